@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InternalConnectivityProbeError is returned when the output of an internal
+// connectivity probe cannot be understood, typically because the app's
+// container lacks the tools the probe relies on.
+type InternalConnectivityProbeError struct {
+	Output string
+}
+
+func (e InternalConnectivityProbeError) Error() string {
+	return fmt.Sprintf("Unexpected output from the connectivity probe: %s", e.Output)
+}
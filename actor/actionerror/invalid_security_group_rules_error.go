@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InvalidSecurityGroupRulesError is returned when a security group rules file
+// contains rules that the Cloud Controller would reject.
+type InvalidSecurityGroupRulesError struct {
+	Path     string
+	Problems []string
+}
+
+func (e InvalidSecurityGroupRulesError) Error() string {
+	return fmt.Sprintf("Invalid security group rules in %s:\n  %s", e.Path, strings.Join(e.Problems, "\n  "))
+}
@@ -0,0 +1,16 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InvalidServiceParametersError is returned when configuration parameters do
+// not satisfy the JSON schema published by the service plan.
+type InvalidServiceParametersError struct {
+	Errors []string
+}
+
+func (e InvalidServiceParametersError) Error() string {
+	return fmt.Sprintf("Parameters do not match the schema published by the service plan:\n%s", strings.Join(e.Errors, "\n"))
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidTaskNamePatternError is returned when a task name pattern is not a
+// valid shell pattern.
+type InvalidTaskNamePatternError struct {
+	Pattern string
+}
+
+func (e InvalidTaskNamePatternError) Error() string {
+	return fmt.Sprintf("Invalid task name pattern '%s'.", e.Pattern)
+}
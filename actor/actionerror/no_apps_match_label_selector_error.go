@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// NoAppsMatchLabelSelectorError is returned when a label selector matches
+// no apps in a space.
+type NoAppsMatchLabelSelectorError struct {
+	LabelSelector string
+}
+
+func (e NoAppsMatchLabelSelectorError) Error() string {
+	return fmt.Sprintf("No apps match the label selector '%s'.", e.LabelSelector)
+}
@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// NoFreeRoutePortError is returned when every port of a range is either used
+// by another route or not reservable in the router group of the domain.
+type NoFreeRoutePortError struct {
+	Domain    string
+	StartPort int
+	EndPort   int
+}
+
+func (e NoFreeRoutePortError) Error() string {
+	return fmt.Sprintf("No free port between %d and %d for domain '%s'.", e.StartPort, e.EndPort, e.Domain)
+}
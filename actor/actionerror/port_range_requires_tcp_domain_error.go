@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PortRangeRequiresTCPDomainError is returned when a port is to be picked
+// from a range for a route on a domain that is not a TCP domain.
+type PortRangeRequiresTCPDomainError struct {
+	Domain string
+}
+
+func (e PortRangeRequiresTCPDomainError) Error() string {
+	return fmt.Sprintf("Domain '%s' is not a TCP domain, so route ports cannot be picked from a range.", e.Domain)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// RouteNotInternalError is returned when a route is expected to be on an
+// internal domain but is not.
+type RouteNotInternalError struct {
+	URL string
+}
+
+func (e RouteNotInternalError) Error() string {
+	return fmt.Sprintf("Route %s is not on an internal domain.", e.URL)
+}
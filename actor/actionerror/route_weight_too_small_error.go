@@ -0,0 +1,16 @@
+package actionerror
+
+import "fmt"
+
+// RouteWeightTooSmallError is returned when an app's weight on a route is
+// smaller than the number of its destinations on the route, so that not every
+// destination can get a weight of at least 1.
+type RouteWeightTooSmallError struct {
+	AppGUID      string
+	Weight       int
+	Destinations int
+}
+
+func (e RouteWeightTooSmallError) Error() string {
+	return fmt.Sprintf("Weight %d of app '%s' is smaller than its %d destinations on the route.", e.Weight, e.AppGUID, e.Destinations)
+}
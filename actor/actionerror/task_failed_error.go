@@ -1,7 +1,12 @@
 package actionerror
 
-type TaskFailedError struct{}
+type TaskFailedError struct {
+	Reason string
+}
 
-func (TaskFailedError) Error() string {
+func (e TaskFailedError) Error() string {
+	if e.Reason != "" {
+		return "Task failed to complete successfully: " + e.Reason
+	}
 	return "Task failed to complete successfully"
 }
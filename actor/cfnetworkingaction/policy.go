@@ -8,10 +8,13 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/batcher"
 	"code.cloudfoundry.org/cli/util/lookuptable"
+	"code.cloudfoundry.org/cli/util/unique"
 )
 
 type Policy struct {
 	SourceName           string
+	SourceSpaceName      string
+	SourceOrgName        string
 	DestinationName      string
 	Protocol             string
 	DestinationSpaceName string
@@ -74,6 +77,29 @@ func (actor Actor) NetworkPoliciesBySpace(spaceGUID string) ([]Policy, Warnings,
 	return policies, allWarnings, nil
 }
 
+// NetworkPoliciesByOrg lists the policies whose source app is in any space
+// of the given org.
+func (actor Actor) NetworkPoliciesByOrg(orgGUID string) ([]Policy, Warnings, error) {
+	var allWarnings Warnings
+
+	applications, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{
+		Key:    ccv3.OrganizationGUIDFilter,
+		Values: []string{orgGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	policies, warnings, err := actor.getPoliciesForApplications(applications)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	return policies, allWarnings, nil
+}
+
 func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName string) ([]Policy, Warnings, error) {
 	var allWarnings Warnings
 
@@ -92,6 +118,70 @@ func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName
 	return policies, allWarnings, nil
 }
 
+// NetworkPoliciesToApplications lists the policies whose destination is one
+// of the given applications.
+func (actor Actor) NetworkPoliciesToApplications(destApps []resources.Application) ([]Policy, Warnings, error) {
+	var allWarnings Warnings
+
+	var destAppGUIDs []string
+	for _, app := range destApps {
+		destAppGUIDs = append(destAppGUIDs, app.GUID)
+	}
+
+	var v1Policies []cfnetv1.Policy
+	warnings, err := batcher.RequestByGUID(destAppGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, err := actor.NetworkingClient.ListPolicies(guids...)
+		v1Policies = append(v1Policies, batch...)
+		return nil, err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	// ListPolicies returns policies with the app guids in either the source or
+	// destination, so keep only those with the app guids in the destination.
+	isDestination := map[string]bool{}
+	for _, guid := range destAppGUIDs {
+		isDestination[guid] = true
+	}
+
+	var toDestApps []cfnetv1.Policy
+	var srcAppGUIDs []string
+	for _, v1Policy := range v1Policies {
+		if isDestination[v1Policy.Destination.ID] {
+			toDestApps = append(toDestApps, v1Policy)
+			srcAppGUIDs = append(srcAppGUIDs, v1Policy.Source.ID)
+		}
+	}
+
+	if len(toDestApps) == 0 {
+		return []Policy{}, allWarnings, nil
+	}
+
+	var srcApps []resources.Application
+	warnings, err = batcher.RequestByGUID(unique.StringSlice(srcAppGUIDs), func(guids []string) (ccv3.Warnings, error) {
+		batch, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{
+			Key:    ccv3.GUIDFilter,
+			Values: guids,
+		})
+		srcApps = append(srcApps, batch...)
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	policies, warnings, err := actor.namePolicies(toDestApps, srcApps)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	return policies, allWarnings, nil
+}
+
 func (actor Actor) RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
 	var allWarnings Warnings
 
@@ -135,6 +225,115 @@ func (actor Actor) RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID,
 	return allWarnings, actionerror.PolicyDoesNotExistError{}
 }
 
+// PolicyAppSelector selects the apps at one end of a policy: the app named
+// AppName, or every app matching LabelSelector, in the space.
+type PolicyAppSelector struct {
+	SpaceGUID     string
+	AppName       string
+	LabelSelector string
+}
+
+// GetPolicyApps returns the apps matching the selector. It returns a
+// NoAppsMatchLabelSelectorError when a label selector matches no apps.
+func (actor Actor) GetPolicyApps(selector PolicyAppSelector) ([]resources.Application, Warnings, error) {
+	if selector.LabelSelector == "" {
+		app, warnings, err := actor.CloudControllerClient.GetApplicationByNameAndSpace(selector.AppName, selector.SpaceGUID)
+		if err != nil {
+			return nil, Warnings(warnings), err
+		}
+		return []resources.Application{app}, Warnings(warnings), nil
+	}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{
+			Key:    ccv3.SpaceGUIDFilter,
+			Values: []string{selector.SpaceGUID},
+		},
+		ccv3.Query{
+			Key:    ccv3.LabelSelectorFilter,
+			Values: []string{selector.LabelSelector},
+		},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+	if len(apps) == 0 {
+		return nil, Warnings(warnings), actionerror.NoAppsMatchLabelSelectorError{LabelSelector: selector.LabelSelector}
+	}
+	return apps, Warnings(warnings), nil
+}
+
+// AddNetworkPolicies creates a policy from every source app to every
+// destination app, in batches.
+func (actor Actor) AddNetworkPolicies(srcApps []resources.Application, destApps []resources.Application, protocol string, startPort, endPort int) error {
+	policies := policiesBetween(srcApps, destApps, protocol, startPort, endPort)
+	return requestInBatches(policies, actor.NetworkingClient.CreatePolicies)
+}
+
+// RemoveNetworkPolicies removes the existing policies from every source app
+// to every destination app, in batches. It returns a PolicyDoesNotExistError
+// when none of the policies exist.
+func (actor Actor) RemoveNetworkPolicies(srcApps []resources.Application, destApps []resources.Application, protocol string, startPort, endPort int) error {
+	existing, err := actor.listPoliciesWithSources(srcApps)
+	if err != nil {
+		return err
+	}
+
+	existingSet := map[cfnetv1.Policy]struct{}{}
+	for _, policy := range existing {
+		existingSet[policy] = struct{}{}
+	}
+
+	var policiesToRemove []cfnetv1.Policy
+	for _, policy := range policiesBetween(srcApps, destApps, protocol, startPort, endPort) {
+		if _, ok := existingSet[policy]; ok {
+			policiesToRemove = append(policiesToRemove, policy)
+		}
+	}
+
+	if len(policiesToRemove) == 0 {
+		return actionerror.PolicyDoesNotExistError{}
+	}
+
+	return requestInBatches(policiesToRemove, actor.NetworkingClient.RemovePolicies)
+}
+
+func policiesBetween(srcApps []resources.Application, destApps []resources.Application, protocol string, startPort, endPort int) []cfnetv1.Policy {
+	var policies []cfnetv1.Policy
+	for _, srcApp := range srcApps {
+		for _, destApp := range destApps {
+			policies = append(policies, cfnetv1.Policy{
+				Source: cfnetv1.PolicySource{
+					ID: srcApp.GUID,
+				},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       destApp.GUID,
+					Protocol: cfnetv1.PolicyProtocol(protocol),
+					Ports: cfnetv1.Ports{
+						Start: startPort,
+						End:   endPort,
+					},
+				},
+			})
+		}
+	}
+	return policies
+}
+
+func requestInBatches(policies []cfnetv1.Policy, request func([]cfnetv1.Policy) error) error {
+	for start := 0; start < len(policies); start += batcher.BatchSize {
+		end := start + batcher.BatchSize
+		if end > len(policies) {
+			end = len(policies)
+		}
+
+		if err := request(policies[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func filterPoliciesWithoutMatchingSourceGUIDs(v1Policies []cfnetv1.Policy, srcAppGUIDs []string) []cfnetv1.Policy {
 	srcGUIDsSet := map[string]struct{}{}
 	for _, srcGUID := range srcAppGUIDs {
@@ -210,8 +409,17 @@ func (actor Actor) orgNamesBySpaceGUID(spaces []resources.Space) (map[string]str
 }
 
 func (actor Actor) getPoliciesForApplications(applications []resources.Application) ([]Policy, ccv3.Warnings, error) {
-	var allWarnings ccv3.Warnings
+	v1Policies, err := actor.listPoliciesWithSources(applications)
+	if err != nil {
+		return []Policy{}, nil, err
+	}
 
+	return actor.namePolicies(v1Policies, applications)
+}
+
+// listPoliciesWithSources returns the policies whose source is one of the
+// given applications.
+func (actor Actor) listPoliciesWithSources(applications []resources.Application) ([]cfnetv1.Policy, error) {
 	var srcAppGUIDs []string
 	for _, app := range applications {
 		srcAppGUIDs = append(srcAppGUIDs, app.GUID)
@@ -229,12 +437,18 @@ func (actor Actor) getPoliciesForApplications(applications []resources.Applicati
 	})
 
 	if err != nil {
-		return []Policy{}, allWarnings, err
+		return nil, err
 	}
 
 	// ListPolicies will return policies with the app guids in either the source or destination.
 	// It needs to be further filtered to only get policies with the app guids in the source.
-	v1Policies = filterPoliciesWithoutMatchingSourceGUIDs(v1Policies, srcAppGUIDs)
+	return filterPoliciesWithoutMatchingSourceGUIDs(v1Policies, srcAppGUIDs), nil
+}
+
+// namePolicies resolves the app, space and org names of the given policies.
+// The source apps of the policies must be part of applications.
+func (actor Actor) namePolicies(v1Policies []cfnetv1.Policy, applications []resources.Application) ([]Policy, ccv3.Warnings, error) {
+	var allWarnings ccv3.Warnings
 
 	destAppGUIDs := uniqueDestGUIDs(v1Policies)
 
@@ -276,10 +490,13 @@ func (actor Actor) getPoliciesForApplications(applications []resources.Applicati
 
 	var policies []Policy
 	for _, v1Policy := range v1Policies {
+		source := appByGUID[v1Policy.Source.ID]
 		destination := appByGUID[v1Policy.Destination.ID]
 
 		policies = append(policies, Policy{
-			SourceName:           appByGUID[v1Policy.Source.ID].Name,
+			SourceName:           source.Name,
+			SourceSpaceName:      spaceNamesByGUID[source.SpaceGUID],
+			SourceOrgName:        orgNamesBySpaceGUID[source.SpaceGUID],
 			DestinationName:      destination.Name,
 			Protocol:             string(v1Policy.Destination.Protocol),
 			StartPort:            v1Policy.Destination.Ports.Start,
@@ -0,0 +1,230 @@
+package cfnetworkingaction
+
+import (
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// PolicyApp names an app by its org, space and app name.
+type PolicyApp struct {
+	OrgName   string
+	SpaceName string
+	AppName   string
+}
+
+// PolicyDefinition is a policy that should exist, as declared by the user.
+type PolicyDefinition struct {
+	Source      PolicyApp
+	Destination PolicyApp
+	Protocol    string
+	StartPort   int
+	EndPort     int
+}
+
+// PolicyChanges are the policies added and removed when applying policy
+// definitions.
+type PolicyChanges struct {
+	Added   []Policy
+	Removed []Policy
+}
+
+// ApplyNetworkPolicies creates the defined policies that do not exist yet.
+// When prune is set, policies whose source app is in the given space or is
+// the source of a definition, and which are not defined, are removed. When
+// dryRun is set, the changes are computed but not made.
+func (actor Actor) ApplyNetworkPolicies(spaceGUID string, definitions []PolicyDefinition, prune bool, dryRun bool) (PolicyChanges, Warnings, error) {
+	var allWarnings Warnings
+
+	resolver := newPolicyAppResolver(actor.CloudControllerClient)
+
+	var desired []cfnetv1.Policy
+	var sourceApps []resources.Application
+	for _, definition := range definitions {
+		source, warnings, err := resolver.resolve(definition.Source)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PolicyChanges{}, allWarnings, err
+		}
+
+		destination, warnings, err := resolver.resolve(definition.Destination)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PolicyChanges{}, allWarnings, err
+		}
+
+		sourceApps = append(sourceApps, source)
+		desired = append(desired, cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{
+				ID: source.GUID,
+			},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       destination.GUID,
+				Protocol: cfnetv1.PolicyProtocol(definition.Protocol),
+				Ports: cfnetv1.Ports{
+					Start: definition.StartPort,
+					End:   definition.EndPort,
+				},
+			},
+		})
+	}
+
+	spaceApps, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{
+		Key:    ccv3.SpaceGUIDFilter,
+		Values: []string{spaceGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+
+	knownApps := uniqueApplications(append(spaceApps, sourceApps...))
+
+	current, err := actor.listPoliciesWithSources(knownApps)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+
+	toAdd := policyDifference(desired, current)
+	var toRemove []cfnetv1.Policy
+	if prune {
+		toRemove = policyDifference(current, desired)
+	}
+
+	var changes PolicyChanges
+	if len(toAdd)+len(toRemove) == 0 {
+		return changes, allWarnings, nil
+	}
+
+	named, warnings, err := actor.namePolicies(append(append([]cfnetv1.Policy{}, toAdd...), toRemove...), knownApps)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+	changes.Added = named[:len(toAdd)]
+	changes.Removed = named[len(toAdd):]
+
+	if dryRun {
+		return changes, allWarnings, nil
+	}
+
+	err = requestInBatches(toAdd, actor.NetworkingClient.CreatePolicies)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+
+	err = requestInBatches(toRemove, actor.NetworkingClient.RemovePolicies)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+
+	return changes, allWarnings, nil
+}
+
+// policyDifference returns the unique policies in policies that are not in
+// others.
+func policyDifference(policies []cfnetv1.Policy, others []cfnetv1.Policy) []cfnetv1.Policy {
+	exclude := map[cfnetv1.Policy]struct{}{}
+	for _, policy := range others {
+		exclude[policy] = struct{}{}
+	}
+
+	var difference []cfnetv1.Policy
+	for _, policy := range policies {
+		if _, ok := exclude[policy]; !ok {
+			difference = append(difference, policy)
+			exclude[policy] = struct{}{}
+		}
+	}
+	return difference
+}
+
+func uniqueApplications(applications []resources.Application) []resources.Application {
+	var unique []resources.Application
+	occurrences := map[string]struct{}{}
+	for _, app := range applications {
+		if _, ok := occurrences[app.GUID]; !ok {
+			unique = append(unique, app)
+			occurrences[app.GUID] = struct{}{}
+		}
+	}
+	return unique
+}
+
+// policyAppResolver looks up apps by org, space and app name, caching the
+// orgs, spaces and apps it has already found.
+type policyAppResolver struct {
+	client     CloudControllerClient
+	orgGUIDs   map[string]string
+	spaceGUIDs map[[2]string]string
+	apps       map[[3]string]resources.Application
+}
+
+func newPolicyAppResolver(client CloudControllerClient) *policyAppResolver {
+	return &policyAppResolver{
+		client:     client,
+		orgGUIDs:   map[string]string{},
+		spaceGUIDs: map[[2]string]string{},
+		apps:       map[[3]string]resources.Application{},
+	}
+}
+
+func (resolver *policyAppResolver) resolve(policyApp PolicyApp) (resources.Application, ccv3.Warnings, error) {
+	var allWarnings ccv3.Warnings
+
+	appKey := [3]string{policyApp.OrgName, policyApp.SpaceName, policyApp.AppName}
+	if app, ok := resolver.apps[appKey]; ok {
+		return app, nil, nil
+	}
+
+	orgGUID, ok := resolver.orgGUIDs[policyApp.OrgName]
+	if !ok {
+		orgs, warnings, err := resolver.client.GetOrganizations(ccv3.Query{
+			Key:    ccv3.NameFilter,
+			Values: []string{policyApp.OrgName},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return resources.Application{}, allWarnings, err
+		}
+		if len(orgs) == 0 {
+			return resources.Application{}, allWarnings, actionerror.OrganizationNotFoundError{Name: policyApp.OrgName}
+		}
+		orgGUID = orgs[0].GUID
+		resolver.orgGUIDs[policyApp.OrgName] = orgGUID
+	}
+
+	spaceKey := [2]string{policyApp.OrgName, policyApp.SpaceName}
+	spaceGUID, ok := resolver.spaceGUIDs[spaceKey]
+	if !ok {
+		spaces, _, warnings, err := resolver.client.GetSpaces(
+			ccv3.Query{
+				Key:    ccv3.NameFilter,
+				Values: []string{policyApp.SpaceName},
+			},
+			ccv3.Query{
+				Key:    ccv3.OrganizationGUIDFilter,
+				Values: []string{orgGUID},
+			},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return resources.Application{}, allWarnings, err
+		}
+		if len(spaces) == 0 {
+			return resources.Application{}, allWarnings, actionerror.SpaceNotFoundError{Name: policyApp.SpaceName}
+		}
+		spaceGUID = spaces[0].GUID
+		resolver.spaceGUIDs[spaceKey] = spaceGUID
+	}
+
+	app, warnings, err := resolver.client.GetApplicationByNameAndSpace(policyApp.AppName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return resources.Application{}, allWarnings, err
+	}
+
+	resolver.apps[appKey] = app
+	return app, allWarnings, nil
+}
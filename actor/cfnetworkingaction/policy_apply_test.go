@@ -0,0 +1,262 @@
+package cfnetworkingaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction/cfnetworkingactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApplyNetworkPolicies", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *cfnetworkingactionfakes.FakeCloudControllerClient
+		fakeNetworkingClient      *cfnetworkingactionfakes.FakeNetworkingClient
+
+		definitions []PolicyDefinition
+		prune       bool
+		dryRun      bool
+
+		changes    PolicyChanges
+		warnings   Warnings
+		executeErr error
+
+		frontendToBackend cfnetv1.Policy
+		backendToFrontend cfnetv1.Policy
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(cfnetworkingactionfakes.FakeCloudControllerClient)
+		fakeNetworkingClient = new(cfnetworkingactionfakes.FakeNetworkingClient)
+		actor = NewActor(fakeNetworkingClient, fakeCloudControllerClient)
+
+		apps := []resources.Application{
+			{Name: "frontend", GUID: "frontend-guid", SpaceGUID: "space-guid"},
+			{Name: "backend", GUID: "backend-guid", SpaceGUID: "space-guid"},
+		}
+
+		fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{
+			{Name: "org", GUID: "org-guid"},
+		}, ccv3.Warnings{"get-orgs-warning"}, nil)
+		fakeCloudControllerClient.GetSpacesReturns([]resources.Space{
+			{
+				Name: "space",
+				GUID: "space-guid",
+				Relationships: map[constant.RelationshipType]resources.Relationship{
+					constant.RelationshipTypeOrganization: {GUID: "org-guid"},
+				},
+			},
+		}, ccv3.IncludedResources{}, ccv3.Warnings{"get-spaces-warning"}, nil)
+		fakeCloudControllerClient.GetApplicationByNameAndSpaceStub = func(appName string, spaceGUID string) (resources.Application, ccv3.Warnings, error) {
+			for _, app := range apps {
+				if app.Name == appName {
+					return app, ccv3.Warnings{"get-app-warning"}, nil
+				}
+			}
+			return resources.Application{}, nil, errors.New("app not found")
+		}
+		fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+			if queries[0].Key == ccv3.SpaceGUIDFilter {
+				return apps, ccv3.Warnings{"get-space-apps-warning"}, nil
+			}
+			var found []resources.Application
+			for _, app := range apps {
+				for _, guid := range queries[0].Values {
+					if app.GUID == guid {
+						found = append(found, app)
+					}
+				}
+			}
+			return found, nil, nil
+		}
+
+		frontendToBackend = cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{ID: "frontend-guid"},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       "backend-guid",
+				Protocol: "tcp",
+				Ports:    cfnetv1.Ports{Start: 8080, End: 8080},
+			},
+		}
+		backendToFrontend = cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{ID: "backend-guid"},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       "frontend-guid",
+				Protocol: "udp",
+				Ports:    cfnetv1.Ports{Start: 9000, End: 9010},
+			},
+		}
+		fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{backendToFrontend}, nil)
+
+		definition := PolicyDefinition{
+			Source:      PolicyApp{OrgName: "org", SpaceName: "space", AppName: "frontend"},
+			Destination: PolicyApp{OrgName: "org", SpaceName: "space", AppName: "backend"},
+			Protocol:    "tcp",
+			StartPort:   8080,
+			EndPort:     8080,
+		}
+		definitions = []PolicyDefinition{definition, definition}
+		prune = false
+		dryRun = false
+	})
+
+	JustBeforeEach(func() {
+		changes, warnings, executeErr = actor.ApplyNetworkPolicies("space-guid", definitions, prune, dryRun)
+	})
+
+	It("creates the missing policies once and keeps the others", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(warnings).To(ContainElements("get-orgs-warning", "get-spaces-warning", "get-app-warning", "get-space-apps-warning"))
+
+		Expect(changes.Added).To(Equal([]Policy{{
+			SourceName:           "frontend",
+			SourceSpaceName:      "space",
+			SourceOrgName:        "org",
+			DestinationName:      "backend",
+			Protocol:             "tcp",
+			StartPort:            8080,
+			EndPort:              8080,
+			DestinationSpaceName: "space",
+			DestinationOrgName:   "org",
+		}}))
+		Expect(changes.Removed).To(BeEmpty())
+
+		Expect(fakeCloudControllerClient.GetApplicationByNameAndSpaceCallCount()).To(Equal(2))
+		Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(ConsistOf("frontend-guid", "backend-guid"))
+
+		Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+		Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{frontendToBackend}))
+		Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+	})
+
+	When("pruning", func() {
+		BeforeEach(func() {
+			prune = true
+		})
+
+		It("also removes the policies that are not defined", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(changes.Added).To(HaveLen(1))
+			Expect(changes.Removed).To(Equal([]Policy{{
+				SourceName:           "backend",
+				SourceSpaceName:      "space",
+				SourceOrgName:        "org",
+				DestinationName:      "frontend",
+				Protocol:             "udp",
+				StartPort:            9000,
+				EndPort:              9010,
+				DestinationSpaceName: "space",
+				DestinationOrgName:   "org",
+			}}))
+
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{backendToFrontend}))
+		})
+	})
+
+	When("there are more changes than fit in one request", func() {
+		BeforeEach(func() {
+			prune = true
+
+			definitions = nil
+			var current []cfnetv1.Policy
+			for i := 0; i <= batcher.BatchSize; i++ {
+				definitions = append(definitions, PolicyDefinition{
+					Source:      PolicyApp{OrgName: "org", SpaceName: "space", AppName: "frontend"},
+					Destination: PolicyApp{OrgName: "org", SpaceName: "space", AppName: "backend"},
+					Protocol:    "tcp",
+					StartPort:   1000 + i,
+					EndPort:     1000 + i,
+				})
+
+				policy := backendToFrontend
+				policy.Destination.Ports = cfnetv1.Ports{Start: 2000 + i, End: 2000 + i}
+				current = append(current, policy)
+			}
+			fakeNetworkingClient.ListPoliciesReturns(current, nil)
+		})
+
+		It("creates and removes the policies in batches", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(2))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(HaveLen(batcher.BatchSize))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(1)).To(HaveLen(1))
+
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(2))
+			Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(HaveLen(batcher.BatchSize))
+			Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(1)).To(HaveLen(1))
+		})
+	})
+
+	When("it is a dry run", func() {
+		BeforeEach(func() {
+			prune = true
+			dryRun = true
+		})
+
+		It("returns the changes without making them", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(changes.Added).To(HaveLen(1))
+			Expect(changes.Removed).To(HaveLen(1))
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the policies already match the definitions", func() {
+		BeforeEach(func() {
+			prune = true
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{frontendToBackend}, nil)
+		})
+
+		It("changes nothing", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(changes).To(Equal(PolicyChanges{}))
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("an org does not exist", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-orgs-warning"}, nil)
+		})
+
+		It("returns an OrganizationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "org"}))
+			Expect(warnings).To(ConsistOf("get-orgs-warning"))
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a space does not exist", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+		})
+
+		It("returns a SpaceNotFoundError", func() {
+			Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "space"}))
+		})
+	})
+
+	When("creating the policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingClient.CreatePoliciesReturns(errors.New("create-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("create-error"))
+		})
+	})
+})
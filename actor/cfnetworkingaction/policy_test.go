@@ -216,6 +216,8 @@ var _ = Describe("Policy", func() {
 				Expect(policies).To(Equal([]Policy{
 					{
 						SourceName:           "appA",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appB",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -225,6 +227,8 @@ var _ = Describe("Policy", func() {
 					},
 					{
 						SourceName:           "appA",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appC",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -429,6 +433,8 @@ var _ = Describe("Policy", func() {
 				Expect(policies).To(Equal(
 					[]Policy{{
 						SourceName:           "appA",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appB",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -437,6 +443,8 @@ var _ = Describe("Policy", func() {
 						DestinationOrgName:   "orgA",
 					}, {
 						SourceName:           "appB",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appB",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -445,6 +453,8 @@ var _ = Describe("Policy", func() {
 						DestinationOrgName:   "orgA",
 					}, {
 						SourceName:           "appA",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appC",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -595,6 +605,8 @@ var _ = Describe("Policy", func() {
 
 					expectedPolicy := Policy{
 						SourceName:           srcApp.Name,
+						SourceSpaceName:      "space",
+						SourceOrgName:        "org",
 						DestinationName:      destApp.Name,
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -653,6 +665,204 @@ var _ = Describe("Policy", func() {
 		})
 	})
 
+	Describe("NetworkPoliciesByOrg", func() {
+		var policies []Policy
+
+		BeforeEach(func() {
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{{
+				Source: cfnetv1.PolicySource{
+					ID: "appAGUID",
+				},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       "appBGUID",
+					Protocol: "tcp",
+					Ports: cfnetv1.Ports{
+						Start: 8080,
+						End:   8080,
+					},
+				},
+			}}, nil)
+
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []resources.Application{
+				{Name: "appA", GUID: "appAGUID", SpaceGUID: "spaceAGUID"},
+				{Name: "appB", GUID: "appBGUID", SpaceGUID: "spaceBGUID"},
+			}, []string{"filter-apps-by-org-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, []resources.Application{
+				{Name: "appB", GUID: "appBGUID", SpaceGUID: "spaceBGUID"},
+			}, nil, nil)
+
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{
+				{
+					Name: "spaceA",
+					GUID: "spaceAGUID",
+					Relationships: map[constant.RelationshipType]resources.Relationship{
+						constant.RelationshipTypeOrganization: {GUID: "orgGUID"},
+					},
+				},
+				{
+					Name: "spaceB",
+					GUID: "spaceBGUID",
+					Relationships: map[constant.RelationshipType]resources.Relationship{
+						constant.RelationshipTypeOrganization: {GUID: "orgGUID"},
+					},
+				},
+			}, ccv3.IncludedResources{}, nil, nil)
+
+			fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{
+				{Name: "org", GUID: "orgGUID"},
+			}, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			policies, warnings, executeErr = actor.NetworkPoliciesByOrg("orgGUID")
+		})
+
+		It("lists policies of apps in every space of the org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("filter-apps-by-org-warning"))
+			Expect(policies).To(Equal([]Policy{{
+				SourceName:           "appA",
+				SourceSpaceName:      "spaceA",
+				SourceOrgName:        "org",
+				DestinationName:      "appB",
+				Protocol:             "tcp",
+				StartPort:            8080,
+				EndPort:              8080,
+				DestinationSpaceName: "spaceB",
+				DestinationOrgName:   "org",
+			}}))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.OrganizationGUIDFilter, Values: []string{"orgGUID"}},
+			}))
+		})
+
+		When("getting the applications fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, nil, []string{"filter-apps-by-org-warning"}, errors.New("banana"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(policies).To(Equal([]Policy{}))
+				Expect(warnings).To(ConsistOf("filter-apps-by-org-warning"))
+				Expect(executeErr).To(MatchError("banana"))
+			})
+		})
+	})
+
+	Describe("NetworkPoliciesToApplications", func() {
+		var policies []Policy
+
+		BeforeEach(func() {
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+				{
+					Source: cfnetv1.PolicySource{ID: "appAGUID"},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appBGUID",
+						Protocol: "tcp",
+						Ports:    cfnetv1.Ports{Start: 8080, End: 8090},
+					},
+				},
+				{
+					Source: cfnetv1.PolicySource{ID: "appBGUID"},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appCGUID",
+						Protocol: "udp",
+						Ports:    cfnetv1.Ports{Start: 53, End: 53},
+					},
+				},
+			}, nil)
+
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []resources.Application{
+				{Name: "appA", GUID: "appAGUID", SpaceGUID: "spaceAGUID"},
+			}, []string{"get-source-apps-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, []resources.Application{
+				{Name: "appB", GUID: "appBGUID", SpaceGUID: "spaceBGUID"},
+			}, []string{"get-destination-apps-warning"}, nil)
+
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{
+				{
+					Name: "spaceA",
+					GUID: "spaceAGUID",
+					Relationships: map[constant.RelationshipType]resources.Relationship{
+						constant.RelationshipTypeOrganization: {GUID: "orgGUID"},
+					},
+				},
+				{
+					Name: "spaceB",
+					GUID: "spaceBGUID",
+					Relationships: map[constant.RelationshipType]resources.Relationship{
+						constant.RelationshipTypeOrganization: {GUID: "orgGUID"},
+					},
+				},
+			}, ccv3.IncludedResources{}, nil, nil)
+
+			fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{
+				{Name: "org", GUID: "orgGUID"},
+			}, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			policies, warnings, executeErr = actor.NetworkPoliciesToApplications([]resources.Application{
+				{Name: "appB", GUID: "appBGUID", SpaceGUID: "spaceBGUID"},
+			})
+		})
+
+		It("lists the policies whose destination is one of the apps", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-source-apps-warning", "get-destination-apps-warning"))
+			Expect(policies).To(Equal([]Policy{{
+				SourceName:           "appA",
+				SourceSpaceName:      "spaceA",
+				SourceOrgName:        "org",
+				DestinationName:      "appB",
+				Protocol:             "tcp",
+				StartPort:            8080,
+				EndPort:              8090,
+				DestinationSpaceName: "spaceB",
+				DestinationOrgName:   "org",
+			}}))
+
+			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(Equal([]string{"appBGUID"}))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.GUIDFilter, Values: []string{"appAGUID"}},
+			}))
+		})
+
+		When("no policies allow traffic to the apps", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, nil)
+			})
+
+			It("returns no policies without looking up apps", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(policies).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("listing the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, errors.New("apple"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("apple"))
+			})
+		})
+
+		When("naming the policies fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"get-spaces-warning"}, errors.New("banana"))
+			})
+
+			It("returns the error and the warnings so far", func() {
+				Expect(executeErr).To(MatchError("banana"))
+				Expect(warnings).To(ConsistOf("get-source-apps-warning", "get-destination-apps-warning", "get-spaces-warning"))
+			})
+		})
+	})
+
 	Describe("RemoveNetworkPolicy", func() {
 		BeforeEach(func() {
 			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
@@ -766,4 +976,152 @@ var _ = Describe("Policy", func() {
 			})
 		})
 	})
+
+	Describe("GetPolicyApps", func() {
+		var (
+			selector PolicyAppSelector
+			apps     []resources.Application
+		)
+
+		JustBeforeEach(func() {
+			apps, warnings, executeErr = actor.GetPolicyApps(selector)
+		})
+
+		When("the selector names an app", func() {
+			BeforeEach(func() {
+				selector = PolicyAppSelector{SpaceGUID: "space-guid", AppName: "appA"}
+			})
+
+			It("returns the app", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("v3ActorWarningA"))
+				Expect(apps).To(Equal([]resources.Application{{GUID: "appAGUID"}}))
+
+				appName, spaceGUID := fakeCloudControllerClient.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("appA"))
+				Expect(spaceGUID).To(Equal("space-guid"))
+			})
+		})
+
+		When("the selector has a label selector", func() {
+			BeforeEach(func() {
+				selector = PolicyAppSelector{SpaceGUID: "space-guid", LabelSelector: "tier=web"}
+				fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{
+					{GUID: "web-1-guid"},
+					{GUID: "web-2-guid"},
+				}, ccv3.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("returns the apps in the space matching the label selector", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(apps).To(HaveLen(2))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"tier=web"}},
+				))
+			})
+
+			When("no apps match", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, nil)
+				})
+
+				It("returns a NoAppsMatchLabelSelectorError", func() {
+					Expect(executeErr).To(MatchError(actionerror.NoAppsMatchLabelSelectorError{LabelSelector: "tier=web"}))
+					Expect(warnings).To(ConsistOf("get-apps-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("AddNetworkPolicies", func() {
+		var srcApps, destApps []resources.Application
+
+		BeforeEach(func() {
+			srcApps = nil
+			for i := 0; i < batcher.BatchSize+1; i++ {
+				srcApps = append(srcApps, resources.Application{GUID: fmt.Sprintf("src-%d-guid", i)})
+			}
+			destApps = []resources.Application{{GUID: "dest-guid"}}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.AddNetworkPolicies(srcApps, destApps, "tcp", 8080, 8090)
+		})
+
+		It("creates a policy for every pair in batches", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(2))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(HaveLen(batcher.BatchSize))
+
+			lastBatch := fakeNetworkingClient.CreatePoliciesArgsForCall(1)
+			Expect(lastBatch).To(Equal([]cfnetv1.Policy{{
+				Source: cfnetv1.PolicySource{ID: fmt.Sprintf("src-%d-guid", batcher.BatchSize)},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       "dest-guid",
+					Protocol: "tcp",
+					Ports:    cfnetv1.Ports{Start: 8080, End: 8090},
+				},
+			}}))
+		})
+
+		When("creating a batch fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.CreatePoliciesReturns(errors.New("apple"))
+			})
+
+			It("returns the error without sending further batches", func() {
+				Expect(executeErr).To(MatchError("apple"))
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("RemoveNetworkPolicies", func() {
+		var (
+			srcApps, destApps []resources.Application
+			webToAPI          cfnetv1.Policy
+		)
+
+		BeforeEach(func() {
+			srcApps = []resources.Application{{GUID: "web-1-guid"}, {GUID: "web-2-guid"}}
+			destApps = []resources.Application{{GUID: "api-guid"}}
+
+			webToAPI = cfnetv1.Policy{
+				Source: cfnetv1.PolicySource{ID: "web-1-guid"},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       "api-guid",
+					Protocol: "tcp",
+					Ports:    cfnetv1.Ports{Start: 8080, End: 8080},
+				},
+			}
+			otherPort := webToAPI
+			otherPort.Destination.Ports = cfnetv1.Ports{Start: 9090, End: 9090}
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{webToAPI, otherPort}, nil)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.RemoveNetworkPolicies(srcApps, destApps, "tcp", 8080, 8080)
+		})
+
+		It("removes only the matching policies that exist", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(ConsistOf("web-1-guid", "web-2-guid"))
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{webToAPI}))
+		})
+
+		When("none of the policies exist", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, nil)
+			})
+
+			It("returns a PolicyDoesNotExistError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PolicyDoesNotExistError{}))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
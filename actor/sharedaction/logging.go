@@ -259,3 +259,38 @@ func convertEnvelopesToLogMessages(envelopes []*loggregator_v2.Envelope) []*LogM
 	}
 	return logMessages
 }
+
+// GetRecentLogsBefore returns up to limit log lines emitted by the given app
+// instance before the given time, oldest first. Instance indexes are per
+// process, so sourceType, such as APP/PROC/WEB, selects the process. An empty
+// sourceType or instance matches every process or instance of the app.
+func GetRecentLogsBefore(appGUID string, sourceType string, instance string, before time.Time, limit int, client LogCacheClient) ([]LogMessage, error) {
+	envelopes, err := client.Read(
+		context.Background(),
+		appGUID,
+		time.Time{},
+		logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
+		logcache.WithEndTime(before),
+		logcache.WithLimit(RecentLogsLines),
+		logcache.WithDescending(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve logs from Log Cache: %s", err)
+	}
+
+	var logMessages []LogMessage
+	for _, logMessage := range convertEnvelopesToLogMessages(envelopes) {
+		if len(logMessages) == limit {
+			break
+		}
+		if sourceType != "" && logMessage.SourceType() != sourceType {
+			continue
+		}
+		if instance != "" && logMessage.SourceInstance() != instance {
+			continue
+		}
+		logMessages = append([]LogMessage{*logMessage}, logMessages...)
+	}
+
+	return logMessages, nil
+}
@@ -366,4 +366,97 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogsBefore", func() {
+		var (
+			before     time.Time
+			sourceType string
+			messages   []sharedaction.LogMessage
+			err        error
+		)
+
+		BeforeEach(func() {
+			before = time.Unix(0, 100)
+			sourceType = ""
+		})
+
+		JustBeforeEach(func() {
+			messages, err = sharedaction.GetRecentLogsBefore("some-app-guid", sourceType, "1", before, 2, fakeLogCacheClient)
+		})
+
+		When("Log Cache returns logs", func() {
+			BeforeEach(func() {
+				envelope := func(timestamp int64, instance string, payload string, sourceType string) *loggregator_v2.Envelope {
+					return &loggregator_v2.Envelope{
+						Timestamp:  timestamp,
+						SourceId:   "some-app-guid",
+						InstanceId: instance,
+						Message: &loggregator_v2.Envelope_Log{
+							Log: &loggregator_v2.Log{
+								Payload: []byte(payload),
+								Type:    loggregator_v2.Log_ERR,
+							},
+						},
+						Tags: map[string]string{
+							"source_type": sourceType,
+						},
+					}
+				}
+
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					envelope(40, "1", "message-4", "APP/PROC/WEB"),
+					envelope(30, "0", "message-3", "APP/PROC/WEB"),
+					envelope(20, "1", "message-2", "APP/PROC/WORKER"),
+					envelope(10, "1", "message-1", "APP/PROC/WEB"),
+				}, nil)
+			})
+
+			It("reads logs ending at the given time", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+
+				_, sourceID, _, readOptions := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+
+				u := new(url.URL)
+				v := make(url.Values)
+				for _, option := range readOptions {
+					option(u, v)
+				}
+				Expect(v.Get("end_time")).To(Equal("100"))
+				Expect(v.Get("descending")).To(Equal("true"))
+			})
+
+			It("returns the last lines of the given instance, oldest first", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-2"))
+				Expect(messages[1].Message()).To(Equal("message-4"))
+				Expect(messages[1].SourceInstance()).To(Equal("1"))
+			})
+
+			When("a source type is given", func() {
+				BeforeEach(func() {
+					sourceType = "APP/PROC/WEB"
+				})
+
+				It("returns only the lines of that process", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(messages).To(HaveLen(2))
+					Expect(messages[0].Message()).To(Equal("message-1"))
+					Expect(messages[1].Message()).To(Equal("message-4"))
+				})
+			})
+		})
+
+		When("Log Cache errors", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("some-recent-logs-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: some-recent-logs-error"))
+			})
+		})
+	})
+
 })
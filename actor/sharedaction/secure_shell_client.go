@@ -9,5 +9,6 @@ type SecureShellClient interface {
 	Close() error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	Run(command string) ([]byte, error)
 	Wait() error
 }
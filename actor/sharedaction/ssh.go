@@ -1,6 +1,10 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -45,6 +49,19 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	return err
 }
 
+// RunSecureShellCommand connects to the app instance described by
+// sshOptions, runs its commands without a terminal and returns what they
+// write to standard output.
+func (actor Actor) RunSecureShellCommand(sshClient SecureShellClient, sshOptions SSHOptions) ([]byte, error) {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return nil, err
+	}
+	defer sshClient.Close()
+
+	return sshClient.Run(strings.Join(sshOptions.Commands, " "))
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
@@ -163,4 +163,65 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("RunSecureShellCommand", func() {
+		var (
+			sshOptions SSHOptions
+			output     []byte
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Commands:           []string{"echo", "hello"},
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+			}
+			fakeSecureShellClient.RunReturns([]byte("hello\n"), nil)
+		})
+
+		JustBeforeEach(func() {
+			output, executeErr = actor.RunSecureShellCommand(fakeSecureShellClient, sshOptions)
+		})
+
+		It("connects, runs the commands and closes the connection", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(output).To(Equal([]byte("hello\n")))
+
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeFalse())
+
+			Expect(fakeSecureShellClient.RunArgsForCall(0)).To(Equal("echo hello"))
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without running anything", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.RunCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.RunReturns(nil, errors.New("some-run-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-run-error"))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
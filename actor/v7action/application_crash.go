@@ -0,0 +1,119 @@
+package v7action
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/generic"
+)
+
+const (
+	ProcessCrashEventType        = "audit.app.process.crash"
+	ProcessReschedulingEventType = "audit.app.process.rescheduling"
+)
+
+// ApplicationCrash describes a single crash or rescheduling of an app
+// instance, together with the log lines the instance emitted before it.
+type ApplicationCrash struct {
+	Time            time.Time
+	Type            string
+	ProcessType     string
+	Index           string
+	ExitDescription string
+	Reason          string
+	Logs            []sharedaction.LogMessage
+}
+
+// GetApplicationCrashesByNameAndSpace returns the crash and rescheduling
+// events of every process of the app created at or after since, newest first.
+// Each crash includes up to logLines log lines of the instance that crashed.
+func (actor Actor) GetApplicationCrashesByNameAndSpace(appName string, spaceGUID string, since time.Time, logLines int, client sharedaction.LogCacheClient) ([]ApplicationCrash, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	processTypes := map[string]string{}
+	targetGUIDs := []string{app.GUID}
+	for _, process := range processes {
+		processTypes[process.GUID] = process.Type
+		if process.GUID != app.GUID {
+			targetGUIDs = append(targetGUIDs, process.GUID)
+		}
+	}
+
+	queries := []ccv3.Query{
+		{Key: ccv3.TargetGUIDFilter, Values: targetGUIDs},
+		{Key: ccv3.EventTypesFilter, Values: []string{ProcessCrashEventType, ProcessReschedulingEventType}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+	}
+	if !since.IsZero() {
+		queries = append(queries, ccv3.Query{
+			Key:    ccv3.CreatedAtsGreaterThanOrEqualFilter,
+			Values: []string{since.UTC().Format(time.RFC3339)},
+		})
+	}
+
+	ccEvents, ccWarnings, err := actor.CloudControllerClient.GetEvents(queries...)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var crashes []ApplicationCrash
+	for _, ccEvent := range ccEvents {
+		data := generic.NewMap(ccEvent.Data)
+		crash := ApplicationCrash{
+			Time:            ccEvent.CreatedAt,
+			Type:            ccEvent.Type,
+			ProcessType:     crashProcessType(ccEvent, data, processTypes),
+			Index:           eventDataString(data, "index"),
+			ExitDescription: eventDataString(data, "exit_description"),
+			Reason:          eventDataString(data, "reason"),
+		}
+
+		if logLines > 0 && crash.Index != "" {
+			sourceType := ""
+			if crash.ProcessType != "" {
+				sourceType = "APP/PROC/" + strings.ToUpper(crash.ProcessType)
+			}
+			crash.Logs, err = sharedaction.GetRecentLogsBefore(app.GUID, sourceType, crash.Index, crash.Time, logLines, client)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+		}
+
+		crashes = append(crashes, crash)
+	}
+
+	return crashes, allWarnings, nil
+}
+
+// crashProcessType returns the type of the process an event is about. The
+// events target the app, and their actor is the process, whose name is the
+// process type.
+func crashProcessType(ccEvent ccv3.Event, data generic.Map, processTypes map[string]string) string {
+	if processType, ok := processTypes[ccEvent.ActorGUID]; ok {
+		return processType
+	}
+	if ccEvent.ActorName != "" {
+		return ccEvent.ActorName
+	}
+	return eventDataString(data, "process_type")
+}
+
+func eventDataString(data generic.Map, key string) string {
+	value := data.Get(key)
+	if value == nil {
+		return ""
+	}
+	return formatDescriptionPart(value)
+}
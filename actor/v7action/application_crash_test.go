@@ -0,0 +1,222 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Crash Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *sharedactionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	Describe("GetApplicationCrashesByNameAndSpace", func() {
+		var (
+			since      time.Time
+			logLines   int
+			crashes    []ApplicationCrash
+			warnings   Warnings
+			executeErr error
+			crashTime  time.Time
+		)
+
+		BeforeEach(func() {
+			since = time.Time{}
+			logLines = 5
+			crashTime = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]resources.Process{
+					{GUID: "some-app-guid", Type: "web"},
+					{GUID: "worker-process-guid", Type: "worker"},
+				},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv3.Event{
+					{
+						GUID:       "crash-event-guid",
+						CreatedAt:  crashTime,
+						Type:       "audit.app.process.crash",
+						ActorGUID:  "worker-process-guid",
+						ActorName:  "worker",
+						TargetGUID: "some-app-guid",
+						Data: map[string]interface{}{
+							"index":            float64(1),
+							"exit_description": "APP/PROC/WORKER: Exited with status 137",
+							"reason":           "CRASHED",
+						},
+					},
+					{
+						GUID:       "rescheduling-event-guid",
+						CreatedAt:  crashTime.Add(-time.Hour),
+						Type:       "audit.app.process.rescheduling",
+						ActorGUID:  "some-app-guid",
+						ActorName:  "web",
+						TargetGUID: "some-app-guid",
+						Data: map[string]interface{}{
+							"index":  float64(0),
+							"reason": "Cell is being evacuated",
+						},
+					},
+				},
+				ccv3.Warnings{"get-events-warning"},
+				nil,
+			)
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				{
+					Timestamp:  crashTime.Add(-time.Second).UnixNano(),
+					SourceId:   "some-app-guid",
+					InstanceId: "1",
+					Message: &loggregator_v2.Envelope_Log{
+						Log: &loggregator_v2.Log{
+							Payload: []byte("web request served"),
+							Type:    loggregator_v2.Log_OUT,
+						},
+					},
+					Tags: map[string]string{"source_type": "APP/PROC/WEB"},
+				},
+				{
+					Timestamp:  crashTime.Add(-2 * time.Second).UnixNano(),
+					SourceId:   "some-app-guid",
+					InstanceId: "1",
+					Message: &loggregator_v2.Envelope_Log{
+						Log: &loggregator_v2.Log{
+							Payload: []byte("out of memory"),
+							Type:    loggregator_v2.Log_ERR,
+						},
+					},
+					Tags: map[string]string{"source_type": "APP/PROC/WORKER"},
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			crashes, warnings, executeErr = actor.GetApplicationCrashesByNameAndSpace("some-app", "some-space-guid", since, logLines, fakeLogCacheClient)
+		})
+
+		It("queries the crash and rescheduling events of every process of the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-processes-warning", "get-events-warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"some-app-guid", "worker-process-guid"}},
+				ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.process.crash", "audit.app.process.rescheduling"}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+			))
+		})
+
+		It("returns the crashes with their process types and logs", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(crashes).To(HaveLen(2))
+
+			Expect(crashes[0].Time).To(Equal(crashTime))
+			Expect(crashes[0].Type).To(Equal("audit.app.process.crash"))
+			Expect(crashes[0].ProcessType).To(Equal("worker"))
+			Expect(crashes[0].Index).To(Equal("1"))
+			Expect(crashes[0].ExitDescription).To(Equal("APP/PROC/WORKER: Exited with status 137"))
+			Expect(crashes[0].Reason).To(Equal("CRASHED"))
+			Expect(crashes[0].Logs).To(HaveLen(1))
+			Expect(crashes[0].Logs[0].Message()).To(Equal("out of memory"))
+
+			Expect(crashes[1].Type).To(Equal("audit.app.process.rescheduling"))
+			Expect(crashes[1].ProcessType).To(Equal("web"))
+			Expect(crashes[1].Index).To(Equal("0"))
+			Expect(crashes[1].Reason).To(Equal("Cell is being evacuated"))
+			Expect(crashes[1].Logs).To(BeEmpty())
+		})
+
+		When("the crashed process is not known any more", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns([]resources.Process{{GUID: "some-app-guid", Type: "web"}}, nil, nil)
+			})
+
+			It("takes the process type from the event actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(crashes[0].ProcessType).To(Equal("worker"))
+				Expect(crashes[0].Logs).To(HaveLen(1))
+				Expect(crashes[0].Logs[0].Message()).To(Equal("out of memory"))
+			})
+		})
+
+		When("a since time is given", func() {
+			BeforeEach(func() {
+				since = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			})
+
+			It("filters the events by creation time", func() {
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2020-01-01T00:00:00Z"}},
+				))
+			})
+		})
+
+		When("no log lines are requested", func() {
+			BeforeEach(func() {
+				logLines = 0
+			})
+
+			It("does not read from log cache", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"get-events-warning"}, errors.New("events-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("events-error"))
+				Expect(warnings).To(ContainElement("get-events-warning"))
+			})
+		})
+
+		When("reading the logs fails", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("log-cache-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("Failed to retrieve logs from Log Cache: log-cache-error"))
+			})
+		})
+	})
+})
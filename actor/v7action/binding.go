@@ -0,0 +1,222 @@
+package v7action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/extract"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+const RouteBindingType = "route"
+
+// Binding is a service credential binding or a route binding.
+type Binding struct {
+	// Type is "app", "key" or "route"
+	Type                string
+	Name                string
+	AppName             string
+	RouteURL            string
+	ServiceInstanceName string
+	LastOperation       resources.LastOperation
+	CreatedAt           string
+}
+
+type GetBindingsParams struct {
+	SpaceGUID           string
+	AppName             string
+	ServiceInstanceName string
+	// Type is "app", "key" or "route". When empty, all bindings are returned.
+	Type string
+}
+
+// GetBindings returns the app, key and route bindings of the service
+// instances in a space, together with the bindings of the space's apps to
+// service instances shared into it, or the bindings of a single app or
+// service instance.
+func (actor Actor) GetBindings(params GetBindingsParams) ([]Binding, Warnings, error) {
+	var (
+		appGUID              string
+		spaceAppGUIDs        []string
+		serviceInstanceGUIDs []string
+		credentialBindings   []resources.ServiceCredentialBinding
+		result               []Binding
+	)
+
+	wantCredentialBindings := params.Type != RouteBindingType
+	wantRouteBindings := params.AppName == "" && (params.Type == "" || params.Type == RouteBindingType)
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			switch {
+			case params.AppName != "":
+				var app resources.Application
+				app, warnings, err = actor.CloudControllerClient.GetApplicationByNameAndSpace(params.AppName, params.SpaceGUID)
+				appGUID = app.GUID
+			case params.ServiceInstanceName != "":
+				var serviceInstance resources.ServiceInstance
+				serviceInstance, _, warnings, err = actor.getServiceInstanceByNameAndSpace(params.ServiceInstanceName, params.SpaceGUID)
+				serviceInstanceGUIDs = []string{serviceInstance.GUID}
+			default:
+				var instances []resources.ServiceInstance
+				instances, _, warnings, err = actor.CloudControllerClient.GetServiceInstances(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{params.SpaceGUID}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				serviceInstanceGUIDs = extract.UniqueList("GUID", instances)
+			}
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			// Apps in the space can be bound to service instances shared
+			// into it, which are not found by listing the space's instances.
+			if params.AppName != "" || params.ServiceInstanceName != "" || !wantAppBindings(params.Type) {
+				return
+			}
+
+			var apps []resources.Application
+			apps, warnings, err = actor.CloudControllerClient.GetApplications(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{params.SpaceGUID}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			spaceAppGUIDs = extract.UniqueList("GUID", apps)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			if !wantCredentialBindings {
+				return
+			}
+
+			if appGUID != "" {
+				credentialBindings, warnings, err = actor.getCredentialBindings(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+					ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(resources.AppBinding)}},
+				)
+				return
+			}
+
+			return batcher.RequestByGUID(serviceInstanceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				query := []ccv3.Query{{Key: ccv3.ServiceInstanceGUIDFilter, Values: guids}}
+				if params.Type != "" {
+					query = append(query, ccv3.Query{Key: ccv3.TypeFilter, Values: []string{params.Type}})
+				}
+
+				bindings, warnings, err := actor.getCredentialBindings(query...)
+				credentialBindings = append(credentialBindings, bindings...)
+				return warnings, err
+			})
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			return batcher.RequestByGUID(spaceAppGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				bindings, warnings, err := actor.getCredentialBindings(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(resources.AppBinding)}},
+				)
+				credentialBindings = append(credentialBindings, bindings...)
+				return warnings, err
+			})
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			if !wantRouteBindings {
+				return
+			}
+
+			return batcher.RequestByGUID(serviceInstanceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				bindings, warnings, err := actor.getRouteBindings(guids)
+				result = append(result, bindings...)
+				return warnings, err
+			})
+		},
+	)
+	switch err.(type) {
+	case nil:
+	case ccerror.ApplicationNotFoundError:
+		return nil, Warnings(warnings), actionerror.ApplicationNotFoundError{Name: params.AppName}
+	default:
+		return nil, Warnings(warnings), err
+	}
+
+	result = append(result, toBindings(credentialBindings)...)
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].ServiceInstanceName != result[j].ServiceInstanceName {
+			return result[i].ServiceInstanceName < result[j].ServiceInstanceName
+		}
+		if result[i].Type != result[j].Type {
+			return result[i].Type < result[j].Type
+		}
+		return result[i].AppName+result[i].Name+result[i].RouteURL < result[j].AppName+result[j].Name+result[j].RouteURL
+	})
+
+	return result, Warnings(warnings), nil
+}
+
+func (actor Actor) getCredentialBindings(query ...ccv3.Query) ([]resources.ServiceCredentialBinding, ccv3.Warnings, error) {
+	query = append(query,
+		ccv3.Query{Key: ccv3.Include, Values: []string{"app", "service_instance"}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+
+	return actor.CloudControllerClient.GetServiceCredentialBindings(query...)
+}
+
+// toBindings converts credential bindings, skipping those listed more than
+// once.
+func toBindings(bindings []resources.ServiceCredentialBinding) []Binding {
+	var result []Binding
+	seen := map[string]bool{}
+	for _, binding := range bindings {
+		if seen[binding.GUID] {
+			continue
+		}
+		seen[binding.GUID] = true
+
+		result = append(result, Binding{
+			Type:                string(binding.Type),
+			Name:                binding.Name,
+			AppName:             binding.AppName,
+			ServiceInstanceName: binding.ServiceInstanceName,
+			LastOperation:       binding.LastOperation,
+			CreatedAt:           binding.CreatedAt,
+		})
+	}
+	return result
+}
+
+func wantAppBindings(bindingType string) bool {
+	return bindingType == "" || bindingType == string(resources.AppBinding)
+}
+
+func (actor Actor) getRouteBindings(serviceInstanceGUIDs []string) ([]Binding, ccv3.Warnings, error) {
+	bindings, included, warnings, err := actor.CloudControllerClient.GetRouteBindings(
+		ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: serviceInstanceGUIDs},
+		ccv3.Query{Key: ccv3.Include, Values: []string{"route", "service_instance"}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	serviceInstanceNameLookup := lookuptable.NameFromGUID(included.ServiceInstances)
+	routeURLLookup := make(map[string]string)
+	for _, route := range included.Routes {
+		routeURLLookup[route.GUID] = route.URL
+	}
+
+	result := make([]Binding, len(bindings))
+	for i, binding := range bindings {
+		result[i] = Binding{
+			Type:                RouteBindingType,
+			RouteURL:            routeURLLookup[binding.RouteGUID],
+			ServiceInstanceName: serviceInstanceNameLookup[binding.ServiceInstanceGUID],
+			LastOperation:       binding.LastOperation,
+			CreatedAt:           binding.CreatedAt,
+		}
+	}
+
+	return result, warnings, nil
+}
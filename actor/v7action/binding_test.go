@@ -0,0 +1,321 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Binding Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetBindings", func() {
+		var (
+			params     GetBindingsParams
+			bindings   []Binding
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			params = GetBindingsParams{SpaceGUID: "space-guid"}
+
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{{GUID: "si-1-guid"}, {GUID: "si-2-guid"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"instances warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+				[]resources.ServiceCredentialBinding{
+					{
+						GUID:                "key-1-guid",
+						Type:                resources.KeyBinding,
+						Name:                "key-1",
+						ServiceInstanceName: "si-2",
+						CreatedAt:           "2026-01-02T03:04:05Z",
+					},
+					{
+						GUID:                "primary-guid",
+						Type:                resources.AppBinding,
+						Name:                "primary",
+						AppName:             "app-1",
+						ServiceInstanceName: "si-1",
+						LastOperation:       resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationSucceeded},
+					},
+				},
+				ccv3.Warnings{"credential bindings warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetRouteBindingsReturns(
+				[]resources.RouteBinding{
+					{RouteGUID: "route-guid", ServiceInstanceGUID: "si-1-guid", CreatedAt: "2026-01-03T03:04:05Z"},
+				},
+				ccv3.IncludedResources{
+					Routes:           []resources.Route{{GUID: "route-guid", URL: "app.example.com"}},
+					ServiceInstances: []resources.ServiceInstance{{GUID: "si-1-guid", Name: "si-1"}},
+				},
+				ccv3.Warnings{"route bindings warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			bindings, warnings, executeErr = actor.GetBindings(params)
+		})
+
+		It("lists the bindings of every service instance in the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instances warning", "credential bindings warning", "route bindings warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"si-1-guid", "si-2-guid"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"app", "service_instance"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+			Expect(fakeCloudControllerClient.GetRouteBindingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"si-1-guid", "si-2-guid"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"route", "service_instance"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("returns the bindings sorted by service instance and type", func() {
+			Expect(bindings).To(Equal([]Binding{
+				{
+					Type:                "app",
+					Name:                "primary",
+					AppName:             "app-1",
+					ServiceInstanceName: "si-1",
+					LastOperation:       resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationSucceeded},
+				},
+				{
+					Type:                "route",
+					RouteURL:            "app.example.com",
+					ServiceInstanceName: "si-1",
+					CreatedAt:           "2026-01-03T03:04:05Z",
+				},
+				{
+					Type:                "key",
+					Name:                "key-1",
+					ServiceInstanceName: "si-2",
+					CreatedAt:           "2026-01-02T03:04:05Z",
+				},
+			}))
+		})
+
+		When("apps in the space are bound to service instances shared into it", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "app-1-guid"}, {GUID: "app-2-guid"}},
+					ccv3.Warnings{"apps warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturnsOnCall(1,
+					[]resources.ServiceCredentialBinding{
+						{
+							GUID:                "primary-guid",
+							Type:                resources.AppBinding,
+							Name:                "primary",
+							AppName:             "app-1",
+							ServiceInstanceName: "si-1",
+							LastOperation:       resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationSucceeded},
+						},
+						{
+							GUID:                "shared-guid",
+							Type:                resources.AppBinding,
+							AppName:             "app-2",
+							ServiceInstanceName: "shared-si",
+						},
+					},
+					ccv3.Warnings{"app bindings warning"},
+					nil,
+				)
+			})
+
+			It("also lists the app bindings of the space's apps, once each", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instances warning", "apps warning", "credential bindings warning", "app bindings warning", "route bindings warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(1)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"app-1-guid", "app-2-guid"}},
+					ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+					ccv3.Query{Key: ccv3.Include, Values: []string{"app", "service_instance"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+
+				Expect(bindings).To(HaveLen(4))
+				Expect(bindings[0]).To(Equal(Binding{Type: "app", AppName: "app-2", ServiceInstanceName: "shared-si"}))
+			})
+
+			When("filtering by key bindings", func() {
+				BeforeEach(func() {
+					params.Type = "key"
+				})
+
+				It("does not look up the apps", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(BeZero())
+					Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("the space has no service instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+			})
+
+			It("does not look for bindings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(bindings).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(BeZero())
+				Expect(fakeCloudControllerClient.GetRouteBindingsCallCount()).To(BeZero())
+			})
+		})
+
+		When("filtering by type", func() {
+			When("the type is key", func() {
+				BeforeEach(func() {
+					params.Type = "key"
+				})
+
+				It("only gets credential bindings of that type", func() {
+					Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ContainElement(
+						ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"key"}},
+					))
+					Expect(fakeCloudControllerClient.GetRouteBindingsCallCount()).To(BeZero())
+				})
+			})
+
+			When("the type is route", func() {
+				BeforeEach(func() {
+					params.Type = "route"
+				})
+
+				It("only gets route bindings", func() {
+					Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(BeZero())
+					Expect(bindings).To(HaveLen(1))
+				})
+			})
+		})
+
+		When("filtering by service instance", func() {
+			BeforeEach(func() {
+				params.ServiceInstanceName = "si-1"
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{GUID: "si-1-guid"},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"instance warning"},
+					nil,
+				)
+			})
+
+			It("only gets the bindings of that instance", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(BeZero())
+
+				name, spaceGUID, _ := fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("si-1"))
+				Expect(spaceGUID).To(Equal("space-guid"))
+
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"si-1-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetRouteBindingsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"si-1-guid"}},
+				))
+			})
+
+			When("the instance does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{},
+						ccv3.IncludedResources{},
+						ccv3.Warnings{"instance warning"},
+						ccerror.ServiceInstanceNotFoundError{Name: "si-1"},
+					)
+				})
+
+				It("returns a not found error", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "si-1"}))
+					Expect(warnings).To(ConsistOf("instance warning"))
+				})
+			})
+		})
+
+		When("filtering by app", func() {
+			BeforeEach(func() {
+				params.AppName = "app-1"
+				fakeCloudControllerClient.GetApplicationByNameAndSpaceReturns(
+					resources.Application{GUID: "app-guid"},
+					ccv3.Warnings{"app warning"},
+					nil,
+				)
+			})
+
+			It("only gets the app bindings of that app", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(BeZero())
+				Expect(fakeCloudControllerClient.GetRouteBindingsCallCount()).To(BeZero())
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"app-guid"}},
+					ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+					ccv3.Query{Key: ccv3.Include, Values: []string{"app", "service_instance"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+				Expect(warnings).To(ConsistOf("app warning", "credential bindings warning"))
+			})
+
+			When("the app does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationByNameAndSpaceReturns(
+						resources.Application{},
+						ccv3.Warnings{"app warning"},
+						ccerror.ApplicationNotFoundError{Name: "app-1"},
+					)
+				})
+
+				It("returns a not found error", func() {
+					Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "app-1"}))
+				})
+			})
+		})
+
+		When("getting route bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteBindingsReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"route bindings warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("instances warning", "credential bindings warning", "route bindings warning"))
+			})
+		})
+	})
+})
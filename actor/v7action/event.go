@@ -11,11 +11,28 @@ import (
 )
 
 type Event struct {
-	GUID        string
-	Time        time.Time
-	Type        string
-	ActorName   string
-	Description string
+	GUID             string
+	Time             time.Time
+	Type             string
+	ActorName        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Description      string
+}
+
+// EventFilter narrows down the audit events returned by GetAuditEvents. Empty
+// fields do not restrict the result.
+type EventFilter struct {
+	Types             []string
+	TargetGUIDs       []string
+	SpaceGUIDs        []string
+	OrganizationGUIDs []string
+	ActorName         string
+	Since             time.Time
+	Until             time.Time
 }
 
 func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]Event, Warnings, error) {
@@ -40,19 +57,69 @@ func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spac
 
 	var events []Event
 	for _, ccEvent := range ccEvents {
-		events = append(events, Event{
-			GUID:        ccEvent.GUID,
-			Time:        ccEvent.CreatedAt,
-			Type:        ccEvent.Type,
-			ActorName:   ccEvent.ActorName,
-			Description: generateDescription(ccEvent.Data),
-		})
-
+		events = append(events, convertEvent(ccEvent))
 	}
 
 	return events, allWarnings, nil
 }
 
+// GetAuditEvents returns every audit event matching the filter, newest first.
+func (actor Actor) GetAuditEvents(filter EventFilter) ([]Event, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+	if len(filter.Types) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.EventTypesFilter, Values: filter.Types})
+	}
+	if len(filter.TargetGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: filter.TargetGUIDs})
+	}
+	if len(filter.SpaceGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: filter.SpaceGUIDs})
+	}
+	if len(filter.OrganizationGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: filter.OrganizationGUIDs})
+	}
+	if !filter.Since.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{filter.Since.UTC().Format(time.RFC3339)}})
+	}
+	if !filter.Until.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsLessThanOrEqualFilter, Values: []string{filter.Until.UTC().Format(time.RFC3339)}})
+	}
+
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(queries...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []Event
+	for _, ccEvent := range ccEvents {
+		// The audit events endpoint cannot filter by actor, so do it here.
+		if filter.ActorName != "" && ccEvent.ActorName != filter.ActorName {
+			continue
+		}
+		events = append(events, convertEvent(ccEvent))
+	}
+
+	return events, Warnings(warnings), nil
+}
+
+func convertEvent(ccEvent ccv3.Event) Event {
+	return Event{
+		GUID:             ccEvent.GUID,
+		Time:             ccEvent.CreatedAt,
+		Type:             ccEvent.Type,
+		ActorName:        ccEvent.ActorName,
+		TargetGUID:       ccEvent.TargetGUID,
+		TargetType:       ccEvent.TargetType,
+		TargetName:       ccEvent.TargetName,
+		SpaceGUID:        ccEvent.SpaceGUID,
+		OrganizationGUID: ccEvent.OrganizationGUID,
+		Description:      generateDescription(ccEvent.Data),
+	}
+}
+
 var knownMetadataKeys = []string{
 	"index",
 	"reason",
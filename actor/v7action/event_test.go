@@ -2,6 +2,7 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
@@ -123,4 +124,113 @@ var _ = Describe("Event Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetAuditEvents", func() {
+		var (
+			filter   EventFilter
+			events   []Event
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			filter = EventFilter{}
+
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv3.Event{
+					{
+						GUID:             "event-1",
+						Type:             "audit.app.update",
+						ActorName:        "admin",
+						TargetGUID:       "app-guid",
+						TargetType:       "app",
+						TargetName:       "some-app",
+						SpaceGUID:        "space-guid",
+						OrganizationGUID: "org-guid",
+						Data:             map[string]interface{}{"request": map[string]interface{}{"instances": float64(3)}},
+					},
+					{GUID: "event-2", Type: "audit.service_instance.create", ActorName: "someone-else"},
+				},
+				ccv3.Warnings{"some-event-warnings"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, err = actor.GetAuditEvents(filter)
+		})
+
+		When("no filter is given", func() {
+			It("requests all events, newest first", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+
+			It("returns the events and warnings", func() {
+				Expect(warnings).To(ConsistOf("some-event-warnings"))
+				Expect(events).To(Equal([]Event{
+					{
+						GUID:             "event-1",
+						Type:             "audit.app.update",
+						ActorName:        "admin",
+						TargetGUID:       "app-guid",
+						TargetType:       "app",
+						TargetName:       "some-app",
+						SpaceGUID:        "space-guid",
+						OrganizationGUID: "org-guid",
+						Description:      "instances: 3",
+					},
+					{GUID: "event-2", Type: "audit.service_instance.create", ActorName: "someone-else"},
+				}))
+			})
+		})
+
+		When("a filter is given", func() {
+			BeforeEach(func() {
+				filter = EventFilter{
+					Types:             []string{"audit.app.update", "audit.app.create"},
+					TargetGUIDs:       []string{"app-guid"},
+					SpaceGUIDs:        []string{"space-guid"},
+					OrganizationGUIDs: []string{"org-guid"},
+					ActorName:         "admin",
+					Since:             time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					Until:             time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+				}
+			})
+
+			It("passes the filters to the cloud controller", func() {
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.update", "audit.app.create"}},
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"app-guid"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2020-01-01T00:00:00Z"}},
+					ccv3.Query{Key: ccv3.CreatedAtsLessThanOrEqualFilter, Values: []string{"2020-02-01T00:00:00Z"}},
+				))
+			})
+
+			It("filters the events by actor", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].GUID).To(Equal("event-1"))
+			})
+		})
+
+		When("the cc client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"some-event-warnings"}, errors.New("failed to get events"))
+			})
+
+			It("returns the err and warnings", func() {
+				Expect(warnings).To(ConsistOf("some-event-warnings"))
+				Expect(err).To(MatchError("failed to get events"))
+			})
+		})
+	})
 })
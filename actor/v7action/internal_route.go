@@ -0,0 +1,239 @@
+package v7action
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/extract"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+// InternalRouteDestination is an app process that receives the traffic sent
+// to an internal route.
+type InternalRouteDestination struct {
+	App         resources.Application
+	ProcessType string
+	Port        int
+}
+
+// InternalRoute is a route on an internal domain. Apps reach it over the
+// container network, so traffic to it must be allowed by network policies.
+type InternalRoute struct {
+	Route        resources.Route
+	Destinations []InternalRouteDestination
+}
+
+// InternalConnectivityProbe is the outcome of probing an internal route from
+// inside an app instance.
+type InternalConnectivityProbe struct {
+	Addresses []string
+	Connected bool
+}
+
+// GetInternalRoutes returns the internal routes of the space. When appName is
+// not empty, only the routes mapped to that app are returned, with only its
+// destinations.
+func (actor Actor) GetInternalRoutes(spaceGUID string, appName string) ([]InternalRoute, Warnings, error) {
+	var (
+		allWarnings Warnings
+		routes      []resources.Route
+		appGUID     string
+	)
+
+	if appName == "" {
+		var (
+			warnings Warnings
+			err      error
+		)
+		routes, warnings, err = actor.GetRoutesBySpace(spaceGUID, "")
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	} else {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		appGUID = app.GUID
+
+		routes, warnings, err = actor.GetApplicationRoutes(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	internalRoutes, warnings, err := actor.toInternalRoutes(routes)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if appGUID != "" {
+		for i, internalRoute := range internalRoutes {
+			var destinations []InternalRouteDestination
+			for _, destination := range internalRoute.Destinations {
+				if destination.App.GUID == appGUID {
+					destinations = append(destinations, destination)
+				}
+			}
+			internalRoutes[i].Destinations = destinations
+		}
+	}
+
+	return internalRoutes, allWarnings, nil
+}
+
+// GetInternalRoute returns the route with the given path in the space. It
+// returns a RouteNotInternalError when the route is not on an internal
+// domain.
+func (actor Actor) GetInternalRoute(routePath string, spaceGUID string) (InternalRoute, Warnings, error) {
+	route, allWarnings, err := actor.GetRoute(routePath, spaceGUID)
+	if err != nil {
+		return InternalRoute{}, allWarnings, err
+	}
+
+	internalRoutes, warnings, err := actor.toInternalRoutes([]resources.Route{route})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return InternalRoute{}, allWarnings, err
+	}
+
+	if len(internalRoutes) == 0 {
+		return InternalRoute{}, allWarnings, actionerror.RouteNotInternalError{URL: route.URL}
+	}
+
+	return internalRoutes[0], allWarnings, nil
+}
+
+// InternalConnectivityProbeCommand returns a shell command that resolves
+// host and tries to open a TCP connection to it on port. Its output is read by
+// ParseInternalConnectivityProbe. The command needs bash, getent and timeout
+// in the container. host is quoted, and only reaches the inner bash as an
+// argument, so it is never parsed as shell code.
+func InternalConnectivityProbeCommand(host string, port int) string {
+	return fmt.Sprintf(
+		`host='%s'; addrs=$(getent hosts "$host" | awk '{print $1}' | tr '\n' ' '); echo "dns:$addrs"; `+
+			`if [ -n "$addrs" ] && timeout 5 bash -c '</dev/tcp/$0/$1' "$host" %d 2>/dev/null; then echo tcp:open; else echo tcp:closed; fi`,
+		strings.ReplaceAll(host, "'", `'\''`), port,
+	)
+}
+
+// ParseInternalConnectivityProbe reads the output of the command returned by
+// InternalConnectivityProbeCommand.
+func ParseInternalConnectivityProbe(output []byte) (InternalConnectivityProbe, error) {
+	var (
+		probe  InternalConnectivityProbe
+		sawDNS bool
+		sawTCP bool
+	)
+
+	for _, line := range strings.Split(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "dns:"):
+			sawDNS = true
+			probe.Addresses = strings.Fields(strings.TrimPrefix(line, "dns:"))
+		case line == "tcp:open":
+			sawTCP = true
+			probe.Connected = true
+		case line == "tcp:closed":
+			sawTCP = true
+		}
+	}
+
+	if !sawDNS || !sawTCP {
+		return InternalConnectivityProbe{}, actionerror.InternalConnectivityProbeError{Output: strings.TrimSpace(string(output))}
+	}
+
+	return probe, nil
+}
+
+func (actor Actor) toInternalRoutes(routes []resources.Route) ([]InternalRoute, Warnings, error) {
+	if len(routes) == 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		domains []resources.Domain
+		apps    []resources.Application
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(
+				extract.UniqueList("DomainGUID", routes),
+				func(guids []string) (ccv3.Warnings, error) {
+					batch, warnings, err := actor.CloudControllerClient.GetDomains(ccv3.Query{
+						Key:    ccv3.GUIDFilter,
+						Values: guids,
+					})
+					domains = append(domains, batch...)
+					return warnings, err
+				},
+			)
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(
+				extract.UniqueList("Destinations.App.GUID", routes),
+				func(guids []string) (ccv3.Warnings, error) {
+					batch, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{
+						Key:    ccv3.GUIDFilter,
+						Values: guids,
+					})
+					apps = append(apps, batch...)
+					return warnings, err
+				},
+			)
+		},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	internalDomainGUIDs := map[string]bool{}
+	for _, domain := range domains {
+		if domain.Internal.IsSet && domain.Internal.Value {
+			internalDomainGUIDs[domain.GUID] = true
+		}
+	}
+
+	appsByGUID := lookuptable.AppFromGUID(apps)
+
+	var internalRoutes []InternalRoute
+	for _, route := range routes {
+		if !internalDomainGUIDs[route.DomainGUID] {
+			continue
+		}
+
+		internalRoute := InternalRoute{Route: route}
+		for _, destination := range route.Destinations {
+			processType := destination.App.Process.Type
+			if processType == "" {
+				processType = constant.ProcessTypeWeb
+			}
+
+			internalRoute.Destinations = append(internalRoute.Destinations, InternalRouteDestination{
+				App:         appsByGUID[destination.App.GUID],
+				ProcessType: processType,
+				Port:        destination.Port,
+			})
+		}
+		internalRoutes = append(internalRoutes, internalRoute)
+	}
+
+	sort.SliceStable(internalRoutes, func(i, j int) bool {
+		return internalRoutes[i].Route.URL < internalRoutes[j].Route.URL
+	})
+
+	return internalRoutes, Warnings(warnings), nil
+}
@@ -0,0 +1,219 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Internal Route Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+
+		internalDomain resources.Domain
+		sharedDomain   resources.Domain
+		backendApp     resources.Application
+		workerApp      resources.Application
+	)
+
+	destination := func(appGUID string, processType string, port int) resources.RouteDestination {
+		destination := resources.RouteDestination{App: resources.RouteDestinationApp{GUID: appGUID}, Port: port}
+		destination.App.Process.Type = processType
+		return destination
+	}
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		internalDomain = resources.Domain{GUID: "internal-domain-guid", Name: "apps.internal", Internal: types.NullBool{IsSet: true, Value: true}}
+		sharedDomain = resources.Domain{GUID: "shared-domain-guid", Name: "example.com"}
+		backendApp = resources.Application{GUID: "backend-guid", Name: "backend", SpaceGUID: "space-guid"}
+		workerApp = resources.Application{GUID: "worker-guid", Name: "worker", SpaceGUID: "space-guid"}
+	})
+
+	Describe("GetInternalRoutes", func() {
+		var (
+			appName        string
+			internalRoutes []InternalRoute
+		)
+
+		BeforeEach(func() {
+			appName = ""
+
+			fakeCloudControllerClient.GetRoutesReturns([]resources.Route{
+				{GUID: "public-route-guid", URL: "backend.example.com", DomainGUID: "shared-domain-guid", Destinations: []resources.RouteDestination{destination("backend-guid", "", 8080)}},
+				{GUID: "worker-route-guid", URL: "worker.apps.internal", DomainGUID: "internal-domain-guid", Destinations: []resources.RouteDestination{destination("worker-guid", "worker", 9090), destination("backend-guid", "web", 8080)}},
+				{GUID: "backend-route-guid", URL: "backend.apps.internal", DomainGUID: "internal-domain-guid", Destinations: []resources.RouteDestination{destination("backend-guid", "", 8080)}},
+			}, ccv3.Warnings{"get-routes-warning"}, nil)
+			fakeCloudControllerClient.GetDomainsReturns([]resources.Domain{internalDomain, sharedDomain}, ccv3.Warnings{"get-domains-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{backendApp, workerApp}, ccv3.Warnings{"get-apps-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			internalRoutes, warnings, executeErr = actor.GetInternalRoutes("space-guid", appName)
+		})
+
+		It("returns the routes of the space on internal domains", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-routes-warning", "get-domains-warning", "get-apps-warning"))
+
+			Expect(internalRoutes).To(HaveLen(2))
+			Expect(internalRoutes[0].Route.GUID).To(Equal("backend-route-guid"))
+			Expect(internalRoutes[0].Destinations).To(Equal([]InternalRouteDestination{
+				{App: backendApp, ProcessType: "web", Port: 8080},
+			}))
+			Expect(internalRoutes[1].Route.GUID).To(Equal("worker-route-guid"))
+			Expect(internalRoutes[1].Destinations).To(Equal([]InternalRouteDestination{
+				{App: workerApp, ProcessType: "worker", Port: 9090},
+				{App: backendApp, ProcessType: "web", Port: 8080},
+			}))
+
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetDomainsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"shared-domain-guid", "internal-domain-guid"}},
+			))
+		})
+
+		When("an app is given", func() {
+			BeforeEach(func() {
+				appName = "backend"
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []resources.Application{backendApp}, ccv3.Warnings{"get-app-warning"}, nil)
+				fakeCloudControllerClient.GetApplicationRoutesReturns([]resources.Route{
+					{GUID: "worker-route-guid", URL: "worker.apps.internal", DomainGUID: "internal-domain-guid", Destinations: []resources.RouteDestination{destination("worker-guid", "worker", 9090), destination("backend-guid", "web", 8080)}},
+				}, ccv3.Warnings{"get-app-routes-warning"}, nil)
+			})
+
+			It("returns only the app's routes and destinations", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-app-routes-warning", "get-domains-warning", "get-apps-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationRoutesArgsForCall(0)).To(Equal("backend-guid"))
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+
+				Expect(internalRoutes).To(HaveLen(1))
+				Expect(internalRoutes[0].Destinations).To(Equal([]InternalRouteDestination{
+					{App: backendApp, ProcessType: "web", Port: 8080},
+				}))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				appName = "missing"
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "missing"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+
+		When("getting the domains fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(nil, ccv3.Warnings{"get-domains-warning"}, errors.New("domains-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("domains-error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-domains-warning"))
+			})
+		})
+	})
+
+	Describe("GetInternalRoute", func() {
+		var internalRoute InternalRoute
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetDomainsReturnsOnCall(0, nil, ccv3.Warnings{"get-domain-by-name-warning"}, nil)
+			fakeCloudControllerClient.GetDomainsReturnsOnCall(1, []resources.Domain{internalDomain}, ccv3.Warnings{"get-domain-by-name-warning"}, nil)
+			fakeCloudControllerClient.GetDomainsReturnsOnCall(2, []resources.Domain{internalDomain}, ccv3.Warnings{"get-domains-warning"}, nil)
+			fakeCloudControllerClient.GetRoutesReturns([]resources.Route{
+				{GUID: "backend-route-guid", URL: "backend.apps.internal", DomainGUID: "internal-domain-guid", Destinations: []resources.RouteDestination{destination("backend-guid", "", 8080)}},
+			}, ccv3.Warnings{"get-route-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{backendApp}, ccv3.Warnings{"get-apps-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			internalRoute, warnings, executeErr = actor.GetInternalRoute("backend.apps.internal", "space-guid")
+		})
+
+		It("returns the route with its destinations", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-domain-by-name-warning", "get-domain-by-name-warning", "get-route-warning", "get-domains-warning", "get-apps-warning"))
+
+			Expect(internalRoute.Route.GUID).To(Equal("backend-route-guid"))
+			Expect(internalRoute.Destinations).To(Equal([]InternalRouteDestination{
+				{App: backendApp, ProcessType: "web", Port: 8080},
+			}))
+		})
+
+		When("the route is not on an internal domain", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturnsOnCall(2, []resources.Domain{{GUID: "internal-domain-guid", Name: "apps.internal"}}, ccv3.Warnings{"get-domains-warning"}, nil)
+			})
+
+			It("returns a RouteNotInternalError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteNotInternalError{URL: "backend.apps.internal"}))
+			})
+		})
+
+		When("the route does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-route-warning"}, nil)
+			})
+
+			It("returns a RouteNotFoundError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(actionerror.RouteNotFoundError{}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("InternalConnectivityProbeCommand", func() {
+		It("resolves the host and connects to the port", func() {
+			command := InternalConnectivityProbeCommand("backend.apps.internal", 8080)
+			Expect(command).To(HavePrefix(`host='backend.apps.internal';`))
+			Expect(command).To(ContainSubstring(`getent hosts "$host"`))
+			Expect(command).To(ContainSubstring(`bash -c '</dev/tcp/$0/$1' "$host" 8080`))
+		})
+
+		It("quotes the host so that it is not run as shell code", func() {
+			command := InternalConnectivityProbeCommand("x';touch /tmp/pwned;'", 8080)
+			Expect(command).To(HavePrefix(`host='x'\'';touch /tmp/pwned;'\''';`))
+		})
+	})
+
+	Describe("ParseInternalConnectivityProbe", func() {
+		It("reads the resolved addresses and the connection outcome", func() {
+			probe, err := ParseInternalConnectivityProbe([]byte("dns:10.255.0.1 10.255.0.2 \ntcp:open\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(probe).To(Equal(InternalConnectivityProbe{Addresses: []string{"10.255.0.1", "10.255.0.2"}, Connected: true}))
+		})
+
+		It("reports unresolved hosts and refused connections", func() {
+			probe, err := ParseInternalConnectivityProbe([]byte("dns:\ntcp:closed\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(probe.Addresses).To(BeEmpty())
+			Expect(probe.Connected).To(BeFalse())
+		})
+
+		It("returns an error for unexpected output", func() {
+			_, err := ParseInternalConnectivityProbe([]byte("sh: getent: not found\n"))
+			Expect(err).To(MatchError(actionerror.InternalConnectivityProbeError{Output: "sh: getent: not found"}))
+		})
+	})
+})
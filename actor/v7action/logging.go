@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/resources"
 	"github.com/SermoDigital/jose/jws"
 )
 
@@ -21,6 +22,33 @@ func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string,
 	return messages, logErrs, cancelFunc, allWarnings, err
 }
 
+// GetStreamingLogsForTask streams the log lines emitted by the given task,
+// leaving out the lines of the app's other processes and tasks. Task log
+// lines carry the task's name but not its GUID, so lines from earlier runs of
+// a task with the same name are told apart by the task's creation time.
+func (actor Actor) GetStreamingLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc) {
+	messages, logErrs, cancelFunc := sharedaction.GetStreamingLogs(appGUID, client)
+
+	taskSourceType := "APP/TASK/" + task.Name
+	createdAt, _ := time.Parse(time.RFC3339, task.CreatedAt)
+
+	taskMessages := make(chan sharedaction.LogMessage, cap(messages))
+	go func() {
+		defer close(taskMessages)
+		for message := range messages {
+			if message.SourceType() != taskSourceType {
+				continue
+			}
+			if !createdAt.IsZero() && message.Timestamp().Before(createdAt) {
+				continue
+			}
+			taskMessages <- message
+		}
+	}()
+
+	return taskMessages, logErrs, cancelFunc
+}
+
 func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
@@ -248,4 +248,75 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetStreamingLogsForTask", func() {
+		var (
+			messages      <-chan sharedaction.LogMessage
+			logErrs       <-chan error
+			stopStreaming context.CancelFunc
+		)
+
+		AfterEach(func() {
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		})
+
+		BeforeEach(func() {
+			envelope := func(age time.Duration, sourceType string, payload string) *loggregator_v2.Envelope {
+				return &loggregator_v2.Envelope{
+					// in the past to get past Walk delay
+					Timestamp:  time.Now().Add(-age).UnixNano(),
+					SourceId:   "some-app-guid",
+					InstanceId: "0",
+					Message: &loggregator_v2.Envelope_Log{
+						Log: &loggregator_v2.Log{
+							Payload: []byte(payload),
+							Type:    loggregator_v2.Log_OUT,
+						},
+					},
+					Tags: map[string]string{
+						"source_type": sourceType,
+					},
+				}
+			}
+
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				if fakeLogCacheClient.ReadCallCount() > 2 {
+					stopStreaming()
+				}
+
+				return []*loggregator_v2.Envelope{
+					envelope(time.Hour, "APP/TASK/migrate", "earlier-run-message"),
+					envelope(4*time.Second, "APP/TASK/migrate", "task-message-1"),
+					envelope(3*time.Second, "APP/PROC/WEB", "web-message"),
+					envelope(3*time.Second, "APP/TASK/other-task", "other-task-message"),
+					envelope(2*time.Second, "APP/TASK/migrate", "task-message-2"),
+				}, ctx.Err()
+			}
+		})
+
+		It("only passes through the log messages of this run of the task", func() {
+			var message sharedaction.LogMessage
+
+			task := resources.Task{
+				Name:      "migrate",
+				CreatedAt: time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+			}
+			messages, logErrs, stopStreaming = actor.GetStreamingLogsForTask("some-app-guid", task, fakeLogCacheClient)
+
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("task-message-1"))
+
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("task-message-2"))
+
+			_, sourceID, _, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("some-app-guid"))
+		})
+	})
 })
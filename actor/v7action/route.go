@@ -26,7 +26,7 @@ type RouteSummary struct {
 	ServiceInstanceName string
 }
 
-func (actor Actor) CreateRoute(spaceGUID, domainName, hostname, path string, port int) (resources.Route, Warnings, error) {
+func (actor Actor) CreateRoute(spaceGUID, domainName, hostname, path string, port int, options map[string]*string) (resources.Route, Warnings, error) {
 	allWarnings := Warnings{}
 	domain, warnings, err := actor.GetDomainByName(domainName)
 	allWarnings = append(allWarnings, warnings...)
@@ -41,6 +41,7 @@ func (actor Actor) CreateRoute(spaceGUID, domainName, hostname, path string, por
 		Host:       hostname,
 		Path:       path,
 		Port:       port,
+		Options:    options,
 	})
 
 	actorWarnings := Warnings(apiWarnings)
@@ -53,6 +54,13 @@ func (actor Actor) CreateRoute(spaceGUID, domainName, hostname, path string, por
 	return route, allWarnings, err
 }
 
+// UpdateRoute sets the options of the route. Options with a nil value are
+// removed.
+func (actor Actor) UpdateRoute(routeGUID string, options map[string]*string) (resources.Route, Warnings, error) {
+	route, warnings, err := actor.CloudControllerClient.UpdateRoute(routeGUID, options)
+	return route, Warnings(warnings), err
+}
+
 func (actor Actor) GetRouteDestinations(routeGUID string) ([]resources.RouteDestination, Warnings, error) {
 	destinations, warnings, err := actor.CloudControllerClient.GetRouteDestinations(routeGUID)
 
@@ -432,6 +440,127 @@ func (actor Actor) GetApplicationRoutes(appGUID string) ([]resources.Route, Warn
 	return routes, allWarnings, nil
 }
 
+// RouteWeight is the share of a route's traffic that an app receives.
+type RouteWeight struct {
+	AppGUID string
+	Weight  int
+}
+
+// SetRouteWeights atomically replaces the destinations of the route with
+// weighted destinations for the given apps. An app that is already mapped to
+// the route keeps all of its destinations, with their process types, ports
+// and protocols, and its weight is split evenly across them; an app that is
+// not yet mapped gets a single destination for its web process. An app's
+// weight must be at least the number of its destinations.
+func (actor Actor) SetRouteWeights(route resources.Route, weights []RouteWeight) ([]resources.RouteDestination, Warnings, error) {
+	existing := map[string][]resources.RouteDestination{}
+	for _, destination := range route.Destinations {
+		existing[destination.App.GUID] = append(existing[destination.App.GUID], destination)
+	}
+
+	var destinations []resources.RouteDestination
+	for _, routeWeight := range weights {
+		current := existing[routeWeight.AppGUID]
+		if len(current) == 0 {
+			weight := routeWeight.Weight
+			destinations = append(destinations, resources.RouteDestination{
+				App:    resources.RouteDestinationApp{GUID: routeWeight.AppGUID},
+				Weight: &weight,
+			})
+			continue
+		}
+
+		if routeWeight.Weight < len(current) {
+			return nil, nil, actionerror.RouteWeightTooSmallError{
+				AppGUID:      routeWeight.AppGUID,
+				Weight:       routeWeight.Weight,
+				Destinations: len(current),
+			}
+		}
+
+		for i, weight := range splitRouteWeight(routeWeight.Weight, len(current)) {
+			weight := weight
+			destination := resources.RouteDestination{
+				App:      resources.RouteDestinationApp{GUID: routeWeight.AppGUID},
+				Port:     current[i].Port,
+				Protocol: current[i].Protocol,
+				Weight:   &weight,
+			}
+			destination.App.Process = current[i].App.Process
+			destinations = append(destinations, destination)
+		}
+	}
+
+	return actor.ReplaceRouteDestinations(route.GUID, destinations)
+}
+
+// splitRouteWeight splits weight evenly into count parts. Leftovers go to the
+// first parts. The weight must be at least count, because the API does not
+// accept weights smaller than 1.
+func splitRouteWeight(weight int, count int) []int {
+	parts := make([]int, count)
+	for i := range parts {
+		parts[i] = weight / count
+		if i < weight%count {
+			parts[i]++
+		}
+	}
+	return parts
+}
+
+// AddRouteDestinations adds the destinations to the route, keeping their
+// process types, ports and protocols.
+func (actor Actor) AddRouteDestinations(routeGUID string, destinations []resources.RouteDestination) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.AddRouteDestinations(routeGUID, destinations)
+	return Warnings(warnings), err
+}
+
+// ReplaceRouteDestinations replaces all destinations of the route, for example
+// to restore them after an aborted traffic shift.
+func (actor Actor) ReplaceRouteDestinations(routeGUID string, destinations []resources.RouteDestination) ([]resources.RouteDestination, Warnings, error) {
+	newDestinations, warnings, err := actor.CloudControllerClient.ReplaceRouteDestinations(routeGUID, destinations)
+	return newDestinations, Warnings(warnings), err
+}
+
+// RouteTrafficShares returns the percentage of the route's traffic that each
+// destination receives, in the order of the destinations. Destinations of a
+// route without weights share its traffic evenly. The shares always add up to
+// 100; rounding leftovers go to the first destinations.
+func RouteTrafficShares(destinations []resources.RouteDestination) []int {
+	shares := make([]int, len(destinations))
+	if len(destinations) == 0 {
+		return shares
+	}
+
+	weights := make([]int, len(destinations))
+	total := 0
+	for i, destination := range destinations {
+		if destination.Weight != nil {
+			weights[i] = *destination.Weight
+			total += weights[i]
+		}
+	}
+	if total == 0 {
+		for i := range weights {
+			weights[i] = 1
+		}
+		total = len(weights)
+	}
+
+	remainder := 100
+	for i, weight := range weights {
+		shares[i] = weight * 100 / total
+		remainder -= shares[i]
+	}
+	for i := 0; remainder > 0; i = (i + 1) % len(shares) {
+		if weights[i] > 0 {
+			shares[i]++
+			remainder--
+		}
+	}
+	return shares
+}
+
 func (actor Actor) MoveRoute(routeGUID string, spaceGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.MoveRoute(routeGUID, spaceGUID)
 	return Warnings(warnings), err
@@ -33,16 +33,18 @@ var _ = Describe("Route Actions", func() {
 			hostname   string
 			path       string
 			port       int
+			options    map[string]*string
 		)
 
 		BeforeEach(func() {
 			hostname = ""
 			path = ""
 			port = 0
+			options = nil
 		})
 
 		JustBeforeEach(func() {
-			_, warnings, executeErr = actor.CreateRoute("space-guid", "domain-name", hostname, path, port)
+			_, warnings, executeErr = actor.CreateRoute("space-guid", "domain-name", hostname, path, port, options)
 		})
 
 		When("the API layer calls are successful", func() {
@@ -106,6 +108,20 @@ var _ = Describe("Route Actions", func() {
 					))
 				})
 			})
+
+			When("options are given", func() {
+				BeforeEach(func() {
+					leastConnection := "least-connection"
+					options = map[string]*string{"loadbalancing": &leastConnection}
+				})
+
+				It("creates the route with the options", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					passedRoute := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+					Expect(passedRoute.Options).To(Equal(options))
+				})
+			})
 		})
 
 		When("the API call to get the domain returns an error", func() {
@@ -1060,6 +1076,233 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("UpdateRoute", func() {
+		var (
+			options    map[string]*string
+			route      resources.Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			roundRobin := "round-robin"
+			options = map[string]*string{"loadbalancing": &roundRobin}
+
+			fakeCloudControllerClient.UpdateRouteReturns(
+				resources.Route{GUID: "route-guid", Options: options},
+				ccv3.Warnings{"update-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = actor.UpdateRoute("route-guid", options)
+		})
+
+		It("updates the options of the route", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("update-warning"))
+			Expect(route).To(Equal(resources.Route{GUID: "route-guid", Options: options}))
+
+			Expect(fakeCloudControllerClient.UpdateRouteCallCount()).To(Equal(1))
+			routeGUID, passedOptions := fakeCloudControllerClient.UpdateRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(passedOptions).To(Equal(options))
+		})
+
+		When("the update fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateRouteReturns(resources.Route{}, ccv3.Warnings{"update-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("update-warning"))
+			})
+		})
+	})
+
+	Describe("AddRouteDestinations", func() {
+		var destinations []resources.RouteDestination
+
+		BeforeEach(func() {
+			destinations = []resources.RouteDestination{
+				{App: resources.RouteDestinationApp{GUID: "app-guid", Process: struct{ Type string }{Type: "worker"}}, Port: 9090},
+			}
+			fakeCloudControllerClient.AddRouteDestinationsReturns(ccv3.Warnings{"add-warning"}, errors.New("boom"))
+		})
+
+		It("adds the destinations and returns the error and warnings", func() {
+			warnings, err := actor.AddRouteDestinations("route-guid", destinations)
+			Expect(err).To(MatchError("boom"))
+			Expect(warnings).To(ConsistOf("add-warning"))
+
+			Expect(fakeCloudControllerClient.AddRouteDestinationsCallCount()).To(Equal(1))
+			routeGUID, passedDestinations := fakeCloudControllerClient.AddRouteDestinationsArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(passedDestinations).To(Equal(destinations))
+		})
+	})
+
+	Describe("SetRouteWeights", func() {
+		var (
+			route        resources.Route
+			weights      []RouteWeight
+			destinations []resources.RouteDestination
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			weights = []RouteWeight{
+				{AppGUID: "app-1-guid", Weight: 90},
+				{AppGUID: "app-2-guid", Weight: 10},
+			}
+			route = resources.Route{
+				GUID: "route-guid",
+				Destinations: []resources.RouteDestination{
+					{
+						GUID:     "destination-guid",
+						App:      resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "worker"}},
+						Port:     8080,
+						Protocol: "http2",
+					},
+				},
+			}
+
+			fakeCloudControllerClient.ReplaceRouteDestinationsReturns(
+				[]resources.RouteDestination{{GUID: "new-destination-guid"}},
+				ccv3.Warnings{"replace-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			destinations, warnings, executeErr = actor.SetRouteWeights(route, weights)
+		})
+
+		It("replaces the destinations with weighted ones, keeping existing destination settings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("replace-warning"))
+			Expect(destinations).To(Equal([]resources.RouteDestination{{GUID: "new-destination-guid"}}))
+
+			Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(1))
+			routeGUID, passedDestinations := fakeCloudControllerClient.ReplaceRouteDestinationsArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+
+			ninety, ten := 90, 10
+			Expect(passedDestinations).To(Equal([]resources.RouteDestination{
+				{
+					App:      resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "worker"}},
+					Port:     8080,
+					Protocol: "http2",
+					Weight:   &ninety,
+				},
+				{
+					App:    resources.RouteDestinationApp{GUID: "app-2-guid"},
+					Weight: &ten,
+				},
+			}))
+		})
+
+		When("an app has several destinations on the route", func() {
+			BeforeEach(func() {
+				route.Destinations = append(route.Destinations,
+					resources.RouteDestination{
+						GUID: "web-destination-guid",
+						App:  resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
+						Port: 8080,
+					},
+					resources.RouteDestination{
+						GUID: "other-port-destination-guid",
+						App:  resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
+						Port: 9090,
+					},
+				)
+			})
+
+			It("keeps every destination of the app and splits its weight across them", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				_, passedDestinations := fakeCloudControllerClient.ReplaceRouteDestinationsArgsForCall(0)
+				thirty, ten := 30, 10
+				Expect(passedDestinations).To(Equal([]resources.RouteDestination{
+					{
+						App:      resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "worker"}},
+						Port:     8080,
+						Protocol: "http2",
+						Weight:   &thirty,
+					},
+					{
+						App:    resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
+						Port:   8080,
+						Weight: &thirty,
+					},
+					{
+						App:    resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
+						Port:   9090,
+						Weight: &thirty,
+					},
+					{
+						App:    resources.RouteDestinationApp{GUID: "app-2-guid"},
+						Weight: &ten,
+					},
+				}))
+			})
+
+			When("the app's weight is smaller than its number of destinations", func() {
+				BeforeEach(func() {
+					weights = []RouteWeight{
+						{AppGUID: "app-1-guid", Weight: 2},
+						{AppGUID: "app-2-guid", Weight: 98},
+					}
+				})
+
+				It("returns an error without changing the route", func() {
+					Expect(executeErr).To(MatchError(actionerror.RouteWeightTooSmallError{
+						AppGUID:      "app-1-guid",
+						Weight:       2,
+						Destinations: 3,
+					}))
+					Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("replacing the destinations fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ReplaceRouteDestinationsReturns(nil, ccv3.Warnings{"replace-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("replace-warning"))
+			})
+		})
+	})
+
+	Describe("RouteTrafficShares", func() {
+		It("splits the traffic by weight", func() {
+			three, one := 3, 1
+			Expect(RouteTrafficShares([]resources.RouteDestination{
+				{Weight: &three},
+				{Weight: &one},
+			})).To(Equal([]int{75, 25}))
+		})
+
+		It("splits the traffic evenly when the route is not weighted", func() {
+			Expect(RouteTrafficShares([]resources.RouteDestination{{}, {}})).To(Equal([]int{50, 50}))
+		})
+
+		It("gives rounding leftovers to the first destinations", func() {
+			Expect(RouteTrafficShares([]resources.RouteDestination{{}, {}, {}})).To(Equal([]int{34, 33, 33}))
+		})
+
+		It("returns no shares when there are no destinations", func() {
+			Expect(RouteTrafficShares(nil)).To(BeEmpty())
+		})
+	})
+
 	Describe("GetRouteDestinations", func() {
 		var (
 			routeGUID    string
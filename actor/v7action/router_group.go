@@ -1,13 +1,44 @@
 package v7action
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/router"
 	"code.cloudfoundry.org/cli/api/router/routererror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
 )
 
 type RouterGroup router.RouterGroup
 
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Start int
+	End   int
+}
+
+// Contains reports whether the port is in the range.
+func (r PortRange) Contains(port int) bool {
+	return r.Start <= port && port <= r.End
+}
+
+// RouterGroupDetails is a router group with the use of its reservable ports.
+type RouterGroupDetails struct {
+	RouterGroup     RouterGroup
+	ReservablePorts []PortRange
+	// Routes are the TCP routes on the domains of the router group that the
+	// user can see, sorted by port.
+	Routes []resources.Route
+	// FreePorts is the number of reservable ports not used by Routes.
+	FreePorts int
+}
+
 func (actor Actor) GetRouterGroups() ([]RouterGroup, error) {
 	var routerGroups []RouterGroup
 
@@ -35,3 +66,195 @@ func (actor Actor) GetRouterGroupByName(name string) (RouterGroup, error) {
 
 	return RouterGroup(apiRouterGroup), nil
 }
+
+// GetRouterGroupDetails returns the router group with the given name, its
+// reservable port ranges and the TCP routes using its ports.
+func (actor Actor) GetRouterGroupDetails(name string) (RouterGroupDetails, Warnings, error) {
+	routerGroup, err := actor.GetRouterGroupByName(name)
+	if err != nil {
+		return RouterGroupDetails{}, nil, err
+	}
+
+	portRanges, err := parseReservablePorts(routerGroup.ReservablePorts)
+	if err != nil {
+		return RouterGroupDetails{}, nil, err
+	}
+
+	routes, warnings, err := actor.getRouterGroupRoutes(routerGroup.GUID)
+	if err != nil {
+		return RouterGroupDetails{}, warnings, err
+	}
+
+	usedPorts := map[int]bool{}
+	for _, route := range routes {
+		usedPorts[route.Port] = true
+	}
+
+	freePorts := 0
+	for _, portRange := range portRanges {
+		for port := portRange.Start; port <= portRange.End; port++ {
+			if !usedPorts[port] {
+				freePorts++
+			}
+		}
+	}
+
+	return RouterGroupDetails{
+		RouterGroup:     routerGroup,
+		ReservablePorts: portRanges,
+		Routes:          routes,
+		FreePorts:       freePorts,
+	}, warnings, nil
+}
+
+// CreateRouteInPortRange creates a TCP route on the domain with the first
+// port of portRange that is reservable in the domain's router group and not
+// used by another route. Ports taken by routes the user cannot see, on this
+// or another domain of the router group, are skipped when the Cloud
+// Controller rejects them.
+func (actor Actor) CreateRouteInPortRange(spaceGUID string, domainName string, portRange PortRange, options map[string]*string) (resources.Route, Warnings, error) {
+	domain, allWarnings, err := actor.GetDomainByName(domainName)
+	if err != nil {
+		return resources.Route{}, allWarnings, err
+	}
+
+	if !domain.IsTCP() {
+		return resources.Route{}, allWarnings, actionerror.PortRangeRequiresTCPDomainError{Domain: domainName}
+	}
+
+	routerGroups, err := actor.GetRouterGroups()
+	if err != nil {
+		return resources.Route{}, allWarnings, err
+	}
+
+	var (
+		reservablePorts []PortRange
+		foundGroup      bool
+	)
+	for _, routerGroup := range routerGroups {
+		if routerGroup.GUID == domain.RouterGroup {
+			foundGroup = true
+			reservablePorts, err = parseReservablePorts(routerGroup.ReservablePorts)
+			if err != nil {
+				return resources.Route{}, allWarnings, err
+			}
+		}
+	}
+
+	if !foundGroup {
+		return resources.Route{}, allWarnings, actionerror.RouterGroupNotFoundError{Name: domain.RouterGroup}
+	}
+
+	routes, warnings, err := actor.getRouterGroupRoutes(domain.RouterGroup)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return resources.Route{}, allWarnings, err
+	}
+
+	usedPorts := map[int]bool{}
+	for _, route := range routes {
+		usedPorts[route.Port] = true
+	}
+
+	for port := portRange.Start; port <= portRange.End; port++ {
+		if usedPorts[port] || !portInRanges(port, reservablePorts) {
+			continue
+		}
+
+		route, apiWarnings, err := actor.CloudControllerClient.CreateRoute(resources.Route{
+			SpaceGUID:  spaceGUID,
+			DomainGUID: domain.GUID,
+			Port:       port,
+			Options:    options,
+		})
+		allWarnings = append(allWarnings, apiWarnings...)
+		switch err.(type) {
+		case ccerror.RouteNotUniqueError, ccerror.RoutePortNotAvailableError:
+			continue
+		}
+
+		return route, allWarnings, err
+	}
+
+	return resources.Route{}, allWarnings, actionerror.NoFreeRoutePortError{
+		Domain:    domainName,
+		StartPort: portRange.Start,
+		EndPort:   portRange.End,
+	}
+}
+
+// getRouterGroupRoutes returns the routes on the domains of the router
+// group, sorted by port.
+func (actor Actor) getRouterGroupRoutes(routerGroupGUID string) ([]resources.Route, Warnings, error) {
+	domains, warnings, err := actor.CloudControllerClient.GetDomains()
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var domainGUIDs []string
+	for _, domain := range domains {
+		if domain.RouterGroup == routerGroupGUID {
+			domainGUIDs = append(domainGUIDs, domain.GUID)
+		}
+	}
+
+	var routes []resources.Route
+	batchWarnings, err := batcher.RequestByGUID(domainGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, warnings, err := actor.CloudControllerClient.GetRoutes(ccv3.Query{
+			Key:    ccv3.DomainGUIDFilter,
+			Values: guids,
+		})
+		routes = append(routes, batch...)
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, batchWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].Port < routes[j].Port
+	})
+
+	return routes, allWarnings, nil
+}
+
+// parseReservablePorts parses the reservable ports of a router group, such as
+// "1024-1033,2000".
+func parseReservablePorts(reservablePorts string) ([]PortRange, error) {
+	var portRanges []PortRange
+	for _, part := range strings.Split(reservablePorts, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		bounds := strings.SplitN(part, "-", 2)
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid reservable ports '%s'", reservablePorts)
+		}
+
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid reservable ports '%s'", reservablePorts)
+			}
+		}
+
+		portRanges = append(portRanges, PortRange{Start: start, End: end})
+	}
+
+	return portRanges, nil
+}
+
+func portInRanges(port int, portRanges []PortRange) bool {
+	for _, portRange := range portRanges {
+		if portRange.Contains(port) {
+			return true
+		}
+	}
+	return false
+}
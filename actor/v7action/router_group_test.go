@@ -6,8 +6,11 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/router"
 	"code.cloudfoundry.org/cli/api/router/routererror"
+	"code.cloudfoundry.org/cli/resources"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -154,4 +157,194 @@ var _ = Describe("Router Group Actions", func() {
 			})
 		})
 	})
+
+	Describe("router group ports", func() {
+		var (
+			fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+			warnings                  Warnings
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil, nil, fakeRoutingClient, nil)
+
+			fakeCloudControllerClient.GetDomainsReturns([]resources.Domain{
+				{GUID: "tcp-domain-guid", Name: "tcp.example.com", RouterGroup: "router-group-guid", Protocols: []string{"tcp"}},
+				{GUID: "other-tcp-domain-guid", Name: "tcp2.example.com", RouterGroup: "router-group-guid", Protocols: []string{"tcp"}},
+				{GUID: "http-domain-guid", Name: "example.com", Protocols: []string{"http"}},
+			}, ccv3.Warnings{"get-domains-warning"}, nil)
+			fakeCloudControllerClient.GetRoutesReturns([]resources.Route{
+				{GUID: "route-2-guid", Port: 1026},
+				{GUID: "route-1-guid", Port: 1024},
+			}, ccv3.Warnings{"get-routes-warning"}, nil)
+		})
+
+		Describe("GetRouterGroupDetails", func() {
+			var details RouterGroupDetails
+
+			BeforeEach(func() {
+				fakeRoutingClient.GetRouterGroupByNameReturns(router.RouterGroup{
+					GUID:            "router-group-guid",
+					Name:            "default-tcp",
+					Type:            "tcp",
+					ReservablePorts: "1024-1027, 2000",
+				}, nil)
+			})
+
+			JustBeforeEach(func() {
+				details, warnings, executeErr = actor.GetRouterGroupDetails("default-tcp")
+			})
+
+			It("returns the reservable ports, the routes using them and the free capacity", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning"))
+
+				Expect(details.RouterGroup.Name).To(Equal("default-tcp"))
+				Expect(details.ReservablePorts).To(Equal([]PortRange{{Start: 1024, End: 1027}, {Start: 2000, End: 2000}}))
+				Expect(details.Routes).To(Equal([]resources.Route{
+					{GUID: "route-1-guid", Port: 1024},
+					{GUID: "route-2-guid", Port: 1026},
+				}))
+				Expect(details.FreePorts).To(Equal(3))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"tcp-domain-guid", "other-tcp-domain-guid"}},
+				))
+			})
+
+			When("the reservable ports cannot be parsed", func() {
+				BeforeEach(func() {
+					fakeRoutingClient.GetRouterGroupByNameReturns(router.RouterGroup{GUID: "router-group-guid", ReservablePorts: "1024-abc"}, nil)
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("invalid reservable ports '1024-abc'"))
+				})
+			})
+
+			When("the router group does not exist", func() {
+				BeforeEach(func() {
+					fakeRoutingClient.GetRouterGroupByNameReturns(router.RouterGroup{}, routererror.ResourceNotFoundError{})
+				})
+
+				It("returns a RouterGroupNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.RouterGroupNotFoundError{Name: "default-tcp"}))
+					Expect(fakeCloudControllerClient.GetDomainsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Describe("CreateRouteInPortRange", func() {
+			var (
+				domainName string
+				portRange  PortRange
+				route      resources.Route
+			)
+
+			BeforeEach(func() {
+				domainName = "tcp.example.com"
+				portRange = PortRange{Start: 1000, End: 1030}
+
+				fakeCloudControllerClient.GetDomainsReturnsOnCall(0, []resources.Domain{
+					{GUID: "tcp-domain-guid", Name: "tcp.example.com", RouterGroup: "router-group-guid", Protocols: []string{"tcp"}},
+				}, ccv3.Warnings{"get-domain-warning"}, nil)
+				fakeRoutingClient.GetRouterGroupsReturns([]router.RouterGroup{
+					{GUID: "other-router-group-guid", ReservablePorts: "1000-1100"},
+					{GUID: "router-group-guid", ReservablePorts: "1024-1027"},
+				}, nil)
+				fakeCloudControllerClient.CreateRouteReturns(resources.Route{GUID: "new-route-guid", Port: 1025}, ccv3.Warnings{"create-route-warning"}, nil)
+			})
+
+			JustBeforeEach(func() {
+				route, warnings, executeErr = actor.CreateRouteInPortRange("space-guid", domainName, portRange, nil)
+			})
+
+			It("creates the route with the first free reservable port of the range", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(route.GUID).To(Equal("new-route-guid"))
+				Expect(warnings).To(ConsistOf("get-domain-warning", "get-domains-warning", "get-routes-warning", "create-route-warning"))
+
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateRouteArgsForCall(0)).To(Equal(resources.Route{
+					SpaceGUID:  "space-guid",
+					DomainGUID: "tcp-domain-guid",
+					Port:       1025,
+				}))
+			})
+
+			When("a free port is taken by a route the user cannot see", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateRouteReturnsOnCall(0, resources.Route{}, ccv3.Warnings{"create-route-warning"}, ccerror.RouteNotUniqueError{})
+				})
+
+				It("tries the next free port", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.CreateRouteArgsForCall(1).Port).To(Equal(1027))
+				})
+			})
+
+			When("a free port is taken by a route on another domain of the router group", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateRouteReturnsOnCall(0, resources.Route{}, ccv3.Warnings{"create-route-warning"}, ccerror.RoutePortNotAvailableError{})
+				})
+
+				It("tries the next free port", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.CreateRouteArgsForCall(1).Port).To(Equal(1027))
+				})
+			})
+
+			When("every port of the range is used or not reservable", func() {
+				BeforeEach(func() {
+					portRange = PortRange{Start: 1026, End: 1026}
+				})
+
+				It("returns a NoFreeRoutePortError", func() {
+					Expect(executeErr).To(MatchError(actionerror.NoFreeRoutePortError{Domain: "tcp.example.com", StartPort: 1026, EndPort: 1026}))
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the domain's router group does not exist", func() {
+				BeforeEach(func() {
+					fakeRoutingClient.GetRouterGroupsReturns([]router.RouterGroup{
+						{GUID: "other-router-group-guid", ReservablePorts: "1000-1100"},
+					}, nil)
+				})
+
+				It("returns a RouterGroupNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.RouterGroupNotFoundError{Name: "router-group-guid"}))
+					Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the domain is not a TCP domain", func() {
+				BeforeEach(func() {
+					domainName = "example.com"
+					fakeCloudControllerClient.GetDomainsReturnsOnCall(0, []resources.Domain{
+						{GUID: "http-domain-guid", Name: "example.com", Protocols: []string{"http"}},
+					}, ccv3.Warnings{"get-domain-warning"}, nil)
+				})
+
+				It("returns a PortRangeRequiresTCPDomainError", func() {
+					Expect(executeErr).To(MatchError(actionerror.PortRangeRequiresTCPDomainError{Domain: "example.com"}))
+					Expect(fakeRoutingClient.GetRouterGroupsCallCount()).To(Equal(0))
+				})
+			})
+
+			When("creating the route fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateRouteReturns(resources.Route{}, ccv3.Warnings{"create-route-warning"}, errors.New("create-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("create-error"))
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
 })
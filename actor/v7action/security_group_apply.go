@@ -0,0 +1,456 @@
+package v7action
+
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+)
+
+type SecurityGroupAction string
+
+const (
+	SecurityGroupCreated SecurityGroupAction = "create"
+	SecurityGroupUpdated SecurityGroupAction = "update"
+	SecurityGroupDeleted SecurityGroupAction = "delete"
+)
+
+// SecurityGroupBinding applies a security group to the apps of a space in a
+// lifecycle phase. A binding without a space applies to the apps of every
+// space.
+type SecurityGroupBinding struct {
+	Lifecycle constant.SecurityGroupLifecycle
+	OrgName   string
+	SpaceName string
+	SpaceGUID string
+}
+
+func (binding SecurityGroupBinding) IsGlobal() bool {
+	return binding.SpaceName == "" && binding.SpaceGUID == ""
+}
+
+func (binding SecurityGroupBinding) key() string {
+	return string(binding.Lifecycle) + "/" + binding.SpaceGUID
+}
+
+// SecurityGroupDefinition is the declared state of a security group.
+type SecurityGroupDefinition struct {
+	Name     string
+	Rules    []resources.Rule
+	Bindings []SecurityGroupBinding
+}
+
+// SecurityGroupChange is a change PlanSecurityGroups found between the
+// declared and the current security groups.
+type SecurityGroupChange struct {
+	Action SecurityGroupAction
+	Name   string
+	GUID   string
+	// RulesChanged is set when a group is created or its rules differ from
+	// the declared Rules.
+	RulesChanged bool
+	Rules        []resources.Rule
+	Bind         []SecurityGroupBinding
+	Unbind       []SecurityGroupBinding
+}
+
+// PlanSecurityGroups compares the declared security groups with the ones in
+// the Cloud Controller and returns the changes that converge them, declared
+// groups first. Groups that are not declared are deleted when prune is set.
+// Nothing is changed.
+func (actor Actor) PlanSecurityGroups(definitions []SecurityGroupDefinition, prune bool) ([]SecurityGroupChange, Warnings, error) {
+	var allWarnings Warnings
+
+	for _, definition := range definitions {
+		problems, lintWarnings := lintRules(definition.Rules)
+		if len(problems) > 0 {
+			return nil, allWarnings, actionerror.InvalidSecurityGroupRulesError{
+				Path:     fmt.Sprintf("security group '%s'", definition.Name),
+				Problems: problems,
+			}
+		}
+		for _, warning := range lintWarnings {
+			allWarnings = append(allWarnings, fmt.Sprintf("Security group %s: %s", definition.Name, warning))
+		}
+	}
+
+	existingGroups, ccWarnings, err := actor.CloudControllerClient.GetSecurityGroups()
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	existingByName := map[string]resources.SecurityGroup{}
+	for _, group := range existingGroups {
+		existingByName[group.Name] = group
+	}
+
+	resolvedDefinitions, warnings, err := actor.resolveSecurityGroupBindings(definitions)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var boundGroups []resources.SecurityGroup
+	for _, definition := range definitions {
+		if group, ok := existingByName[definition.Name]; ok {
+			boundGroups = append(boundGroups, group)
+		}
+	}
+	currentBindings, warnings, err := actor.getSecurityGroupBindings(boundGroups)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var changes []SecurityGroupChange
+	declared := map[string]bool{}
+	for _, definition := range resolvedDefinitions {
+		declared[definition.Name] = true
+
+		group, exists := existingByName[definition.Name]
+		if !exists {
+			changes = append(changes, SecurityGroupChange{
+				Action:       SecurityGroupCreated,
+				Name:         definition.Name,
+				RulesChanged: true,
+				Rules:        definition.Rules,
+				Bind:         definition.Bindings,
+			})
+			continue
+		}
+
+		change := SecurityGroupChange{
+			Action:       SecurityGroupUpdated,
+			Name:         definition.Name,
+			GUID:         group.GUID,
+			RulesChanged: !sameRules(group.Rules, definition.Rules),
+			Rules:        definition.Rules,
+			Bind:         bindingDifference(definition.Bindings, currentBindings[group.GUID]),
+			Unbind:       bindingDifference(currentBindings[group.GUID], definition.Bindings),
+		}
+		if change.RulesChanged || len(change.Bind) > 0 || len(change.Unbind) > 0 {
+			changes = append(changes, change)
+		}
+	}
+
+	if prune {
+		var deletions []SecurityGroupChange
+		for _, group := range existingGroups {
+			if !declared[group.Name] {
+				deletions = append(deletions, SecurityGroupChange{
+					Action: SecurityGroupDeleted,
+					Name:   group.Name,
+					GUID:   group.GUID,
+				})
+			}
+		}
+		sort.Slice(deletions, func(i, j int) bool { return deletions[i].Name < deletions[j].Name })
+		changes = append(changes, deletions...)
+	}
+
+	return changes, allWarnings, nil
+}
+
+// ApplySecurityGroupChanges makes the changes returned by
+// PlanSecurityGroups, stopping at the first error.
+func (actor Actor) ApplySecurityGroupChanges(changes []SecurityGroupChange) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, change := range changes {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		switch change.Action {
+		case SecurityGroupCreated:
+			warnings, err = actor.createDeclaredSecurityGroup(change)
+		case SecurityGroupUpdated:
+			warnings, err = actor.updateDeclaredSecurityGroup(change)
+		case SecurityGroupDeleted:
+			var jobURL ccv3.JobURL
+			var ccWarnings ccv3.Warnings
+			jobURL, ccWarnings, err = actor.CloudControllerClient.DeleteSecurityGroup(change.GUID)
+			warnings = append(warnings, ccWarnings...)
+			if err == nil {
+				ccWarnings, err = actor.CloudControllerClient.PollJob(jobURL)
+				warnings = append(warnings, ccWarnings...)
+			}
+		}
+
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) createDeclaredSecurityGroup(change SecurityGroupChange) (Warnings, error) {
+	runningGlobally, stagingGlobally := false, false
+	securityGroup := resources.SecurityGroup{
+		Name:                   change.Name,
+		Rules:                  change.Rules,
+		RunningGloballyEnabled: &runningGlobally,
+		StagingGloballyEnabled: &stagingGlobally,
+	}
+
+	for _, binding := range change.Bind {
+		switch {
+		case binding.IsGlobal() && binding.Lifecycle == constant.SecurityGroupLifecycleRunning:
+			runningGlobally = true
+		case binding.IsGlobal():
+			stagingGlobally = true
+		case binding.Lifecycle == constant.SecurityGroupLifecycleRunning:
+			securityGroup.RunningSpaceGUIDs = append(securityGroup.RunningSpaceGUIDs, binding.SpaceGUID)
+		default:
+			securityGroup.StagingSpaceGUIDs = append(securityGroup.StagingSpaceGUIDs, binding.SpaceGUID)
+		}
+	}
+
+	_, warnings, err := actor.CloudControllerClient.CreateSecurityGroup(securityGroup)
+	return Warnings(warnings), err
+}
+
+func (actor Actor) updateDeclaredSecurityGroup(change SecurityGroupChange) (Warnings, error) {
+	var allWarnings Warnings
+
+	securityGroup := resources.SecurityGroup{GUID: change.GUID}
+	needsUpdate := change.RulesChanged
+	if change.RulesChanged {
+		// A group declared without rules has nil Rules, which would leave the
+		// current rules in place instead of removing them.
+		securityGroup.Rules = append([]resources.Rule{}, change.Rules...)
+	}
+
+	spaceGUIDs := map[constant.SecurityGroupLifecycle][]string{}
+	for _, binding := range change.Bind {
+		if binding.IsGlobal() {
+			setGloballyEnabled(&securityGroup, binding.Lifecycle, true)
+			needsUpdate = true
+		} else {
+			spaceGUIDs[binding.Lifecycle] = append(spaceGUIDs[binding.Lifecycle], binding.SpaceGUID)
+		}
+	}
+	for _, binding := range change.Unbind {
+		if binding.IsGlobal() {
+			setGloballyEnabled(&securityGroup, binding.Lifecycle, false)
+			needsUpdate = true
+		}
+	}
+
+	if needsUpdate {
+		_, warnings, err := actor.CloudControllerClient.UpdateSecurityGroup(securityGroup)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, lifecycle := range []constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycleRunning, constant.SecurityGroupLifecycleStaging} {
+		if len(spaceGUIDs[lifecycle]) == 0 {
+			continue
+		}
+		warnings, err := actor.BindSecurityGroupToSpaces(change.GUID, spacesFromGUIDs(spaceGUIDs[lifecycle]), lifecycle)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, binding := range change.Unbind {
+		if binding.IsGlobal() {
+			continue
+		}
+
+		var warnings ccv3.Warnings
+		var err error
+		if binding.Lifecycle == constant.SecurityGroupLifecycleRunning {
+			warnings, err = actor.CloudControllerClient.UnbindSecurityGroupRunningSpace(change.GUID, binding.SpaceGUID)
+		} else {
+			warnings, err = actor.CloudControllerClient.UnbindSecurityGroupStagingSpace(change.GUID, binding.SpaceGUID)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// resolveSecurityGroupBindings looks up the GUIDs of the spaces the
+// definitions are bound to.
+func (actor Actor) resolveSecurityGroupBindings(definitions []SecurityGroupDefinition) ([]SecurityGroupDefinition, Warnings, error) {
+	var allWarnings Warnings
+	orgGUIDs := map[string]string{}
+	spaceGUIDs := map[string]string{}
+
+	resolved := make([]SecurityGroupDefinition, len(definitions))
+	for i, definition := range definitions {
+		resolved[i] = definition
+		resolved[i].Bindings = nil
+
+		for _, binding := range definition.Bindings {
+			if binding.IsGlobal() {
+				resolved[i].Bindings = append(resolved[i].Bindings, binding)
+				continue
+			}
+
+			orgGUID, ok := orgGUIDs[binding.OrgName]
+			if !ok {
+				org, warnings, err := actor.GetOrganizationByName(binding.OrgName)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return nil, allWarnings, err
+				}
+				orgGUID = org.GUID
+				orgGUIDs[binding.OrgName] = orgGUID
+			}
+
+			spaceKey := binding.OrgName + "/" + binding.SpaceName
+			spaceGUID, ok := spaceGUIDs[spaceKey]
+			if !ok {
+				space, warnings, err := actor.GetSpaceByNameAndOrganization(binding.SpaceName, orgGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return nil, allWarnings, err
+				}
+				spaceGUID = space.GUID
+				spaceGUIDs[spaceKey] = spaceGUID
+			}
+
+			binding.SpaceGUID = spaceGUID
+			resolved[i].Bindings = append(resolved[i].Bindings, binding)
+		}
+	}
+
+	return resolved, allWarnings, nil
+}
+
+// getSecurityGroupBindings returns the current bindings of the groups, with
+// the names of their spaces and orgs, by group GUID.
+func (actor Actor) getSecurityGroupBindings(groups []resources.SecurityGroup) (map[string][]SecurityGroupBinding, Warnings, error) {
+	var spaceGUIDs []string
+	for _, group := range groups {
+		spaceGUIDs = append(spaceGUIDs, group.RunningSpaceGUIDs...)
+		spaceGUIDs = append(spaceGUIDs, group.StagingSpaceGUIDs...)
+	}
+
+	var (
+		spaces   []resources.Space
+		includes ccv3.IncludedResources
+		warnings ccv3.Warnings
+	)
+	if len(spaceGUIDs) > 0 {
+		var err error
+		warnings, err = batcher.RequestByGUID(spaceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+			batchSpaces, batchIncludes, batchWarnings, err := actor.CloudControllerClient.GetSpaces(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: guids},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"organization"}},
+			)
+			spaces = append(spaces, batchSpaces...)
+			includes.Organizations = append(includes.Organizations, batchIncludes.Organizations...)
+			return batchWarnings, err
+		})
+		if err != nil {
+			return nil, Warnings(warnings), err
+		}
+	}
+
+	orgsByGUID := lookuptable.OrgFromGUID(includes.Organizations)
+	spacesByGUID := lookuptable.SpaceFromGUID(spaces)
+	spaceBinding := func(lifecycle constant.SecurityGroupLifecycle, spaceGUID string) SecurityGroupBinding {
+		space := spacesByGUID[spaceGUID]
+		return SecurityGroupBinding{
+			Lifecycle: lifecycle,
+			OrgName:   orgsByGUID[space.Relationships[constant.RelationshipTypeOrganization].GUID].Name,
+			SpaceName: space.Name,
+			SpaceGUID: spaceGUID,
+		}
+	}
+
+	bindings := map[string][]SecurityGroupBinding{}
+	for _, group := range groups {
+		if group.RunningGloballyEnabled != nil && *group.RunningGloballyEnabled {
+			bindings[group.GUID] = append(bindings[group.GUID], SecurityGroupBinding{Lifecycle: constant.SecurityGroupLifecycleRunning})
+		}
+		if group.StagingGloballyEnabled != nil && *group.StagingGloballyEnabled {
+			bindings[group.GUID] = append(bindings[group.GUID], SecurityGroupBinding{Lifecycle: constant.SecurityGroupLifecycleStaging})
+		}
+		for _, spaceGUID := range group.RunningSpaceGUIDs {
+			bindings[group.GUID] = append(bindings[group.GUID], spaceBinding(constant.SecurityGroupLifecycleRunning, spaceGUID))
+		}
+		for _, spaceGUID := range group.StagingSpaceGUIDs {
+			bindings[group.GUID] = append(bindings[group.GUID], spaceBinding(constant.SecurityGroupLifecycleStaging, spaceGUID))
+		}
+	}
+
+	return bindings, Warnings(warnings), nil
+}
+
+// bindingDifference returns the bindings in a that are not in b.
+func bindingDifference(a []SecurityGroupBinding, b []SecurityGroupBinding) []SecurityGroupBinding {
+	inB := map[string]bool{}
+	for _, binding := range b {
+		inB[binding.key()] = true
+	}
+
+	var difference []SecurityGroupBinding
+	for _, binding := range a {
+		if !inB[binding.key()] {
+			difference = append(difference, binding)
+		}
+	}
+	return difference
+}
+
+// sameRules reports whether both lists have the same rules in the same
+// order, including descriptions and logging.
+func sameRules(a []resources.Rule, b []resources.Rule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if ruleKey(a[i]) != ruleKey(b[i]) ||
+			optionalString(a[i].Description) != optionalString(b[i].Description) ||
+			optionalBool(a[i].Log) != optionalBool(b[i].Log) {
+			return false
+		}
+	}
+	return true
+}
+
+func optionalString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func optionalBool(value *bool) bool {
+	return value != nil && *value
+}
+
+func setGloballyEnabled(securityGroup *resources.SecurityGroup, lifecycle constant.SecurityGroupLifecycle, enabled bool) {
+	if lifecycle == constant.SecurityGroupLifecycleRunning {
+		securityGroup.RunningGloballyEnabled = &enabled
+	} else {
+		securityGroup.StagingGloballyEnabled = &enabled
+	}
+}
+
+func spacesFromGUIDs(guids []string) []resources.Space {
+	spaces := make([]resources.Space, len(guids))
+	for i, guid := range guids {
+		spaces[i] = resources.Space{GUID: guid}
+	}
+	return spaces
+}
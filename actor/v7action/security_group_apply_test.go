@@ -0,0 +1,335 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Apply Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+
+		httpsPorts string
+		httpsRule  resources.Rule
+		dnsPorts   string
+		dnsRule    resources.Rule
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		httpsPorts = "443"
+		httpsRule = resources.Rule{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: &httpsPorts}
+		dnsPorts = "53"
+		dnsRule = resources.Rule{Protocol: "udp", Destination: "10.0.0.2", Ports: &dnsPorts}
+	})
+
+	Describe("PlanSecurityGroups", func() {
+		var (
+			definitions []SecurityGroupDefinition
+			prune       bool
+			changes     []SecurityGroupChange
+		)
+
+		BeforeEach(func() {
+			prune = false
+			definitions = []SecurityGroupDefinition{
+				{
+					Name:  "public",
+					Rules: []resources.Rule{httpsRule},
+					Bindings: []SecurityGroupBinding{
+						{Lifecycle: constant.SecurityGroupLifecycleStaging},
+						{Lifecycle: constant.SecurityGroupLifecycleRunning, OrgName: "some-org", SpaceName: "some-space"},
+					},
+				},
+				{
+					Name:     "dns",
+					Rules:    []resources.Rule{dnsRule},
+					Bindings: []SecurityGroupBinding{{Lifecycle: constant.SecurityGroupLifecycleRunning}},
+				},
+			}
+
+			running := true
+			fakeCloudControllerClient.GetSecurityGroupsReturns([]resources.SecurityGroup{
+				{
+					Name:                   "public",
+					GUID:                   "public-guid",
+					Rules:                  []resources.Rule{httpsRule, dnsRule},
+					RunningGloballyEnabled: &running,
+					RunningSpaceGUIDs:      []string{"old-space-guid"},
+				},
+				{Name: "old", GUID: "old-guid"},
+			}, ccv3.Warnings{"get-groups-warning"}, nil)
+
+			fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{{GUID: "some-org-guid", Name: "some-org"}}, ccv3.Warnings{"get-org-warning"}, nil)
+			fakeCloudControllerClient.GetSpacesStub = func(queries ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
+				for _, query := range queries {
+					if query.Key == ccv3.GUIDFilter {
+						return []resources.Space{{
+							GUID: "old-space-guid",
+							Name: "old-space",
+							Relationships: resources.Relationships{
+								constant.RelationshipTypeOrganization: resources.Relationship{GUID: "some-org-guid"},
+							},
+						}}, ccv3.IncludedResources{Organizations: []resources.Organization{{GUID: "some-org-guid", Name: "some-org"}}}, ccv3.Warnings{"get-bound-spaces-warning"}, nil
+					}
+				}
+				return []resources.Space{{GUID: "some-space-guid", Name: "some-space"}}, ccv3.IncludedResources{}, ccv3.Warnings{"get-space-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.PlanSecurityGroups(definitions, prune)
+		})
+
+		It("returns the changes that converge the security groups", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-groups-warning", "get-org-warning", "get-space-warning", "get-bound-spaces-warning"))
+
+			Expect(changes).To(Equal([]SecurityGroupChange{
+				{
+					Action:       SecurityGroupUpdated,
+					Name:         "public",
+					GUID:         "public-guid",
+					RulesChanged: true,
+					Rules:        []resources.Rule{httpsRule},
+					Bind: []SecurityGroupBinding{
+						{Lifecycle: constant.SecurityGroupLifecycleStaging},
+						{Lifecycle: constant.SecurityGroupLifecycleRunning, OrgName: "some-org", SpaceName: "some-space", SpaceGUID: "some-space-guid"},
+					},
+					Unbind: []SecurityGroupBinding{
+						{Lifecycle: constant.SecurityGroupLifecycleRunning},
+						{Lifecycle: constant.SecurityGroupLifecycleRunning, OrgName: "some-org", SpaceName: "old-space", SpaceGUID: "old-space-guid"},
+					},
+				},
+				{
+					Action:       SecurityGroupCreated,
+					Name:         "dns",
+					RulesChanged: true,
+					Rules:        []resources.Rule{dnsRule},
+					Bind:         []SecurityGroupBinding{{Lifecycle: constant.SecurityGroupLifecycleRunning}},
+				},
+			}))
+		})
+
+		When("prune is set", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("deletes the groups that are not declared", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(changes).To(HaveLen(3))
+				Expect(changes[2]).To(Equal(SecurityGroupChange{Action: SecurityGroupDeleted, Name: "old", GUID: "old-guid"}))
+			})
+		})
+
+		When("a declared group is up to date", func() {
+			BeforeEach(func() {
+				definitions = []SecurityGroupDefinition{{Name: "old"}}
+			})
+
+			It("returns no changes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(changes).To(BeEmpty())
+			})
+		})
+
+		When("a declared group has no rules but the current group does", func() {
+			BeforeEach(func() {
+				definitions = []SecurityGroupDefinition{{Name: "public", Rules: []resources.Rule{}}}
+			})
+
+			It("changes the rules of the group", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(changes).To(HaveLen(1))
+				Expect(changes[0].RulesChanged).To(BeTrue())
+				Expect(changes[0].Rules).To(BeEmpty())
+			})
+		})
+
+		When("a declared group has invalid rules", func() {
+			BeforeEach(func() {
+				definitions[1].Rules = []resources.Rule{{Protocol: "all"}}
+			})
+
+			It("returns an error without contacting the Cloud Controller", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidSecurityGroupRulesError{
+					Path:     "security group 'dns'",
+					Problems: []string{"rule 1: destination is required"},
+				}))
+				Expect(fakeCloudControllerClient.GetSecurityGroupsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a declared group has overlapping rules", func() {
+			BeforeEach(func() {
+				definitions[1].Rules = []resources.Rule{dnsRule, dnsRule}
+			})
+
+			It("warns with the name of the group", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElement("Security group dns: Rule 2 duplicates rule 1."))
+			})
+		})
+
+		When("a bound org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ContainElement("get-org-warning"))
+			})
+		})
+
+		When("getting the security groups fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv3.Warnings{"get-groups-warning"}, errors.New("get-groups-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-groups-error"))
+				Expect(warnings).To(ConsistOf("get-groups-warning"))
+			})
+		})
+	})
+
+	Describe("ApplySecurityGroupChanges", func() {
+		var changes []SecurityGroupChange
+
+		BeforeEach(func() {
+			changes = []SecurityGroupChange{
+				{
+					Action:       SecurityGroupCreated,
+					Name:         "dns",
+					RulesChanged: true,
+					Rules:        []resources.Rule{dnsRule},
+					Bind: []SecurityGroupBinding{
+						{Lifecycle: constant.SecurityGroupLifecycleRunning},
+						{Lifecycle: constant.SecurityGroupLifecycleStaging, OrgName: "some-org", SpaceName: "some-space", SpaceGUID: "some-space-guid"},
+					},
+				},
+				{
+					Action: SecurityGroupUpdated,
+					Name:   "public",
+					GUID:   "public-guid",
+					Bind: []SecurityGroupBinding{
+						{Lifecycle: constant.SecurityGroupLifecycleStaging},
+						{Lifecycle: constant.SecurityGroupLifecycleRunning, OrgName: "some-org", SpaceName: "some-space", SpaceGUID: "some-space-guid"},
+					},
+					Unbind: []SecurityGroupBinding{
+						{Lifecycle: constant.SecurityGroupLifecycleStaging, OrgName: "some-org", SpaceName: "old-space", SpaceGUID: "old-space-guid"},
+					},
+				},
+				{Action: SecurityGroupDeleted, Name: "old", GUID: "old-guid"},
+			}
+
+			fakeCloudControllerClient.CreateSecurityGroupReturns(resources.SecurityGroup{}, ccv3.Warnings{"create-warning"}, nil)
+			fakeCloudControllerClient.UpdateSecurityGroupReturns(resources.SecurityGroup{}, ccv3.Warnings{"update-warning"}, nil)
+			fakeCloudControllerClient.UpdateSecurityGroupRunningSpaceReturns(ccv3.Warnings{"bind-warning"}, nil)
+			fakeCloudControllerClient.UnbindSecurityGroupStagingSpaceReturns(ccv3.Warnings{"unbind-warning"}, nil)
+			fakeCloudControllerClient.DeleteSecurityGroupReturns("job-url", ccv3.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplySecurityGroupChanges(changes)
+		})
+
+		It("makes the changes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"create-warning", "update-warning", "bind-warning", "unbind-warning", "delete-warning", "poll-warning"}))
+
+			running, staging := true, false
+			Expect(fakeCloudControllerClient.CreateSecurityGroupArgsForCall(0)).To(Equal(resources.SecurityGroup{
+				Name:                   "dns",
+				Rules:                  []resources.Rule{dnsRule},
+				RunningGloballyEnabled: &running,
+				StagingGloballyEnabled: &staging,
+				StagingSpaceGUIDs:      []string{"some-space-guid"},
+			}))
+
+			stagingGlobally := true
+			Expect(fakeCloudControllerClient.UpdateSecurityGroupArgsForCall(0)).To(Equal(resources.SecurityGroup{
+				GUID:                   "public-guid",
+				StagingGloballyEnabled: &stagingGlobally,
+			}))
+
+			groupGUID, spaceGUIDs := fakeCloudControllerClient.UpdateSecurityGroupRunningSpaceArgsForCall(0)
+			Expect(groupGUID).To(Equal("public-guid"))
+			Expect(spaceGUIDs).To(Equal([]string{"some-space-guid"}))
+			Expect(fakeCloudControllerClient.UpdateSecurityGroupStagingSpaceCallCount()).To(Equal(0))
+
+			groupGUID, spaceGUID := fakeCloudControllerClient.UnbindSecurityGroupStagingSpaceArgsForCall(0)
+			Expect(groupGUID).To(Equal("public-guid"))
+			Expect(spaceGUID).To(Equal("old-space-guid"))
+
+			Expect(fakeCloudControllerClient.DeleteSecurityGroupArgsForCall(0)).To(Equal("old-guid"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("job-url")))
+		})
+
+		When("only the space bindings of a group change", func() {
+			BeforeEach(func() {
+				changes = []SecurityGroupChange{{
+					Action: SecurityGroupUpdated,
+					GUID:   "public-guid",
+					Unbind: []SecurityGroupBinding{{Lifecycle: constant.SecurityGroupLifecycleStaging, SpaceName: "old-space", SpaceGUID: "old-space-guid"}},
+				}}
+			})
+
+			It("does not update the group itself", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UnbindSecurityGroupStagingSpaceCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the rules of a group change to none", func() {
+			BeforeEach(func() {
+				changes = []SecurityGroupChange{{
+					Action:       SecurityGroupUpdated,
+					Name:         "public",
+					GUID:         "public-guid",
+					RulesChanged: true,
+				}}
+			})
+
+			It("updates the group with an empty list of rules", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupCallCount()).To(Equal(1))
+
+				securityGroup := fakeCloudControllerClient.UpdateSecurityGroupArgsForCall(0)
+				Expect(securityGroup.GUID).To(Equal("public-guid"))
+				Expect(securityGroup.Rules).NotTo(BeNil())
+				Expect(securityGroup.Rules).To(BeEmpty())
+			})
+		})
+
+		When("a change fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSecurityGroupReturns(resources.SecurityGroup{}, ccv3.Warnings{"create-warning"}, errors.New("create-error"))
+			})
+
+			It("stops and returns the error", func() {
+				Expect(executeErr).To(MatchError("create-error"))
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteSecurityGroupCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
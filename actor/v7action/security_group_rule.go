@@ -0,0 +1,417 @@
+package v7action
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+const (
+	ruleProtocolTCP    = "tcp"
+	ruleProtocolUDP    = "udp"
+	ruleProtocolICMP   = "icmp"
+	ruleProtocolICMPv6 = "icmpv6"
+	ruleProtocolAll    = "all"
+)
+
+// EffectiveSecurityRule is an egress rule that applies to the apps of a
+// space, with the security groups that grant it.
+type EffectiveSecurityRule struct {
+	Rule               resources.Rule
+	SecurityGroupNames []string
+}
+
+// LintSecurityGroupRules parses the rules file at filePath and validates the
+// rules locally. Rules the Cloud Controller would reject are returned as an
+// InvalidSecurityGroupRulesError. Rules that duplicate, are shadowed by or
+// overlap other rules, and CIDRs with host bits set, are returned as warnings.
+func (actor Actor) LintSecurityGroupRules(filePath string) (Warnings, error) {
+	rawRules, err := parsePath(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []resources.Rule
+	err = json.Unmarshal(rawRules, &rules)
+	if err != nil {
+		return nil, err
+	}
+
+	problems, warnings := lintRules(rules)
+	if len(problems) > 0 {
+		return nil, actionerror.InvalidSecurityGroupRulesError{Path: filePath, Problems: problems}
+	}
+
+	return warnings, nil
+}
+
+// lintRules returns the rules the Cloud Controller would reject as
+// problems, and the rules that duplicate, are shadowed by or overlap other
+// rules as warnings, along with the hints for rules the Cloud Controller
+// accepts but that are likely mistakes.
+func lintRules(rules []resources.Rule) ([]string, Warnings) {
+	var (
+		problems []string
+		warnings Warnings
+	)
+	parsed := make([]parsedRule, len(rules))
+	for i, rule := range rules {
+		var (
+			hints []string
+			err   error
+		)
+		parsed[i], hints, err = parseRule(rule)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("rule %d: %s", i+1, err))
+		}
+		for _, hint := range hints {
+			warnings = append(warnings, fmt.Sprintf("Rule %d: %s", i+1, hint))
+		}
+	}
+	if len(problems) > 0 {
+		return problems, nil
+	}
+
+	for j := range parsed {
+		for i := 0; i < j; i++ {
+			switch {
+			case parsed[i].covers(parsed[j]) && parsed[j].covers(parsed[i]):
+				warnings = append(warnings, fmt.Sprintf("Rule %d duplicates rule %d.", j+1, i+1))
+			case parsed[i].covers(parsed[j]):
+				warnings = append(warnings, fmt.Sprintf("Rule %d is shadowed by rule %d.", j+1, i+1))
+			case parsed[j].covers(parsed[i]):
+				warnings = append(warnings, fmt.Sprintf("Rule %d is shadowed by rule %d.", i+1, j+1))
+			case parsed[i].overlaps(parsed[j]):
+				warnings = append(warnings, fmt.Sprintf("Rules %d and %d overlap.", i+1, j+1))
+			}
+		}
+	}
+
+	return nil, warnings
+}
+
+// GetEffectiveSecurityRules returns the deduplicated egress rules that apps
+// in the space get in the lifecycle phase, from the globally enabled
+// security groups and the ones bound to the space.
+func (actor Actor) GetEffectiveSecurityRules(spaceName string, orgGUID string, lifecycle constant.SecurityGroupLifecycle) ([]EffectiveSecurityRule, Warnings, error) {
+	var allWarnings Warnings
+
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	getSpaceGroups := actor.CloudControllerClient.GetRunningSecurityGroups
+	getGlobalGroups := actor.GetGlobalRunningSecurityGroups
+	if lifecycle == constant.SecurityGroupLifecycleStaging {
+		getSpaceGroups = actor.CloudControllerClient.GetStagingSecurityGroups
+		getGlobalGroups = actor.GetGlobalStagingSecurityGroups
+	}
+
+	spaceGroups, ccWarnings, err := getSpaceGroups(space.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	globalGroups, warnings, err := getGlobalGroups()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var rules []EffectiveSecurityRule
+	ruleIndexes := map[string]int{}
+	seenGroups := map[string]bool{}
+	for _, group := range append(globalGroups, spaceGroups...) {
+		if seenGroups[group.GUID] {
+			continue
+		}
+		seenGroups[group.GUID] = true
+
+		for _, rule := range group.Rules {
+			key := ruleKey(rule)
+			index, ok := ruleIndexes[key]
+			if !ok {
+				index = len(rules)
+				ruleIndexes[key] = index
+				rules = append(rules, EffectiveSecurityRule{Rule: rule})
+			}
+			if !containsString(rules[index].SecurityGroupNames, group.Name) {
+				rules[index].SecurityGroupNames = append(rules[index].SecurityGroupNames, group.Name)
+			}
+		}
+	}
+
+	for i := range rules {
+		sort.Strings(rules[i].SecurityGroupNames)
+	}
+
+	return rules, allWarnings, nil
+}
+
+// ruleKey identifies the traffic a rule allows; descriptions and logging are
+// ignored.
+func ruleKey(rule resources.Rule) string {
+	key := []string{strings.ToLower(rule.Protocol), strings.ReplaceAll(rule.Destination, " ", "")}
+	if rule.Ports != nil {
+		key = append(key, strings.ReplaceAll(*rule.Ports, " ", ""))
+	} else {
+		key = append(key, "")
+	}
+	for _, value := range []*int{rule.Type, rule.Code} {
+		if value != nil {
+			key = append(key, strconv.Itoa(*value))
+		} else {
+			key = append(key, "")
+		}
+	}
+	return strings.Join(key, "|")
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type ipRange struct {
+	start, end net.IP
+}
+
+type portRange struct {
+	start, end int
+}
+
+// parsedRule is a validated rule in a form that can be compared with other
+// rules. An icmpType or icmpCode of -1 means any.
+type parsedRule struct {
+	protocol     string
+	destinations []ipRange
+	ports        []portRange
+	icmpType     int
+	icmpCode     int
+}
+
+// parseRule validates the rule and returns it in a comparable form, along
+// with hints about parts of the rule that are valid but likely mistakes.
+func parseRule(rule resources.Rule) (parsedRule, []string, error) {
+	parsed := parsedRule{protocol: rule.Protocol}
+
+	switch rule.Protocol {
+	case ruleProtocolTCP, ruleProtocolUDP, ruleProtocolICMP, ruleProtocolICMPv6, ruleProtocolAll:
+	default:
+		return parsedRule{}, nil, fmt.Errorf("protocol '%s' must be one of tcp, udp, icmp, icmpv6 or all", rule.Protocol)
+	}
+
+	if rule.Destination == "" {
+		return parsedRule{}, nil, fmt.Errorf("destination is required")
+	}
+	var hints []string
+	for _, destination := range strings.Split(rule.Destination, ",") {
+		destinationRange, hint, err := parseDestination(strings.TrimSpace(destination))
+		if err != nil {
+			return parsedRule{}, nil, err
+		}
+		if hint != "" {
+			hints = append(hints, hint)
+		}
+		parsed.destinations = append(parsed.destinations, destinationRange)
+	}
+
+	switch rule.Protocol {
+	case ruleProtocolTCP, ruleProtocolUDP:
+		if rule.Ports == nil || *rule.Ports == "" {
+			return parsedRule{}, nil, fmt.Errorf("ports are required for protocol %s", rule.Protocol)
+		}
+		ports, err := parsePorts(*rule.Ports)
+		if err != nil {
+			return parsedRule{}, nil, err
+		}
+		parsed.ports = ports
+	default:
+		if rule.Ports != nil {
+			return parsedRule{}, nil, fmt.Errorf("ports are only allowed for protocols tcp and udp")
+		}
+	}
+
+	if isICMP(rule.Protocol) {
+		if rule.Type == nil || rule.Code == nil {
+			return parsedRule{}, nil, fmt.Errorf("type and code are required for protocol %s", rule.Protocol)
+		}
+		if *rule.Type < -1 || *rule.Type > 255 {
+			return parsedRule{}, nil, fmt.Errorf("%s type %d must be between -1 and 255", rule.Protocol, *rule.Type)
+		}
+		if *rule.Code < -1 || *rule.Code > 255 {
+			return parsedRule{}, nil, fmt.Errorf("%s code %d must be between -1 and 255", rule.Protocol, *rule.Code)
+		}
+		parsed.icmpType, parsed.icmpCode = *rule.Type, *rule.Code
+	} else if rule.Type != nil || rule.Code != nil {
+		return parsedRule{}, nil, fmt.Errorf("type and code are only allowed for protocols icmp and icmpv6")
+	}
+
+	return parsed, hints, nil
+}
+
+func isICMP(protocol string) bool {
+	return protocol == ruleProtocolICMP || protocol == ruleProtocolICMPv6
+}
+
+// parseDestination returns the addresses of the destination. A CIDR with
+// host bits set is accepted by the Cloud Controller as its network, so it is
+// returned as such, with a hint.
+func parseDestination(destination string) (ipRange, string, error) {
+	if strings.Contains(destination, "/") {
+		ip, network, err := net.ParseCIDR(destination)
+		if err != nil {
+			return ipRange{}, "", fmt.Errorf("destination '%s' is not a valid CIDR", destination)
+		}
+		var hint string
+		if !ip.Equal(network.IP) {
+			hint = fmt.Sprintf("destination '%s' has host bits set; did you mean %s?", destination, network)
+		}
+		end := make(net.IP, len(network.IP))
+		for i := range network.IP {
+			end[i] = network.IP[i] | ^network.Mask[i]
+		}
+		return ipRange{start: network.IP.To16(), end: end.To16()}, hint, nil
+	}
+
+	if i := strings.Index(destination, "-"); i >= 0 {
+		start, end := net.ParseIP(destination[:i]), net.ParseIP(destination[i+1:])
+		if start == nil || end == nil {
+			return ipRange{}, "", fmt.Errorf("destination '%s' is not a valid IP range", destination)
+		}
+		if (start.To4() == nil) != (end.To4() == nil) {
+			return ipRange{}, "", fmt.Errorf("destination '%s' mixes IPv4 and IPv6 addresses", destination)
+		}
+		if bytes.Compare(start.To16(), end.To16()) > 0 {
+			return ipRange{}, "", fmt.Errorf("destination '%s' ends before it starts", destination)
+		}
+		return ipRange{start: start.To16(), end: end.To16()}, "", nil
+	}
+
+	ip := net.ParseIP(destination)
+	if ip == nil {
+		return ipRange{}, "", fmt.Errorf("destination '%s' is not a valid IP address, IP range or CIDR", destination)
+	}
+	return ipRange{start: ip.To16(), end: ip.To16()}, "", nil
+}
+
+func parsePorts(ports string) ([]portRange, error) {
+	var ranges []portRange
+	for _, entry := range strings.Split(ports, ",") {
+		entry = strings.TrimSpace(entry)
+		startText, endText := entry, entry
+		if i := strings.Index(entry, "-"); i >= 0 {
+			startText, endText = entry[:i], entry[i+1:]
+		}
+
+		start, startErr := strconv.Atoi(strings.TrimSpace(startText))
+		end, endErr := strconv.Atoi(strings.TrimSpace(endText))
+		if startErr != nil || endErr != nil || start < 1 || end > 65535 {
+			return nil, fmt.Errorf("ports '%s' must be ports or port ranges between 1 and 65535", ports)
+		}
+		if end < start {
+			return nil, fmt.Errorf("port range '%s' ends before it starts", entry)
+		}
+		ranges = append(ranges, portRange{start: start, end: end})
+	}
+	return ranges, nil
+}
+
+// covers returns whether every packet allowed by other is also allowed by
+// the rule.
+func (rule parsedRule) covers(other parsedRule) bool {
+	if rule.protocol != ruleProtocolAll && rule.protocol != other.protocol {
+		return false
+	}
+
+	for _, destination := range other.destinations {
+		if !rule.coversDestination(destination) {
+			return false
+		}
+	}
+
+	switch {
+	case rule.protocol == ruleProtocolAll:
+		return true
+	case isICMP(rule.protocol):
+		return (rule.icmpType == -1 || rule.icmpType == other.icmpType) &&
+			(rule.icmpCode == -1 || rule.icmpCode == other.icmpCode)
+	default:
+		for _, ports := range other.ports {
+			if !rule.coversPorts(ports) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// overlaps returns whether some packet is allowed by both rules.
+func (rule parsedRule) overlaps(other parsedRule) bool {
+	if rule.protocol != ruleProtocolAll && other.protocol != ruleProtocolAll && rule.protocol != other.protocol {
+		return false
+	}
+
+	destinationsOverlap := false
+	for _, a := range rule.destinations {
+		for _, b := range other.destinations {
+			if bytes.Compare(a.start, b.end) <= 0 && bytes.Compare(b.start, a.end) <= 0 {
+				destinationsOverlap = true
+			}
+		}
+	}
+	if !destinationsOverlap {
+		return false
+	}
+
+	if rule.protocol != other.protocol || rule.protocol == ruleProtocolAll {
+		return true
+	}
+
+	if isICMP(rule.protocol) {
+		return (rule.icmpType == -1 || other.icmpType == -1 || rule.icmpType == other.icmpType) &&
+			(rule.icmpCode == -1 || other.icmpCode == -1 || rule.icmpCode == other.icmpCode)
+	}
+
+	for _, a := range rule.ports {
+		for _, b := range other.ports {
+			if a.start <= b.end && b.start <= a.end {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (rule parsedRule) coversDestination(destination ipRange) bool {
+	for _, r := range rule.destinations {
+		if bytes.Compare(r.start, destination.start) <= 0 && bytes.Compare(destination.end, r.end) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule parsedRule) coversPorts(ports portRange) bool {
+	for _, r := range rule.ports {
+		if r.start <= ports.start && ports.end <= r.end {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,285 @@
+package v7action_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Rule Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("LintSecurityGroupRules", func() {
+		var (
+			filePath     string
+			fileContents string
+		)
+
+		BeforeEach(func() {
+			tempFile, err := ioutil.TempFile("", "security-group-rules")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tempFile.Close()).To(Succeed())
+			filePath = tempFile.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(filePath)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			Expect(ioutil.WriteFile(filePath, []byte(fileContents), 0600)).To(Succeed())
+			warnings, executeErr = actor.LintSecurityGroupRules(filePath)
+		})
+
+		When("the rules are valid and disjoint", func() {
+			BeforeEach(func() {
+				fileContents = `[
+					{"protocol": "tcp", "destination": "10.0.11.0/24", "ports": "80,443"},
+					{"protocol": "udp", "destination": "10.0.0.1-10.0.0.9", "ports": "53"},
+					{"protocol": "icmp", "destination": "10.0.12.1", "type": 0, "code": -1},
+					{"protocol": "all", "destination": "192.168.0.0/16, 172.16.0.1"},
+					{"protocol": "tcp", "destination": "2001:db8::/32", "ports": "8000-9000"},
+					{"protocol": "icmpv6", "destination": "2001:db9::/32", "type": 128, "code": 0}
+				]`
+			})
+
+			It("returns no warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+			})
+		})
+
+		When("the file is not valid JSON", func() {
+			BeforeEach(func() {
+				fileContents = `[{"protocol": "tcp",`
+			})
+
+			It("returns the JSON error", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(&json.SyntaxError{}))
+			})
+		})
+
+		When("rules duplicate, shadow or overlap each other", func() {
+			BeforeEach(func() {
+				fileContents = `[
+					{"protocol": "tcp", "destination": "10.0.0.0/16", "ports": "1-1024"},
+					{"protocol": "tcp", "destination": "10.0.1.0/24", "ports": "443"},
+					{"protocol": "tcp", "destination": "10.0.0.0/16", "ports": "1-1024", "description": "again"},
+					{"protocol": "tcp", "destination": "10.0.0.0/8", "ports": "1000-2000"}
+				]`
+			})
+
+			It("warns about each pair", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"Rule 2 is shadowed by rule 1.",
+					"Rule 3 duplicates rule 1.",
+					"Rule 2 is shadowed by rule 3.",
+					"Rules 1 and 4 overlap.",
+					"Rules 3 and 4 overlap.",
+				))
+			})
+		})
+
+		When("a CIDR has host bits set", func() {
+			BeforeEach(func() {
+				fileContents = `[
+					{"protocol": "all", "destination": "10.0.0.1/24"},
+					{"protocol": "tcp", "destination": "10.0.0.7", "ports": "443"}
+				]`
+			})
+
+			It("warns with the network it stands for and compares the rules by that network", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"Rule 1: destination '10.0.0.1/24' has host bits set; did you mean 10.0.0.0/24?",
+					"Rule 2 is shadowed by rule 1.",
+				))
+			})
+		})
+
+		When("icmp and icmpv6 rules have the same type and code", func() {
+			BeforeEach(func() {
+				fileContents = `[
+					{"protocol": "icmp", "destination": "0.0.0.0-255.255.255.255", "type": -1, "code": -1},
+					{"protocol": "icmpv6", "destination": "::/0", "type": -1, "code": -1},
+					{"protocol": "icmpv6", "destination": "2001:db8::1", "type": 128, "code": 0}
+				]`
+			})
+
+			It("only compares rules of the same protocol", func() {
+				Expect(warnings).To(ConsistOf("Rule 3 is shadowed by rule 2."))
+			})
+		})
+
+		When("a rule for all protocols covers a tcp rule", func() {
+			BeforeEach(func() {
+				fileContents = `[
+					{"protocol": "tcp", "destination": "10.0.0.1", "ports": "443"},
+					{"protocol": "all", "destination": "10.0.0.0/24"}
+				]`
+			})
+
+			It("warns that the tcp rule is shadowed", func() {
+				Expect(warnings).To(ConsistOf("Rule 1 is shadowed by rule 2."))
+			})
+		})
+	})
+
+	DescribeTable("invalid rules",
+		func(rule string, problem string) {
+			tempFile, err := ioutil.TempFile("", "security-group-rules")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Remove(tempFile.Name())
+			_, err = tempFile.WriteString("[" + rule + "]")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tempFile.Close()).To(Succeed())
+
+			_, err = NewActor(nil, nil, nil, nil, nil, nil).LintSecurityGroupRules(tempFile.Name())
+			Expect(err).To(MatchError(actionerror.InvalidSecurityGroupRulesError{
+				Path:     tempFile.Name(),
+				Problems: []string{"rule 1: " + problem},
+			}))
+		},
+		Entry("unknown protocol", `{"protocol": "sctp", "destination": "10.0.0.1"}`,
+			"protocol 'sctp' must be one of tcp, udp, icmp, icmpv6 or all"),
+		Entry("missing destination", `{"protocol": "all"}`,
+			"destination is required"),
+		Entry("bad CIDR", `{"protocol": "all", "destination": "10.0.0.0/33"}`,
+			"destination '10.0.0.0/33' is not a valid CIDR"),
+		Entry("reversed IP range", `{"protocol": "all", "destination": "10.0.0.9-10.0.0.1"}`,
+			"destination '10.0.0.9-10.0.0.1' ends before it starts"),
+		Entry("not an IP", `{"protocol": "all", "destination": "example.com"}`,
+			"destination 'example.com' is not a valid IP address, IP range or CIDR"),
+		Entry("missing ports", `{"protocol": "tcp", "destination": "10.0.0.1"}`,
+			"ports are required for protocol tcp"),
+		Entry("port out of range", `{"protocol": "udp", "destination": "10.0.0.1", "ports": "0-70000"}`,
+			"ports '0-70000' must be ports or port ranges between 1 and 65535"),
+		Entry("reversed port range", `{"protocol": "tcp", "destination": "10.0.0.1", "ports": "80,9000-8000"}`,
+			"port range '9000-8000' ends before it starts"),
+		Entry("ports for icmp", `{"protocol": "icmp", "destination": "10.0.0.1", "ports": "80", "type": 0, "code": 0}`,
+			"ports are only allowed for protocols tcp and udp"),
+		Entry("missing icmp code", `{"protocol": "icmp", "destination": "10.0.0.1", "type": 0}`,
+			"type and code are required for protocol icmp"),
+		Entry("icmp type out of range", `{"protocol": "icmp", "destination": "10.0.0.1", "type": 256, "code": 0}`,
+			"icmp type 256 must be between -1 and 255"),
+		Entry("missing icmpv6 type", `{"protocol": "icmpv6", "destination": "2001:db8::1", "code": 0}`,
+			"type and code are required for protocol icmpv6"),
+		Entry("icmpv6 code out of range", `{"protocol": "icmpv6", "destination": "2001:db8::1", "type": 128, "code": -2}`,
+			"icmpv6 code -2 must be between -1 and 255"),
+		Entry("icmp type for tcp", `{"protocol": "tcp", "destination": "10.0.0.1", "ports": "80", "type": 0}`,
+			"type and code are only allowed for protocols icmp and icmpv6"),
+	)
+
+	Describe("GetEffectiveSecurityRules", func() {
+		var (
+			lifecycle constant.SecurityGroupLifecycle
+			rules     []EffectiveSecurityRule
+		)
+
+		BeforeEach(func() {
+			lifecycle = constant.SecurityGroupLifecycleRunning
+			ports := "443"
+			otherPorts := " 443"
+			description := "https"
+
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{{GUID: "space-guid", Name: "space"}}, ccv3.IncludedResources{}, ccv3.Warnings{"get-space-warning"}, nil)
+
+			public := resources.SecurityGroup{
+				Name:  "public",
+				GUID:  "public-guid",
+				Rules: []resources.Rule{{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: &ports}},
+			}
+			fakeCloudControllerClient.GetSecurityGroupsReturns([]resources.SecurityGroup{public}, ccv3.Warnings{"get-global-warning"}, nil)
+			fakeCloudControllerClient.GetRunningSecurityGroupsReturns([]resources.SecurityGroup{
+				public,
+				{
+					Name: "backend",
+					GUID: "backend-guid",
+					Rules: []resources.Rule{
+						{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: &otherPorts, Description: &description},
+						{Protocol: "all", Destination: "10.0.0.0/8"},
+					},
+				},
+			}, ccv3.Warnings{"get-running-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			rules, warnings, executeErr = actor.GetEffectiveSecurityRules("space", "org-guid", lifecycle)
+		})
+
+		It("merges the global and space groups and deduplicates their rules", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-space-warning", "get-running-warning", "get-global-warning"))
+
+			Expect(rules).To(HaveLen(2))
+			Expect(rules[0].Rule.Destination).To(Equal("0.0.0.0/0"))
+			Expect(rules[0].SecurityGroupNames).To(Equal([]string{"backend", "public"}))
+			Expect(rules[1].Rule.Protocol).To(Equal("all"))
+			Expect(rules[1].SecurityGroupNames).To(Equal([]string{"backend"}))
+
+			Expect(fakeCloudControllerClient.GetRunningSecurityGroupsArgsForCall(0)).To(Equal("space-guid"))
+			Expect(fakeCloudControllerClient.GetSecurityGroupsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GloballyEnabledRunning, Values: []string{"true"}},
+			))
+			Expect(fakeCloudControllerClient.GetStagingSecurityGroupsCallCount()).To(Equal(0))
+		})
+
+		When("the lifecycle is staging", func() {
+			BeforeEach(func() {
+				lifecycle = constant.SecurityGroupLifecycleStaging
+			})
+
+			It("uses the staging groups", func() {
+				Expect(fakeCloudControllerClient.GetStagingSecurityGroupsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRunningSecurityGroupsCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetSecurityGroupsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GloballyEnabledStaging, Values: []string{"true"}},
+				))
+			})
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "space"}))
+				Expect(warnings).To(ConsistOf("get-space-warning"))
+			})
+		})
+
+		When("getting the space groups fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRunningSecurityGroupsReturns(nil, ccv3.Warnings{"get-running-warning"}, errors.New("running-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("running-error"))
+				Expect(warnings).To(ConsistOf("get-space-warning", "get-running-warning"))
+			})
+		})
+	})
+})
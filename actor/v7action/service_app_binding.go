@@ -23,6 +23,12 @@ type DeleteServiceAppBindingParams struct {
 	AppName             string
 }
 
+type GetServiceAppBindingParams struct {
+	SpaceGUID           string
+	ServiceInstanceName string
+	AppName             string
+}
+
 func (actor Actor) CreateServiceAppBinding(params CreateServiceAppBindingParams) (chan PollJobEvent, Warnings, error) {
 	var (
 		serviceInstance resources.ServiceInstance
@@ -40,6 +46,9 @@ func (actor Actor) CreateServiceAppBinding(params CreateServiceAppBindingParams)
 			app, warnings, err = actor.CloudControllerClient.GetApplicationByNameAndSpace(params.AppName, params.SpaceGUID)
 			return
 		},
+		func() (warnings ccv3.Warnings, err error) {
+			return actor.validateServiceBindingParameters(serviceInstance, params.Parameters)
+		},
 		func() (warnings ccv3.Warnings, err error) {
 			jobURL, warnings, err = actor.createServiceAppBinding(serviceInstance.GUID, app.GUID, params.BindingName, params.Parameters)
 			return
@@ -102,6 +111,52 @@ func (actor Actor) DeleteServiceAppBinding(params DeleteServiceAppBindingParams)
 	}
 }
 
+// GetServiceAppBinding returns the binding between the named app and service
+// instance.
+func (actor Actor) GetServiceAppBinding(params GetServiceAppBindingParams) (resources.ServiceCredentialBinding, Warnings, error) {
+	var (
+		serviceInstance resources.ServiceInstance
+		app             resources.Application
+		binding         resources.ServiceCredentialBinding
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			serviceInstance, _, warnings, err = actor.getServiceInstanceByNameAndSpace(params.ServiceInstanceName, params.SpaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			app, warnings, err = actor.CloudControllerClient.GetApplicationByNameAndSpace(params.AppName, params.SpaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			binding, warnings, err = actor.getServiceAppBinding(serviceInstance.GUID, app.GUID)
+			return
+		},
+	)
+
+	switch err.(type) {
+	case nil:
+		return binding, Warnings(warnings), nil
+	case ccerror.ApplicationNotFoundError:
+		return resources.ServiceCredentialBinding{}, Warnings(warnings), actionerror.ApplicationNotFoundError{Name: params.AppName}
+	default:
+		return resources.ServiceCredentialBinding{}, Warnings(warnings), err
+	}
+}
+
+// DeleteServiceAppBindingByGUID deletes the app binding with the given GUID.
+// Unlike DeleteServiceAppBinding it can remove one of several bindings
+// between the same app and service instance.
+func (actor Actor) DeleteServiceAppBindingByGUID(bindingGUID string) (chan PollJobEvent, Warnings, error) {
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteServiceCredentialBinding(bindingGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	return actor.PollJobToEventStream(jobURL), Warnings(warnings), nil
+}
+
 func (actor Actor) createServiceAppBinding(serviceInstanceGUID, appGUID, bindingName string, parameters types.OptionalObject) (ccv3.JobURL, ccv3.Warnings, error) {
 	jobURL, warnings, err := actor.CloudControllerClient.CreateServiceCredentialBinding(resources.ServiceCredentialBinding{
 		Type:                resources.AppBinding,
@@ -191,6 +191,66 @@ var _ = Describe("Service App Binding Action", func() {
 			})
 		})
 
+		Describe("parameter validation", func() {
+			It("does not fetch the plan of a user-provided service instance", func() {
+				Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+			})
+
+			When("the service instance is managed", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{
+							Type:            resources.ManagedServiceInstance,
+							Name:            serviceInstanceName,
+							GUID:            serviceInstanceGUID,
+							ServicePlanGUID: "fake-plan-guid",
+						},
+						ccv3.IncludedResources{},
+						ccv3.Warnings{"get instance warning"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+						resources.ServicePlan{
+							ServiceBindingCreateSchema: map[string]interface{}{
+								"properties": map[string]interface{}{
+									"foo": map[string]interface{}{"enum": []interface{}{"baz"}},
+								},
+							},
+						},
+						ccv3.Warnings{"get plan warning"},
+						nil,
+					)
+				})
+
+				It("validates the parameters against the binding schema of the plan", func() {
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDArgsForCall(0)).To(Equal("fake-plan-guid"))
+
+					Expect(executionError).To(MatchError(actionerror.InvalidServiceParametersError{
+						Errors: []string{"$.foo: must be one of 'baz'"},
+					}))
+					Expect(warnings).To(ContainElement("get plan warning"))
+					Expect(fakeCloudControllerClient.CreateServiceCredentialBindingCallCount()).To(BeZero())
+				})
+
+				When("getting the plan fails", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+							resources.ServicePlan{},
+							ccv3.Warnings{"get plan warning"},
+							errors.New("plan boom"),
+						)
+					})
+
+					It("returns the error and warnings", func() {
+						Expect(executionError).To(MatchError("plan boom"))
+						Expect(warnings).To(ContainElement("get plan warning"))
+					})
+				})
+			})
+		})
+
 		Describe("initiating the create", func() {
 			It("makes the correct call", func() {
 				Expect(fakeCloudControllerClient.CreateServiceCredentialBindingCallCount()).To(Equal(1))
@@ -493,4 +553,117 @@ var _ = Describe("Service App Binding Action", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceAppBinding", func() {
+		var (
+			binding        resources.ServiceCredentialBinding
+			warnings       Warnings
+			executionError error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{GUID: "fake-service-instance-guid"},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get instance warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationByNameAndSpaceReturns(
+				resources.Application{GUID: "fake-app-guid"},
+				ccv3.Warnings{"get app warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+				[]resources.ServiceCredentialBinding{{GUID: "fake-binding-guid", Name: "primary-db"}},
+				ccv3.Warnings{"get bindings warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			binding, warnings, executionError = actor.GetServiceAppBinding(GetServiceAppBindingParams{
+				SpaceGUID:           "fake-space-guid",
+				ServiceInstanceName: "fake-service-instance-name",
+				AppName:             "fake-app-name",
+			})
+		})
+
+		It("returns the binding between the app and the service instance", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(binding).To(Equal(resources.ServiceCredentialBinding{GUID: "fake-binding-guid", Name: "primary-db"}))
+			Expect(warnings).To(ConsistOf("get instance warning", "get app warning", "get bindings warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ContainElements(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"fake-app-guid"}},
+				ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"fake-service-instance-guid"}},
+			))
+		})
+
+		When("the app is not bound to the service instance", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(nil, ccv3.Warnings{"get bindings warning"}, nil)
+			})
+
+			It("returns a ServiceBindingNotFoundError", func() {
+				Expect(executionError).To(MatchError(actionerror.ServiceBindingNotFoundError{
+					AppGUID:             "fake-app-guid",
+					ServiceInstanceGUID: "fake-service-instance-guid",
+				}))
+				Expect(warnings).To(ContainElement("get bindings warning"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationByNameAndSpaceReturns(resources.Application{}, ccv3.Warnings{"get app warning"}, ccerror.ApplicationNotFoundError{})
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executionError).To(MatchError(actionerror.ApplicationNotFoundError{Name: "fake-app-name"}))
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("DeleteServiceAppBindingByGUID", func() {
+		var (
+			stream         chan PollJobEvent
+			warnings       Warnings
+			executionError error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceCredentialBindingReturns("fake-job-url", ccv3.Warnings{"delete binding warning"}, nil)
+
+			fakeStream := make(chan ccv3.PollJobEvent)
+			fakeCloudControllerClient.PollJobToEventStreamReturns(fakeStream)
+			go func() {
+				fakeStream <- ccv3.PollJobEvent{State: constant.JobPolling, Warnings: ccv3.Warnings{"poll warning"}}
+			}()
+		})
+
+		JustBeforeEach(func() {
+			stream, warnings, executionError = actor.DeleteServiceAppBindingByGUID("fake-binding-guid")
+		})
+
+		It("deletes the binding and polls the job", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete binding warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceCredentialBindingArgsForCall(0)).To(Equal("fake-binding-guid"))
+			Expect(fakeCloudControllerClient.PollJobToEventStreamArgsForCall(0)).To(Equal(ccv3.JobURL("fake-job-url")))
+			Eventually(stream).Should(Receive(Equal(PollJobEvent{State: JobPolling, Warnings: Warnings{"poll warning"}})))
+		})
+
+		When("the delete fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceCredentialBindingReturns("", ccv3.Warnings{"delete binding warning"}, errors.New("boop"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError("boop"))
+				Expect(warnings).To(ConsistOf("delete binding warning"))
+				Expect(fakeCloudControllerClient.PollJobToEventStreamCallCount()).To(BeZero())
+			})
+		})
+	})
 })
@@ -0,0 +1,370 @@
+package v7action
+
+import (
+	"reflect"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/railway"
+	"code.cloudfoundry.org/cli/util/servicebroker"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ServiceBrokerCatalogFetcher
+
+type ServiceBrokerCatalogFetcher interface {
+	GetCatalog(brokerURL, username, password string) (servicebroker.Catalog, error)
+}
+
+type ServiceBrokerCatalog struct {
+	Offerings []ServiceBrokerCatalogOffering
+}
+
+type ServiceBrokerCatalogOffering struct {
+	GUID            string
+	BrokerCatalogID string
+	Name            string
+	Description     string
+	Tags            []string
+	Shareable       bool
+	Plans           []ServiceBrokerCatalogPlan
+}
+
+type ServiceBrokerCatalogPlan struct {
+	GUID                        string
+	BrokerCatalogID             string
+	Name                        string
+	Description                 string
+	Free                        bool
+	MaintenanceInfoVersion      string
+	VisibilityType              resources.ServicePlanVisibilityType
+	VisibilityDetails           []string
+	ServiceInstanceCreateSchema map[string]interface{}
+	ServiceInstanceUpdateSchema map[string]interface{}
+	ServiceBindingCreateSchema  map[string]interface{}
+}
+
+type CatalogChange string
+
+const (
+	CatalogEntryAdded     CatalogChange = "added"
+	CatalogEntryRemoved   CatalogChange = "removed"
+	CatalogEntryUpdated   CatalogChange = "updated"
+	CatalogEntryUnchanged CatalogChange = "unchanged"
+)
+
+type CatalogFieldChange struct {
+	Field    string
+	Current  interface{}
+	Proposed interface{}
+}
+
+type ServiceBrokerCatalogDiff struct {
+	Offerings []ServiceBrokerCatalogOfferingDiff
+}
+
+type ServiceBrokerCatalogOfferingDiff struct {
+	Name   string
+	Change CatalogChange
+	Fields []CatalogFieldChange
+	Plans  []ServiceBrokerCatalogPlanDiff
+}
+
+type ServiceBrokerCatalogPlanDiff struct {
+	Name   string
+	Change CatalogChange
+	Fields []CatalogFieldChange
+	// ServiceInstanceCount is the number of instances of a removed plan.
+	ServiceInstanceCount int
+}
+
+// HasChanges is true when any offering or plan would be added, removed or
+// updated.
+func (diff ServiceBrokerCatalogDiff) HasChanges() bool {
+	for _, offering := range diff.Offerings {
+		if offering.Change != CatalogEntryUnchanged {
+			return true
+		}
+		for _, plan := range offering.Plans {
+			if plan.Change != CatalogEntryUnchanged {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetServiceBrokerCatalog returns the offerings and plans that the Cloud
+// Controller currently has for the broker, sorted by name.
+func (actor Actor) GetServiceBrokerCatalog(serviceBrokerName string) (ServiceBrokerCatalog, Warnings, error) {
+	var (
+		offerings       []resources.ServiceOffering
+		plans           []resources.ServicePlan
+		plansWithSpaces []ccv3.ServicePlanWithSpaceAndOrganization
+	)
+
+	_, warnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
+	if err != nil {
+		return ServiceBrokerCatalog{}, warnings, err
+	}
+
+	brokerQuery := ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{serviceBrokerName}}
+	ccWarnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			offerings, warnings, err = actor.CloudControllerClient.GetServiceOfferings(brokerQuery)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			plans, warnings, err = actor.CloudControllerClient.GetServicePlans(brokerQuery)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			plansWithSpaces, warnings, err = actor.CloudControllerClient.GetServicePlansWithSpaceAndOrganization(brokerQuery)
+			return
+		},
+	)
+	warnings = append(warnings, ccWarnings...)
+	if err != nil {
+		return ServiceBrokerCatalog{}, warnings, err
+	}
+
+	visibilityLookup := make(map[string]ServicePlanWithSpaceAndOrganization)
+	for _, plan := range plansWithSpaces {
+		visibilityLookup[plan.GUID] = ServicePlanWithSpaceAndOrganization(plan)
+	}
+
+	plansByOffering := make(map[string][]ServiceBrokerCatalogPlan)
+	for _, plan := range plans {
+		visibilityDetails, visibilityWarnings, err := actor.getServicePlanVisibilityDetails(visibilityLookup[plan.GUID])
+		warnings = append(warnings, visibilityWarnings...)
+		if err != nil {
+			return ServiceBrokerCatalog{}, warnings, err
+		}
+
+		plansByOffering[plan.ServiceOfferingGUID] = append(plansByOffering[plan.ServiceOfferingGUID], ServiceBrokerCatalogPlan{
+			GUID:                        plan.GUID,
+			BrokerCatalogID:             plan.BrokerCatalogID,
+			Name:                        plan.Name,
+			Description:                 plan.Description,
+			Free:                        plan.Free,
+			MaintenanceInfoVersion:      plan.MaintenanceInfoVersion,
+			VisibilityType:              plan.VisibilityType,
+			VisibilityDetails:           visibilityDetails,
+			ServiceInstanceCreateSchema: plan.ServiceInstanceCreateSchema,
+			ServiceInstanceUpdateSchema: plan.ServiceInstanceUpdateSchema,
+			ServiceBindingCreateSchema:  plan.ServiceBindingCreateSchema,
+		})
+	}
+
+	var catalog ServiceBrokerCatalog
+	for _, offering := range offerings {
+		offeringPlans := plansByOffering[offering.GUID]
+		sort.Slice(offeringPlans, func(i, j int) bool { return offeringPlans[i].Name < offeringPlans[j].Name })
+
+		catalog.Offerings = append(catalog.Offerings, ServiceBrokerCatalogOffering{
+			GUID:            offering.GUID,
+			BrokerCatalogID: offering.BrokerCatalogID,
+			Name:            offering.Name,
+			Description:     offering.Description,
+			Tags:            offering.Tags.Value,
+			Shareable:       offering.AllowsInstanceSharing,
+			Plans:           offeringPlans,
+		})
+	}
+	sort.Slice(catalog.Offerings, func(i, j int) bool { return catalog.Offerings[i].Name < catalog.Offerings[j].Name })
+
+	return catalog, warnings, nil
+}
+
+// DiffServiceBrokerCatalog compares the catalog the Cloud Controller has for
+// the broker with the catalog the broker at brokerURL returns, as updating the
+// broker with these credentials would. Offerings and plans are
+// matched by their broker catalog IDs, as the Cloud Controller does when the
+// broker is updated. Removed plans are returned with the number of service
+// instances that still use them.
+func (actor Actor) DiffServiceBrokerCatalog(serviceBrokerName, brokerURL, username, password string, fetcher ServiceBrokerCatalogFetcher) (ServiceBrokerCatalogDiff, Warnings, error) {
+	current, warnings, err := actor.GetServiceBrokerCatalog(serviceBrokerName)
+	if err != nil {
+		return ServiceBrokerCatalogDiff{}, warnings, err
+	}
+
+	proposed, err := fetcher.GetCatalog(brokerURL, username, password)
+	if err != nil {
+		return ServiceBrokerCatalogDiff{}, warnings, err
+	}
+
+	proposedPlanIDs := make(map[string]map[string]bool)
+	for _, service := range proposed.Services {
+		proposedPlanIDs[service.ID] = make(map[string]bool)
+		for _, plan := range service.Plans {
+			proposedPlanIDs[service.ID][plan.ID] = true
+		}
+	}
+
+	var removedPlanGUIDs []string
+	for _, offering := range current.Offerings {
+		for _, plan := range offering.Plans {
+			if !proposedPlanIDs[offering.BrokerCatalogID][plan.BrokerCatalogID] {
+				removedPlanGUIDs = append(removedPlanGUIDs, plan.GUID)
+			}
+		}
+	}
+
+	instanceCounts, countWarnings, err := actor.countServiceInstancesByPlan(removedPlanGUIDs)
+	warnings = append(warnings, countWarnings...)
+	if err != nil {
+		return ServiceBrokerCatalogDiff{}, warnings, err
+	}
+
+	currentOfferings := make(map[string]ServiceBrokerCatalogOffering)
+	for _, offering := range current.Offerings {
+		currentOfferings[offering.BrokerCatalogID] = offering
+	}
+
+	var diff ServiceBrokerCatalogDiff
+	for _, service := range proposed.Services {
+		currentOffering, exists := currentOfferings[service.ID]
+		if !exists {
+			offeringDiff := ServiceBrokerCatalogOfferingDiff{Name: service.Name, Change: CatalogEntryAdded}
+			for _, plan := range service.Plans {
+				offeringDiff.Plans = append(offeringDiff.Plans, ServiceBrokerCatalogPlanDiff{Name: plan.Name, Change: CatalogEntryAdded})
+			}
+			diff.Offerings = append(diff.Offerings, offeringDiff)
+			continue
+		}
+
+		diff.Offerings = append(diff.Offerings, diffCatalogOffering(currentOffering, service, instanceCounts))
+	}
+
+	for _, offering := range current.Offerings {
+		if _, ok := proposedPlanIDs[offering.BrokerCatalogID]; ok {
+			continue
+		}
+
+		offeringDiff := ServiceBrokerCatalogOfferingDiff{Name: offering.Name, Change: CatalogEntryRemoved}
+		for _, plan := range offering.Plans {
+			offeringDiff.Plans = append(offeringDiff.Plans, removedCatalogPlan(plan, instanceCounts))
+		}
+		diff.Offerings = append(diff.Offerings, offeringDiff)
+	}
+
+	return diff, warnings, nil
+}
+
+func (actor Actor) countServiceInstancesByPlan(planGUIDs []string) (map[string]int, Warnings, error) {
+	counts := make(map[string]int)
+	if len(planGUIDs) == 0 {
+		return counts, nil, nil
+	}
+
+	warnings, err := batcher.RequestByGUID(planGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		instances, _, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+			ccv3.Query{Key: ccv3.ServicePlanGUIDsFilter, Values: guids},
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+		)
+		for _, instance := range instances {
+			counts[instance.ServicePlanGUID]++
+		}
+		return warnings, err
+	})
+
+	return counts, Warnings(warnings), err
+}
+
+func diffCatalogOffering(current ServiceBrokerCatalogOffering, proposed servicebroker.Service, instanceCounts map[string]int) ServiceBrokerCatalogOfferingDiff {
+	offeringDiff := ServiceBrokerCatalogOfferingDiff{
+		Name: proposed.Name,
+		Fields: diffCatalogFields(
+			CatalogFieldChange{Field: "name", Current: current.Name, Proposed: proposed.Name},
+			CatalogFieldChange{Field: "description", Current: current.Description, Proposed: proposed.Description},
+			CatalogFieldChange{Field: "tags", Current: nonNilStrings(current.Tags), Proposed: nonNilStrings(proposed.Tags)},
+			CatalogFieldChange{Field: "shareable", Current: current.Shareable, Proposed: proposed.Shareable()},
+		),
+	}
+
+	currentPlans := make(map[string]ServiceBrokerCatalogPlan)
+	for _, plan := range current.Plans {
+		currentPlans[plan.BrokerCatalogID] = plan
+	}
+
+	proposedPlanIDs := make(map[string]bool)
+	for _, plan := range proposed.Plans {
+		proposedPlanIDs[plan.ID] = true
+
+		currentPlan, exists := currentPlans[plan.ID]
+		if !exists {
+			offeringDiff.Plans = append(offeringDiff.Plans, ServiceBrokerCatalogPlanDiff{Name: plan.Name, Change: CatalogEntryAdded})
+			continue
+		}
+
+		planDiff := ServiceBrokerCatalogPlanDiff{
+			Name: plan.Name,
+			Fields: diffCatalogFields(
+				CatalogFieldChange{Field: "name", Current: currentPlan.Name, Proposed: plan.Name},
+				CatalogFieldChange{Field: "description", Current: currentPlan.Description, Proposed: plan.Description},
+				CatalogFieldChange{Field: "free", Current: currentPlan.Free, Proposed: plan.IsFree()},
+				CatalogFieldChange{Field: "maintenance_info_version", Current: currentPlan.MaintenanceInfoVersion, Proposed: plan.MaintenanceInfoVersion()},
+				CatalogFieldChange{Field: "service_instance_create_schema", Current: nonNilObject(currentPlan.ServiceInstanceCreateSchema), Proposed: nonNilObject(plan.Schemas.ServiceInstance.Create.Parameters)},
+				CatalogFieldChange{Field: "service_instance_update_schema", Current: nonNilObject(currentPlan.ServiceInstanceUpdateSchema), Proposed: nonNilObject(plan.Schemas.ServiceInstance.Update.Parameters)},
+				CatalogFieldChange{Field: "service_binding_create_schema", Current: nonNilObject(currentPlan.ServiceBindingCreateSchema), Proposed: nonNilObject(plan.Schemas.ServiceBinding.Create.Parameters)},
+			),
+		}
+		planDiff.Change = changeFromFields(planDiff.Fields)
+		offeringDiff.Plans = append(offeringDiff.Plans, planDiff)
+	}
+
+	for _, plan := range current.Plans {
+		if !proposedPlanIDs[plan.BrokerCatalogID] {
+			offeringDiff.Plans = append(offeringDiff.Plans, removedCatalogPlan(plan, instanceCounts))
+		}
+	}
+
+	offeringDiff.Change = changeFromFields(offeringDiff.Fields)
+	for _, plan := range offeringDiff.Plans {
+		if plan.Change != CatalogEntryUnchanged {
+			offeringDiff.Change = CatalogEntryUpdated
+		}
+	}
+
+	return offeringDiff
+}
+
+func removedCatalogPlan(plan ServiceBrokerCatalogPlan, instanceCounts map[string]int) ServiceBrokerCatalogPlanDiff {
+	return ServiceBrokerCatalogPlanDiff{
+		Name:                 plan.Name,
+		Change:               CatalogEntryRemoved,
+		ServiceInstanceCount: instanceCounts[plan.GUID],
+	}
+}
+
+func diffCatalogFields(fields ...CatalogFieldChange) []CatalogFieldChange {
+	var changed []CatalogFieldChange
+	for _, field := range fields {
+		if !reflect.DeepEqual(field.Current, field.Proposed) {
+			changed = append(changed, field)
+		}
+	}
+	return changed
+}
+
+func changeFromFields(fields []CatalogFieldChange) CatalogChange {
+	if len(fields) > 0 {
+		return CatalogEntryUpdated
+	}
+	return CatalogEntryUnchanged
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func nonNilObject(o map[string]interface{}) map[string]interface{} {
+	if o == nil {
+		return map[string]interface{}{}
+	}
+	return o
+}
@@ -0,0 +1,341 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/servicebroker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Broker Catalog Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		fakeCloudControllerClient.GetServiceBrokersReturns(
+			[]resources.ServiceBroker{{GUID: "broker-guid", Name: "my-broker"}},
+			ccv3.Warnings{"broker warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServiceOfferingsReturns(
+			[]resources.ServiceOffering{
+				{GUID: "redis-guid", BrokerCatalogID: "redis-id", Name: "redis", Description: "Redis", Tags: types.NewOptionalStringSlice("kv")},
+				{GUID: "mysql-guid", BrokerCatalogID: "mysql-id", Name: "mysql", Description: "MySQL", AllowsInstanceSharing: true},
+			},
+			ccv3.Warnings{"offerings warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServicePlansReturns(
+			[]resources.ServicePlan{
+				{
+					GUID:                        "large-guid",
+					BrokerCatalogID:             "large-id",
+					Name:                        "large",
+					ServiceOfferingGUID:         "mysql-guid",
+					VisibilityType:              resources.ServicePlanVisibilityOrganization,
+					MaintenanceInfoVersion:      "1.0.0",
+					ServiceInstanceCreateSchema: map[string]interface{}{"type": "object"},
+				},
+				{
+					GUID:                "small-guid",
+					BrokerCatalogID:     "small-id",
+					Name:                "small",
+					ServiceOfferingGUID: "mysql-guid",
+					Free:                true,
+					VisibilityType:      resources.ServicePlanVisibilityPublic,
+				},
+				{
+					GUID:                "cache-guid",
+					BrokerCatalogID:     "cache-id",
+					Name:                "cache",
+					ServiceOfferingGUID: "redis-guid",
+					Free:                true,
+					VisibilityType:      resources.ServicePlanVisibilitySpace,
+				},
+			},
+			ccv3.Warnings{"plans warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServicePlansWithSpaceAndOrganizationReturns(
+			[]ccv3.ServicePlanWithSpaceAndOrganization{
+				{GUID: "large-guid", VisibilityType: resources.ServicePlanVisibilityOrganization},
+				{GUID: "small-guid", VisibilityType: resources.ServicePlanVisibilityPublic},
+				{GUID: "cache-guid", VisibilityType: resources.ServicePlanVisibilitySpace, SpaceName: "dev", OrganizationName: "org-1"},
+			},
+			ccv3.Warnings{"plan spaces warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServicePlanVisibilityReturns(
+			resources.ServicePlanVisibility{
+				Type:          resources.ServicePlanVisibilityOrganization,
+				Organizations: []resources.ServicePlanVisibilityDetail{{Name: "org-1"}, {Name: "org-2"}},
+			},
+			ccv3.Warnings{"visibility warning"},
+			nil,
+		)
+	})
+
+	Describe("GetServiceBrokerCatalog", func() {
+		var (
+			catalog    ServiceBrokerCatalog
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			catalog, warnings, executeErr = actor.GetServiceBrokerCatalog("my-broker")
+		})
+
+		It("queries the offerings and plans of the broker", func() {
+			brokerQuery := ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{"my-broker"}}
+			Expect(fakeCloudControllerClient.GetServiceOfferingsArgsForCall(0)).To(ConsistOf(brokerQuery))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(brokerQuery))
+			Expect(fakeCloudControllerClient.GetServicePlansWithSpaceAndOrganizationArgsForCall(0)).To(ConsistOf(brokerQuery))
+
+			Expect(fakeCloudControllerClient.GetServicePlanVisibilityCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServicePlanVisibilityArgsForCall(0)).To(Equal("large-guid"))
+		})
+
+		It("returns the catalog sorted by name, and all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("broker warning", "offerings warning", "plans warning", "plan spaces warning", "visibility warning"))
+			Expect(catalog).To(Equal(ServiceBrokerCatalog{
+				Offerings: []ServiceBrokerCatalogOffering{
+					{
+						GUID:            "mysql-guid",
+						BrokerCatalogID: "mysql-id",
+						Name:            "mysql",
+						Description:     "MySQL",
+						Shareable:       true,
+						Plans: []ServiceBrokerCatalogPlan{
+							{
+								GUID:                        "large-guid",
+								BrokerCatalogID:             "large-id",
+								Name:                        "large",
+								MaintenanceInfoVersion:      "1.0.0",
+								VisibilityType:              resources.ServicePlanVisibilityOrganization,
+								VisibilityDetails:           []string{"org-1", "org-2"},
+								ServiceInstanceCreateSchema: map[string]interface{}{"type": "object"},
+							},
+							{
+								GUID:            "small-guid",
+								BrokerCatalogID: "small-id",
+								Name:            "small",
+								Free:            true,
+								VisibilityType:  resources.ServicePlanVisibilityPublic,
+							},
+						},
+					},
+					{
+						GUID:            "redis-guid",
+						BrokerCatalogID: "redis-id",
+						Name:            "redis",
+						Description:     "Redis",
+						Tags:            []string{"kv"},
+						Plans: []ServiceBrokerCatalogPlan{
+							{
+								GUID:              "cache-guid",
+								BrokerCatalogID:   "cache-id",
+								Name:              "cache",
+								Free:              true,
+								VisibilityType:    resources.ServicePlanVisibilitySpace,
+								VisibilityDetails: []string{"dev (org: org-1)"},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		When("the broker does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBrokersReturns(nil, ccv3.Warnings{"broker warning"}, nil)
+			})
+
+			It("returns a not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBrokerNotFoundError{Name: "my-broker"}))
+				Expect(warnings).To(ConsistOf("broker warning"))
+				Expect(fakeCloudControllerClient.GetServiceOfferingsCallCount()).To(BeZero())
+			})
+		})
+
+		When("getting the plans fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plans warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("broker warning", "offerings warning", "plans warning"))
+			})
+		})
+	})
+
+	Describe("DiffServiceBrokerCatalog", func() {
+		var (
+			fakeFetcher *v7actionfakes.FakeServiceBrokerCatalogFetcher
+			proposed    servicebroker.Catalog
+			fetchErr    error
+			diff        ServiceBrokerCatalogDiff
+			warnings    Warnings
+			executeErr  error
+		)
+
+		notFree := false
+
+		BeforeEach(func() {
+			fakeFetcher = new(v7actionfakes.FakeServiceBrokerCatalogFetcher)
+			fetchErr = nil
+			proposed = servicebroker.Catalog{
+				Services: []servicebroker.Service{
+					{
+						ID:          "mysql-id",
+						Name:        "mysql",
+						Description: "MySQL databases",
+						Metadata:    map[string]interface{}{"shareable": true},
+						Plans: []servicebroker.Plan{
+							{
+								ID:              "large-id",
+								Name:            "large",
+								Free:            &notFree,
+								MaintenanceInfo: &servicebroker.MaintenanceInfo{Version: "1.0.0"},
+								Schemas: servicebroker.Schemas{
+									ServiceInstance: servicebroker.ServiceInstanceSchemas{
+										Create: servicebroker.SchemaParameters{Parameters: map[string]interface{}{"type": "object"}},
+									},
+								},
+							},
+							{ID: "xl-id", Name: "xl"},
+						},
+					},
+					{ID: "kafka-id", Name: "kafka", Plans: []servicebroker.Plan{{ID: "topic-id", Name: "topic"}}},
+				},
+			}
+
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{Name: "db-1", ServicePlanGUID: "small-guid"},
+					{Name: "db-2", ServicePlanGUID: "small-guid"},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"instances warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			fakeFetcher.GetCatalogReturns(proposed, fetchErr)
+			diff, warnings, executeErr = actor.DiffServiceBrokerCatalog("my-broker", "https://broker.example.com", "user", "pass", fakeFetcher)
+		})
+
+		It("fetches the catalog from the broker", func() {
+			Expect(fakeFetcher.GetCatalogCallCount()).To(Equal(1))
+			url, username, password := fakeFetcher.GetCatalogArgsForCall(0)
+			Expect(url).To(Equal("https://broker.example.com"))
+			Expect(username).To(Equal("user"))
+			Expect(password).To(Equal("pass"))
+		})
+
+		It("counts the instances of removed plans", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServicePlanGUIDsFilter, Values: []string{"small-guid", "cache-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("returns what would change", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement("instances warning"))
+			Expect(diff.HasChanges()).To(BeTrue())
+			Expect(diff.Offerings).To(Equal([]ServiceBrokerCatalogOfferingDiff{
+				{
+					Name:   "mysql",
+					Change: CatalogEntryUpdated,
+					Fields: []CatalogFieldChange{
+						{Field: "description", Current: "MySQL", Proposed: "MySQL databases"},
+					},
+					Plans: []ServiceBrokerCatalogPlanDiff{
+						{Name: "large", Change: CatalogEntryUnchanged},
+						{Name: "xl", Change: CatalogEntryAdded},
+						{Name: "small", Change: CatalogEntryRemoved, ServiceInstanceCount: 2},
+					},
+				},
+				{
+					Name:   "kafka",
+					Change: CatalogEntryAdded,
+					Plans:  []ServiceBrokerCatalogPlanDiff{{Name: "topic", Change: CatalogEntryAdded}},
+				},
+				{
+					Name:   "redis",
+					Change: CatalogEntryRemoved,
+					Plans:  []ServiceBrokerCatalogPlanDiff{{Name: "cache", Change: CatalogEntryRemoved}},
+				},
+			}))
+		})
+
+		When("a plan changes", func() {
+			BeforeEach(func() {
+				proposed.Services[0].Plans[0].MaintenanceInfo.Version = "2.0.0"
+				proposed.Services[0].Plans[0].Free = nil
+			})
+
+			It("returns the changed fields", func() {
+				Expect(diff.Offerings[0].Plans[0]).To(Equal(ServiceBrokerCatalogPlanDiff{
+					Name:   "large",
+					Change: CatalogEntryUpdated,
+					Fields: []CatalogFieldChange{
+						{Field: "free", Current: false, Proposed: true},
+						{Field: "maintenance_info_version", Current: "1.0.0", Proposed: "2.0.0"},
+					},
+				}))
+			})
+		})
+
+		When("no plans are removed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, nil, nil)
+				fakeCloudControllerClient.GetServiceOfferingsReturns(nil, nil, nil)
+			})
+
+			It("does not look for service instances", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(BeZero())
+			})
+		})
+
+		When("fetching the catalog from the broker fails", func() {
+			BeforeEach(func() {
+				fetchErr = errors.New("unauthorized")
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("unauthorized"))
+				Expect(warnings).To(ContainElement("broker warning"))
+			})
+		})
+
+		When("counting the service instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"instances warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("instances warning"))
+			})
+		})
+	})
+})
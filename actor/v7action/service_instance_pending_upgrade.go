@@ -0,0 +1,111 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/extract"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+)
+
+type ServiceInstanceWithPendingUpgrade struct {
+	Name                string
+	SpaceGUID           string
+	SpaceName           string
+	OrganizationName    string
+	ServiceOfferingName string
+	ServicePlanName     string
+	ServiceBrokerName   string
+	// CurrentVersion is the maintenance info version of the instance.
+	CurrentVersion string
+	// AvailableVersion is the maintenance info version of the plan.
+	AvailableVersion string
+	// UpgradeNotes is the maintenance info description the broker gives for
+	// the plan.
+	UpgradeNotes string
+}
+
+type PendingUpgradesParams struct {
+	// SpaceGUID restricts the search to one space.
+	SpaceGUID string
+	// OrganizationGUIDs restricts the search to the given orgs. When neither
+	// SpaceGUID nor OrganizationGUIDs is set, instances in every org visible
+	// to the user are returned.
+	OrganizationGUIDs []string
+}
+
+// GetServiceInstancesWithPendingUpgrades returns the managed service instances
+// whose plan has a newer maintenance info version than the instance.
+func (actor Actor) GetServiceInstancesWithPendingUpgrades(params PendingUpgradesParams) ([]ServiceInstanceWithPendingUpgrade, Warnings, error) {
+	query := []ccv3.Query{
+		{Key: ccv3.TypeFilter, Values: []string{string(resources.ManagedServiceInstance)}},
+		{Key: ccv3.FieldsSpace, Values: []string{"guid", "name", "relationships.organization"}},
+		{Key: ccv3.FieldsSpaceOrganization, Values: []string{"guid", "name"}},
+		{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
+		{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name", "relationships.service_broker"}},
+		{Key: ccv3.FieldsServicePlanServiceOfferingServiceBroker, Values: []string{"guid", "name"}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+	switch {
+	case params.SpaceGUID != "":
+		query = append(query, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{params.SpaceGUID}})
+	case len(params.OrganizationGUIDs) > 0:
+		query = append(query, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: params.OrganizationGUIDs})
+	}
+
+	instances, included, warnings, err := actor.CloudControllerClient.GetServiceInstances(query...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var upgradable []resources.ServiceInstance
+	for _, instance := range instances {
+		if instance.UpgradeAvailable.Value {
+			upgradable = append(upgradable, instance)
+		}
+	}
+
+	plans := make(map[string]resources.ServicePlan)
+	planWarnings, err := batcher.RequestByGUID(extract.UniqueList("ServicePlanGUID", upgradable), func(guids []string) (ccv3.Warnings, error) {
+		batch, warnings, err := actor.CloudControllerClient.GetServicePlans(ccv3.Query{Key: ccv3.GUIDFilter, Values: guids})
+		for _, plan := range batch {
+			plans[plan.GUID] = plan
+		}
+		return warnings, err
+	})
+	warnings = append(warnings, planWarnings...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	planDetailsLookup := buildPlanDetailsLookup(included)
+	orgNameLookup := lookuptable.NameFromGUID(included.Organizations)
+	spaceLookup := make(map[string]resources.Space)
+	for _, space := range included.Spaces {
+		spaceLookup[space.GUID] = space
+	}
+
+	result := make([]ServiceInstanceWithPendingUpgrade, len(upgradable))
+	for i, instance := range upgradable {
+		space := spaceLookup[instance.SpaceGUID]
+		names := planDetailsLookup[instance.ServicePlanGUID]
+		plan := plans[instance.ServicePlanGUID]
+
+		result[i] = ServiceInstanceWithPendingUpgrade{
+			Name:                instance.Name,
+			SpaceGUID:           instance.SpaceGUID,
+			SpaceName:           space.Name,
+			OrganizationName:    orgNameLookup[space.Relationships[constant.RelationshipTypeOrganization].GUID],
+			ServiceOfferingName: names.offering,
+			ServicePlanName:     names.plan,
+			ServiceBrokerName:   names.broker,
+			CurrentVersion:      instance.MaintenanceInfoVersion,
+			AvailableVersion:    plan.MaintenanceInfoVersion,
+			UpgradeNotes:        plan.MaintenanceInfoDescription,
+		}
+	}
+
+	return result, Warnings(warnings), nil
+}
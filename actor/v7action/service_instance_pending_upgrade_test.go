@@ -0,0 +1,171 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Pending Upgrade Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetServiceInstancesWithPendingUpgrades", func() {
+		var (
+			params     PendingUpgradesParams
+			instances  []ServiceInstanceWithPendingUpgrade
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			params = PendingUpgradesParams{SpaceGUID: "space-guid"}
+
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{
+						Name:                   "db-1",
+						SpaceGUID:              "space-guid",
+						ServicePlanGUID:        "plan-guid",
+						UpgradeAvailable:       types.NewOptionalBoolean(true),
+						MaintenanceInfoVersion: "1.0.0",
+					},
+					{
+						Name:             "db-2",
+						SpaceGUID:        "space-guid",
+						ServicePlanGUID:  "plan-guid",
+						UpgradeAvailable: types.NewOptionalBoolean(false),
+					},
+				},
+				ccv3.IncludedResources{
+					Spaces: []resources.Space{{
+						GUID: "space-guid",
+						Name: "dev",
+						Relationships: resources.Relationships{
+							constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-guid"},
+						},
+					}},
+					Organizations:    []resources.Organization{{GUID: "org-guid", Name: "org-1"}},
+					ServicePlans:     []resources.ServicePlan{{GUID: "plan-guid", Name: "small", ServiceOfferingGUID: "offering-guid"}},
+					ServiceOfferings: []resources.ServiceOffering{{GUID: "offering-guid", Name: "mysql", ServiceBrokerGUID: "broker-guid"}},
+					ServiceBrokers:   []resources.ServiceBroker{{GUID: "broker-guid", Name: "mysql-broker"}},
+				},
+				ccv3.Warnings{"instances warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]resources.ServicePlan{{
+					GUID:                       "plan-guid",
+					MaintenanceInfoVersion:     "1.1.0",
+					MaintenanceInfoDescription: "Security patches",
+				}},
+				ccv3.Warnings{"plans warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			instances, warnings, executeErr = actor.GetServiceInstancesWithPendingUpgrades(params)
+		})
+
+		It("queries the managed instances of the space", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"managed"}},
+				ccv3.Query{Key: ccv3.FieldsSpace, Values: []string{"guid", "name", "relationships.organization"}},
+				ccv3.Query{Key: ccv3.FieldsSpaceOrganization, Values: []string{"guid", "name"}},
+				ccv3.Query{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
+				ccv3.Query{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name", "relationships.service_broker"}},
+				ccv3.Query{Key: ccv3.FieldsServicePlanServiceOfferingServiceBroker, Values: []string{"guid", "name"}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+		})
+
+		It("gets the plans of the instances with an upgrade available", func() {
+			Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"plan-guid"}},
+			))
+		})
+
+		It("returns the instances with pending upgrades and all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instances warning", "plans warning"))
+			Expect(instances).To(Equal([]ServiceInstanceWithPendingUpgrade{{
+				Name:                "db-1",
+				SpaceGUID:           "space-guid",
+				SpaceName:           "dev",
+				OrganizationName:    "org-1",
+				ServiceOfferingName: "mysql",
+				ServicePlanName:     "small",
+				ServiceBrokerName:   "mysql-broker",
+				CurrentVersion:      "1.0.0",
+				AvailableVersion:    "1.1.0",
+				UpgradeNotes:        "Security patches",
+			}}))
+		})
+
+		When("orgs are given instead of a space", func() {
+			BeforeEach(func() {
+				params = PendingUpgradesParams{OrganizationGUIDs: []string{"org-guid"}}
+			})
+
+			It("filters by org", func() {
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				))
+			})
+		})
+
+		When("no instance has an upgrade available", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+			})
+
+			It("does not get any plans", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(instances).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(BeZero())
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"instances warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("instances warning"))
+			})
+		})
+
+		When("getting the plans fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plans warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("instances warning", "plans warning"))
+			})
+		})
+	})
+})
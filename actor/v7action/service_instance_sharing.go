@@ -2,8 +2,12 @@ package v7action
 
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/extract"
+	"code.cloudfoundry.org/cli/util/lookuptable"
 	"code.cloudfoundry.org/cli/util/railway"
 )
 
@@ -95,3 +99,210 @@ func (actor Actor) validateSharingDetails(
 
 	return serviceInstance, shareSpace, warnings, nil
 }
+
+type SharedSpaceUsage struct {
+	SpaceGUID        string
+	SpaceName        string
+	OrganizationName string
+	BoundAppCount    int
+}
+
+type ServiceInstanceSharedSpaces struct {
+	ServiceInstanceGUID string
+	Spaces              []SharedSpaceUsage
+}
+
+// ServiceInstanceShare is a sharing relationship between a space in an org
+// and a space elsewhere. When SharedIn is true, the instance belongs to the
+// other space and is shared into SpaceName; otherwise it belongs to SpaceName
+// and is shared into the other space.
+type ServiceInstanceShare struct {
+	ServiceInstanceName   string
+	SharedIn              bool
+	SpaceName             string
+	OtherOrganizationName string
+	OtherSpaceName        string
+	// BoundAppCount is the number of apps bound to the instance in the space
+	// it is shared into.
+	BoundAppCount int
+}
+
+// GetServiceInstanceSharedSpacesUsage returns the spaces the service instance
+// is shared into, with the number of apps bound to it in each.
+func (actor Actor) GetServiceInstanceSharedSpacesUsage(serviceInstanceName, spaceGUID string) (ServiceInstanceSharedSpaces, Warnings, error) {
+	var (
+		serviceInstance resources.ServiceInstance
+		sharedSpaces    []ccv3.SpaceWithOrganization
+		usageSummaries  []resources.ServiceInstanceUsageSummary
+	)
+
+	warnings, err := handleServiceInstanceErrors(railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			serviceInstance, _, warnings, err = actor.CloudControllerClient.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			sharedSpaces, warnings, err = actor.CloudControllerClient.GetServiceInstanceSharedSpaces(serviceInstance.GUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			if len(sharedSpaces) > 0 {
+				usageSummaries, warnings, err = actor.CloudControllerClient.GetServiceInstanceUsageSummary(serviceInstance.GUID)
+			}
+			return
+		},
+	))
+	if err != nil {
+		return ServiceInstanceSharedSpaces{}, warnings, err
+	}
+
+	boundAppCounts := make(map[string]int)
+	for _, summary := range usageSummaries {
+		boundAppCounts[summary.SpaceGUID] = summary.BoundAppCount
+	}
+
+	result := ServiceInstanceSharedSpaces{ServiceInstanceGUID: serviceInstance.GUID}
+	for _, space := range sharedSpaces {
+		result.Spaces = append(result.Spaces, SharedSpaceUsage{
+			SpaceGUID:        space.SpaceGUID,
+			SpaceName:        space.SpaceName,
+			OrganizationName: space.OrganizationName,
+			BoundAppCount:    boundAppCounts[space.SpaceGUID],
+		})
+	}
+
+	return result, warnings, nil
+}
+
+// UnshareServiceInstanceFromSpaces unshares the service instance from each of
+// the spaces, stopping at the first failure.
+func (actor Actor) UnshareServiceInstanceFromSpaces(serviceInstanceGUID string, spaceGUIDs []string) (Warnings, error) {
+	var allWarnings Warnings
+	for _, spaceGUID := range spaceGUIDs {
+		warnings, err := actor.CloudControllerClient.UnshareServiceInstanceFromSpace(serviceInstanceGUID, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
+
+// GetServiceInstanceSharesForOrganization returns the sharing relationships of
+// the managed service instances that belong to, or are shared into, the
+// spaces of the org.
+func (actor Actor) GetServiceInstanceSharesForOrganization(orgGUID string) ([]ServiceInstanceShare, Warnings, error) {
+	spaces, allWarnings, err := actor.GetOrganizationSpaces(orgGUID)
+	if err != nil || len(spaces) == 0 {
+		return nil, allWarnings, err
+	}
+
+	orgSpaces := make(map[string]resources.Space)
+	for _, space := range spaces {
+		orgSpaces[space.GUID] = space
+	}
+
+	instances, included, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(resources.ManagedServiceInstance)}},
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: extract.UniqueList("GUID", spaces)},
+		ccv3.Query{Key: ccv3.FieldsSpace, Values: []string{"guid", "name", "relationships.organization"}},
+		ccv3.Query{Key: ccv3.FieldsSpaceOrganization, Values: []string{"guid", "name"}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgNameLookup := lookuptable.NameFromGUID(included.Organizations)
+	ownerSpaces := make(map[string]resources.Space)
+	for _, space := range included.Spaces {
+		ownerSpaces[space.GUID] = space
+	}
+
+	// The shared spaces of an owned instance can only be listed one instance
+	// at a time, but the apps bound in them are counted for all instances at
+	// once. An instance shared into the org needs its usage summary anyway to
+	// know which spaces of the org it is shared into, and the summary carries
+	// the counts.
+	sharedSpaces := make(map[string][]ccv3.SpaceWithOrganization)
+	usageSummaries := make(map[string][]resources.ServiceInstanceUsageSummary)
+	var ownedGUIDs []string
+	for _, instance := range instances {
+		if _, owned := orgSpaces[instance.SpaceGUID]; owned {
+			shared, warnings, err := actor.CloudControllerClient.GetServiceInstanceSharedSpaces(instance.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			if len(shared) > 0 {
+				sharedSpaces[instance.GUID] = shared
+				ownedGUIDs = append(ownedGUIDs, instance.GUID)
+			}
+			continue
+		}
+
+		summaries, warnings, err := actor.CloudControllerClient.GetServiceInstanceUsageSummary(instance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		usageSummaries[instance.GUID] = summaries
+	}
+
+	boundAppCounts := make(map[string]map[string]int)
+	warnings, err = batcher.RequestByGUID(ownedGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		bindings, warnings, err := actor.CloudControllerClient.GetServiceCredentialBindings(
+			ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: guids},
+			ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(resources.AppBinding)}},
+			ccv3.Query{Key: ccv3.Include, Values: []string{"app"}},
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+		)
+		for _, binding := range bindings {
+			if boundAppCounts[binding.ServiceInstanceGUID] == nil {
+				boundAppCounts[binding.ServiceInstanceGUID] = make(map[string]int)
+			}
+			boundAppCounts[binding.ServiceInstanceGUID][binding.AppSpaceGUID]++
+		}
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var shares []ServiceInstanceShare
+	for _, instance := range instances {
+		if ownerSpace, owned := orgSpaces[instance.SpaceGUID]; owned {
+			for _, space := range sharedSpaces[instance.GUID] {
+				shares = append(shares, ServiceInstanceShare{
+					ServiceInstanceName:   instance.Name,
+					SpaceName:             ownerSpace.Name,
+					OtherOrganizationName: space.OrganizationName,
+					OtherSpaceName:        space.SpaceName,
+					BoundAppCount:         boundAppCounts[instance.GUID][space.SpaceGUID],
+				})
+			}
+			continue
+		}
+
+		owner := ownerSpaces[instance.SpaceGUID]
+		for _, summary := range usageSummaries[instance.GUID] {
+			space, ok := orgSpaces[summary.SpaceGUID]
+			if !ok {
+				continue
+			}
+			shares = append(shares, ServiceInstanceShare{
+				ServiceInstanceName:   instance.Name,
+				SharedIn:              true,
+				SpaceName:             space.Name,
+				OtherOrganizationName: orgNameLookup[owner.Relationships[constant.RelationshipTypeOrganization].GUID],
+				OtherSpaceName:        owner.Name,
+				BoundAppCount:         summary.BoundAppCount,
+			})
+		}
+	}
+
+	return shares, allWarnings, nil
+}
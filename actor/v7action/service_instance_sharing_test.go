@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
@@ -325,4 +326,256 @@ var _ = Describe("Service Instance Sharing", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceInstanceSharedSpacesUsage", func() {
+		var sharedSpaces ServiceInstanceSharedSpaces
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{GUID: "instance-guid"},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"instance warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(
+				[]ccv3.SpaceWithOrganization{
+					{SpaceGUID: "space-1-guid", SpaceName: "space-1", OrganizationName: "org-1"},
+					{SpaceGUID: "space-2-guid", SpaceName: "space-2", OrganizationName: "org-2"},
+				},
+				ccv3.Warnings{"shared spaces warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceUsageSummaryReturns(
+				[]resources.ServiceInstanceUsageSummary{{SpaceGUID: "space-2-guid", BoundAppCount: 3}},
+				ccv3.Warnings{"usage warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			sharedSpaces, warnings, executionError = actor.GetServiceInstanceSharedSpacesUsage(serviceInstanceName, targetedSpaceGUID)
+		})
+
+		It("returns the shared spaces with their bound app counts", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instance warning", "shared spaces warning", "usage warning"))
+
+			name, spaceGUID, _ := fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+			Expect(name).To(Equal(serviceInstanceName))
+			Expect(spaceGUID).To(Equal(targetedSpaceGUID))
+			Expect(fakeCloudControllerClient.GetServiceInstanceSharedSpacesArgsForCall(0)).To(Equal("instance-guid"))
+			Expect(fakeCloudControllerClient.GetServiceInstanceUsageSummaryArgsForCall(0)).To(Equal("instance-guid"))
+
+			Expect(sharedSpaces).To(Equal(ServiceInstanceSharedSpaces{
+				ServiceInstanceGUID: "instance-guid",
+				Spaces: []SharedSpaceUsage{
+					{SpaceGUID: "space-1-guid", SpaceName: "space-1", OrganizationName: "org-1", BoundAppCount: 0},
+					{SpaceGUID: "space-2-guid", SpaceName: "space-2", OrganizationName: "org-2", BoundAppCount: 3},
+				},
+			}))
+		})
+
+		When("the instance is not shared", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(nil, nil, nil)
+			})
+
+			It("does not get the usage summary", func() {
+				Expect(executionError).NotTo(HaveOccurred())
+				Expect(sharedSpaces.Spaces).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetServiceInstanceUsageSummaryCallCount()).To(BeZero())
+			})
+		})
+
+		When("the service instance cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"instance warning"},
+					ccerror.ServiceInstanceNotFoundError{Name: serviceInstanceName},
+				)
+			})
+
+			It("returns an actor error and warnings", func() {
+				Expect(executionError).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: serviceInstanceName}))
+				Expect(warnings).To(ConsistOf("instance warning"))
+			})
+		})
+	})
+
+	Describe("UnshareServiceInstanceFromSpaces", func() {
+		JustBeforeEach(func() {
+			warnings, executionError = actor.UnshareServiceInstanceFromSpaces("instance-guid", []string{"space-1-guid", "space-2-guid"})
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.UnshareServiceInstanceFromSpaceReturns(ccv3.Warnings{"unshare warning"}, nil)
+		})
+
+		It("unshares the instance from every space", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("unshare warning", "unshare warning"))
+
+			Expect(fakeCloudControllerClient.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(2))
+			instanceGUID, spaceGUID := fakeCloudControllerClient.UnshareServiceInstanceFromSpaceArgsForCall(1)
+			Expect(instanceGUID).To(Equal("instance-guid"))
+			Expect(spaceGUID).To(Equal("space-2-guid"))
+		})
+
+		When("unsharing fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UnshareServiceInstanceFromSpaceReturns(ccv3.Warnings{"unshare warning"}, errors.New("boom"))
+			})
+
+			It("stops at the first failure", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("unshare warning"))
+				Expect(fakeCloudControllerClient.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceSharesForOrganization", func() {
+		var shares []ServiceInstanceShare
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{GUID: "dev-guid", Name: "dev"}, {GUID: "prod-guid", Name: "prod"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"spaces warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{GUID: "owned-guid", Name: "owned", SpaceGUID: "dev-guid"},
+					{GUID: "unshared-guid", Name: "unshared", SpaceGUID: "dev-guid"},
+					{GUID: "foreign-guid", Name: "foreign", SpaceGUID: "other-space-guid"},
+				},
+				ccv3.IncludedResources{
+					Spaces: []resources.Space{{
+						GUID: "other-space-guid",
+						Name: "other-space",
+						Relationships: resources.Relationships{
+							constant.RelationshipTypeOrganization: resources.Relationship{GUID: "other-org-guid"},
+						},
+					}},
+					Organizations: []resources.Organization{{GUID: "other-org-guid", Name: "other-org"}},
+				},
+				ccv3.Warnings{"instances warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceSharedSpacesStub = func(guid string) ([]ccv3.SpaceWithOrganization, ccv3.Warnings, error) {
+				if guid == "owned-guid" {
+					return []ccv3.SpaceWithOrganization{{SpaceGUID: "elsewhere-guid", SpaceName: "elsewhere", OrganizationName: "other-org"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+				[]resources.ServiceCredentialBinding{
+					{ServiceInstanceGUID: "owned-guid", AppGUID: "app-1-guid", AppSpaceGUID: "elsewhere-guid"},
+					{ServiceInstanceGUID: "owned-guid", AppGUID: "app-2-guid", AppSpaceGUID: "elsewhere-guid"},
+					{ServiceInstanceGUID: "owned-guid", AppGUID: "app-3-guid", AppSpaceGUID: "dev-guid"},
+				},
+				ccv3.Warnings{"bindings warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceUsageSummaryReturns(
+				[]resources.ServiceInstanceUsageSummary{
+					{SpaceGUID: "prod-guid", BoundAppCount: 1},
+					{SpaceGUID: "invisible-guid", BoundAppCount: 5},
+				},
+				ccv3.Warnings{"usage warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			shares, warnings, executionError = actor.GetServiceInstanceSharesForOrganization("org-guid")
+		})
+
+		It("queries the instances in the spaces of the org", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElements(
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"managed"}},
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"dev-guid", "prod-guid"}},
+			))
+		})
+
+		It("returns the instances shared out of and into the org", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("spaces warning", "instances warning", "usage warning", "bindings warning"))
+			Expect(shares).To(Equal([]ServiceInstanceShare{
+				{
+					ServiceInstanceName:   "owned",
+					SpaceName:             "dev",
+					OtherOrganizationName: "other-org",
+					OtherSpaceName:        "elsewhere",
+					BoundAppCount:         2,
+				},
+				{
+					ServiceInstanceName:   "foreign",
+					SharedIn:              true,
+					SpaceName:             "prod",
+					OtherOrganizationName: "other-org",
+					OtherSpaceName:        "other-space",
+					BoundAppCount:         1,
+				},
+			}))
+		})
+
+		It("only gets the shared spaces of owned instances", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstanceSharedSpacesCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetServiceInstanceSharedSpacesArgsForCall(0)).To(Equal("owned-guid"))
+			Expect(fakeCloudControllerClient.GetServiceInstanceSharedSpacesArgsForCall(1)).To(Equal("unshared-guid"))
+		})
+
+		It("only gets the usage summary of instances shared into the org", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstanceUsageSummaryCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstanceUsageSummaryArgsForCall(0)).To(Equal("foreign-guid"))
+		})
+
+		It("counts the bound apps of all shared owned instances in one request", func() {
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"owned-guid"}},
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"app"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{"5000"}},
+			))
+		})
+
+		When("the org has no spaces", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+			})
+
+			It("returns no shares", func() {
+				Expect(executionError).NotTo(HaveOccurred())
+				Expect(shares).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(BeZero())
+			})
+		})
+
+		When("getting the usage summary fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceUsageSummaryReturns(nil, ccv3.Warnings{"usage warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("spaces warning", "instances warning", "usage warning"))
+			})
+		})
+
+		When("getting the bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(nil, ccv3.Warnings{"bindings warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ContainElements("spaces warning", "instances warning", "bindings warning"))
+			})
+		})
+	})
 })
@@ -645,6 +645,34 @@ var _ = Describe("Service Instance Actions", func() {
 						Tags: newTags,
 					}))
 				})
+
+				It("does not fetch a plan to validate parameters", func() {
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+				})
+			})
+
+			When("the parameters do not match the update schema of the new plan", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlansReturns(
+						[]resources.ServicePlan{{
+							GUID: newServicePlanGUID,
+							Name: newServicePlanName,
+							ServiceInstanceUpdateSchema: map[string]interface{}{
+								"required": []interface{}{"size"},
+							},
+						}},
+						ccv3.Warnings{"fake get service plan warning"},
+						nil,
+					)
+				})
+
+				It("returns the validation errors without fetching the current plan", func() {
+					Expect(executeErr).To(MatchError(actionerror.InvalidServiceParametersError{
+						Errors: []string{"$: missing required property 'size'"},
+					}))
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+					Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(BeZero())
+				})
 			})
 
 			When("just changing parameters", func() {
@@ -662,6 +690,39 @@ var _ = Describe("Service Instance Actions", func() {
 					}))
 				})
 
+				It("validates the parameters against the schema of the current plan", func() {
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDArgsForCall(0)).To(Equal(servicePlanGUID))
+				})
+
+				When("the parameters do not match the update schema", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+							resources.ServicePlan{
+								GUID: servicePlanGUID,
+								ServiceInstanceUpdateSchema: map[string]interface{}{
+									"properties": map[string]interface{}{
+										"foo": map[string]interface{}{"type": "integer"},
+									},
+								},
+							},
+							ccv3.Warnings{"fake get plan by guid warning"},
+							nil,
+						)
+					})
+
+					It("returns the validation errors and does not update the service instance", func() {
+						Expect(executeErr).To(MatchError(actionerror.InvalidServiceParametersError{
+							Errors: []string{"$.foo: expected integer, got string"},
+						}))
+						Expect(warnings).To(ConsistOf(
+							"fake get service instance warning",
+							"fake get plan by guid warning",
+						))
+						Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(BeZero())
+					})
+				})
+
 				When("just changing plan", func() {
 
 				})
@@ -943,6 +1004,33 @@ var _ = Describe("Service Instance Actions", func() {
 		})
 
 		Context("error scenarios", func() {
+			When("the parameters do not match the create schema of the plan", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlansReturns(
+						[]resources.ServicePlan{{
+							GUID: "fake-plan-guid",
+							ServiceInstanceCreateSchema: map[string]interface{}{
+								"additionalProperties": false,
+								"properties": map[string]interface{}{
+									"param1": map[string]interface{}{"type": "string"},
+								},
+							},
+						}},
+						ccv3.Warnings{"plan-warning"},
+						nil,
+					)
+				})
+
+				It("returns the validation errors and does not create the instance", func() {
+					Expect(err).To(MatchError(actionerror.InvalidServiceParametersError{
+						Errors: []string{"$.param-2: additional property is not allowed"},
+					}))
+					Expect(warnings).To(ConsistOf("plan-warning"))
+					Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(BeZero())
+					Expect(stream).To(BeNil())
+				})
+			})
+
 			When("no plan found", func() {
 				BeforeEach(func() {
 					fakeCloudControllerClient.GetServicePlansReturns([]resources.ServicePlan{}, ccv3.Warnings{"be warned"}, nil)
@@ -0,0 +1,119 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/extract"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+type ServiceInstanceForUpgrade struct {
+	Name                string
+	SpaceGUID           string
+	SpaceName           string
+	OrganizationName    string
+	ServicePlanName     string
+	UpgradeAvailable    bool
+	OperationInProgress bool
+}
+
+type ServiceInstancesForUpgradeParams struct {
+	ServiceOfferingName string
+	ServiceBrokerName   string
+	ServicePlanName     string
+	// OrganizationGUIDs restricts the search to the given orgs. When empty,
+	// instances in every org visible to the user are returned.
+	OrganizationGUIDs []string
+}
+
+// GetServiceInstancesForUpgrade returns the managed service instances of the
+// given service offering, along with whether each of them can be upgraded.
+func (actor Actor) GetServiceInstancesForUpgrade(params ServiceInstancesForUpgradeParams) ([]ServiceInstanceForUpgrade, Warnings, error) {
+	var (
+		plans     []resources.ServicePlan
+		instances []resources.ServiceInstance
+		included  ccv3.IncludedResources
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			plans, warnings, err = actor.getServicePlansForUpgrade(params)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			query := []ccv3.Query{
+				{Key: ccv3.ServicePlanGUIDsFilter, Values: extract.UniqueList("GUID", plans)},
+				{Key: ccv3.FieldsSpace, Values: []string{"guid", "name", "relationships.organization"}},
+				{Key: ccv3.FieldsSpaceOrganization, Values: []string{"guid", "name"}},
+				{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			}
+			if len(params.OrganizationGUIDs) > 0 {
+				query = append(query, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: params.OrganizationGUIDs})
+			}
+
+			instances, included, warnings, err = actor.CloudControllerClient.GetServiceInstances(query...)
+			return
+		},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	planNameLookup := lookuptable.NameFromGUID(plans)
+	orgNameLookup := lookuptable.NameFromGUID(included.Organizations)
+	spaceLookup := make(map[string]resources.Space)
+	for _, space := range included.Spaces {
+		spaceLookup[space.GUID] = space
+	}
+
+	result := make([]ServiceInstanceForUpgrade, len(instances))
+	for i, instance := range instances {
+		space := spaceLookup[instance.SpaceGUID]
+		result[i] = ServiceInstanceForUpgrade{
+			Name:                instance.Name,
+			SpaceGUID:           instance.SpaceGUID,
+			SpaceName:           space.Name,
+			OrganizationName:    orgNameLookup[space.Relationships[constant.RelationshipTypeOrganization].GUID],
+			ServicePlanName:     planNameLookup[instance.ServicePlanGUID],
+			UpgradeAvailable:    instance.UpgradeAvailable.Value,
+			OperationInProgress: instance.LastOperation.State == resources.OperationInProgress,
+		}
+	}
+
+	return result, Warnings(warnings), nil
+}
+
+func (actor Actor) getServicePlansForUpgrade(params ServiceInstancesForUpgradeParams) ([]resources.ServicePlan, ccv3.Warnings, error) {
+	query := []ccv3.Query{{Key: ccv3.ServiceOfferingNamesFilter, Values: []string{params.ServiceOfferingName}}}
+	if params.ServiceBrokerName != "" {
+		query = append(query, ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{params.ServiceBrokerName}})
+	}
+	if params.ServicePlanName != "" {
+		query = append(query, ccv3.Query{Key: ccv3.NameFilter, Values: []string{params.ServicePlanName}})
+	}
+
+	plans, warnings, err := actor.CloudControllerClient.GetServicePlans(query...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	if len(plans) == 0 {
+		if params.ServicePlanName != "" {
+			return nil, warnings, actionerror.ServicePlanNotFoundError{
+				PlanName:          params.ServicePlanName,
+				OfferingName:      params.ServiceOfferingName,
+				ServiceBrokerName: params.ServiceBrokerName,
+			}
+		}
+		return nil, warnings, actionerror.ServiceNotFoundError{
+			Name:   params.ServiceOfferingName,
+			Broker: params.ServiceBrokerName,
+		}
+	}
+
+	return plans, warnings, nil
+}
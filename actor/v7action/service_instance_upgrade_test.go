@@ -0,0 +1,191 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Upgrade Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetServiceInstancesForUpgrade", func() {
+		var (
+			params     ServiceInstancesForUpgradeParams
+			instances  []ServiceInstanceForUpgrade
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			params = ServiceInstancesForUpgradeParams{ServiceOfferingName: "fake-offering"}
+
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]resources.ServicePlan{
+					{GUID: "plan-guid-1", Name: "small"},
+					{GUID: "plan-guid-2", Name: "large"},
+				},
+				ccv3.Warnings{"plans warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{
+						Name:             "db-1",
+						SpaceGUID:        "space-guid-1",
+						ServicePlanGUID:  "plan-guid-1",
+						UpgradeAvailable: types.NewOptionalBoolean(true),
+					},
+					{
+						Name:             "db-2",
+						SpaceGUID:        "space-guid-2",
+						ServicePlanGUID:  "plan-guid-2",
+						UpgradeAvailable: types.NewOptionalBoolean(false),
+						LastOperation: resources.LastOperation{
+							Type:  resources.UpdateOperation,
+							State: resources.OperationInProgress,
+						},
+					},
+				},
+				ccv3.IncludedResources{
+					Spaces: []resources.Space{
+						{
+							GUID: "space-guid-1",
+							Name: "dev",
+							Relationships: resources.Relationships{
+								constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-guid-1"},
+							},
+						},
+						{
+							GUID: "space-guid-2",
+							Name: "prod",
+							Relationships: resources.Relationships{
+								constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-guid-2"},
+							},
+						},
+					},
+					Organizations: []resources.Organization{
+						{GUID: "org-guid-1", Name: "org-1"},
+						{GUID: "org-guid-2", Name: "org-2"},
+					},
+				},
+				ccv3.Warnings{"instances warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			instances, warnings, executeErr = actor.GetServiceInstancesForUpgrade(params)
+		})
+
+		It("gets the plans of the offering", func() {
+			Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceOfferingNamesFilter, Values: []string{"fake-offering"}},
+			))
+		})
+
+		It("gets the instances of those plans in all orgs", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServicePlanGUIDsFilter, Values: []string{"plan-guid-1", "plan-guid-2"}},
+				ccv3.Query{Key: ccv3.FieldsSpace, Values: []string{"guid", "name", "relationships.organization"}},
+				ccv3.Query{Key: ccv3.FieldsSpaceOrganization, Values: []string{"guid", "name"}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("returns the instances with their space, org and plan names", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("plans warning", "instances warning"))
+			Expect(instances).To(Equal([]ServiceInstanceForUpgrade{
+				{
+					Name:             "db-1",
+					SpaceGUID:        "space-guid-1",
+					SpaceName:        "dev",
+					OrganizationName: "org-1",
+					ServicePlanName:  "small",
+					UpgradeAvailable: true,
+				},
+				{
+					Name:                "db-2",
+					SpaceGUID:           "space-guid-2",
+					SpaceName:           "prod",
+					OrganizationName:    "org-2",
+					ServicePlanName:     "large",
+					OperationInProgress: true,
+				},
+			}))
+		})
+
+		When("a broker, plan and orgs are specified", func() {
+			BeforeEach(func() {
+				params.ServiceBrokerName = "fake-broker"
+				params.ServicePlanName = "small"
+				params.OrganizationGUIDs = []string{"org-guid-1"}
+			})
+
+			It("filters by them", func() {
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.ServiceOfferingNamesFilter, Values: []string{"fake-offering"}},
+					ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{"fake-broker"}},
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"small"}},
+				))
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid-1"}},
+				))
+			})
+		})
+
+		When("no plans match", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plans warning"}, nil)
+			})
+
+			It("returns a service offering not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "fake-offering"}))
+				Expect(warnings).To(ConsistOf("plans warning"))
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(BeZero())
+			})
+
+			When("a plan was specified", func() {
+				BeforeEach(func() {
+					params.ServicePlanName = "huge"
+				})
+
+				It("returns a plan not found error", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: "huge", OfferingName: "fake-offering"}))
+				})
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"instances warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("plans warning", "instances warning"))
+			})
+		})
+	})
+})
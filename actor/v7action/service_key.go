@@ -2,6 +2,9 @@ package v7action
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -11,6 +14,14 @@ import (
 	"code.cloudfoundry.org/cli/util/railway"
 )
 
+type ServiceKeyRotation struct {
+	// PreviousKeyNames are the existing versions of the key, latest last.
+	// The latest is the one in use, the others are left over from earlier
+	// rotations that did not finish.
+	PreviousKeyNames []string
+	NewKeyName       string
+}
+
 type CreateServiceKeyParams struct {
 	SpaceGUID           string
 	ServiceInstanceName string
@@ -30,6 +41,9 @@ func (actor Actor) CreateServiceKey(params CreateServiceKeyParams) (chan PollJob
 			serviceInstance, _, warnings, err = actor.getServiceInstanceByNameAndSpace(params.ServiceInstanceName, params.SpaceGUID)
 			return
 		},
+		func() (warnings ccv3.Warnings, err error) {
+			return actor.validateServiceBindingParameters(serviceInstance, params.Parameters)
+		},
 		func() (warnings ccv3.Warnings, err error) {
 			jobURL, warnings, err = actor.createServiceKey(serviceInstance.GUID, params.ServiceKeyName, params.Parameters)
 			return
@@ -120,6 +134,47 @@ func (actor Actor) DeleteServiceKeyByServiceInstanceAndName(serviceInstanceName,
 	return stream, Warnings(warnings), err
 }
 
+// GetServiceKeyRotation works out which versions of keyName exist and what
+// the next version of it should be called. Versions after the first are
+// named "KEY_NAME-vN".
+func (actor Actor) GetServiceKeyRotation(serviceInstanceName, keyName, spaceGUID string) (ServiceKeyRotation, Warnings, error) {
+	keys, warnings, err := actor.GetServiceKeysByServiceInstance(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return ServiceKeyRotation{}, warnings, err
+	}
+
+	versionPattern := regexp.MustCompile("^" + regexp.QuoteMeta(keyName) + `-v([0-9]+)$`)
+
+	versions := map[string]int{}
+	var names []string
+	for _, key := range keys {
+		version := 0
+		if key.Name == keyName {
+			version = 1
+		} else if matches := versionPattern.FindStringSubmatch(key.Name); matches != nil {
+			version, _ = strconv.Atoi(matches[1])
+		}
+
+		if version > 0 {
+			versions[key.Name] = version
+			names = append(names, key.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return ServiceKeyRotation{}, warnings, actionerror.NewServiceKeyNotFoundError(keyName, serviceInstanceName)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return versions[names[i]] < versions[names[j]]
+	})
+
+	return ServiceKeyRotation{
+		PreviousKeyNames: names,
+		NewKeyName:       fmt.Sprintf("%s-v%d", keyName, versions[names[len(names)-1]]+1),
+	}, warnings, nil
+}
+
 func (actor Actor) createServiceKey(serviceInstanceGUID, serviceKeyName string, parameters types.OptionalObject) (ccv3.JobURL, ccv3.Warnings, error) {
 	jobURL, warnings, err := actor.CloudControllerClient.CreateServiceCredentialBinding(resources.ServiceCredentialBinding{
 		Type:                resources.KeyBinding,
@@ -138,6 +138,66 @@ var _ = Describe("Service Key Action", func() {
 			})
 		})
 
+		Describe("parameter validation", func() {
+			It("does not fetch the plan of a user-provided service instance", func() {
+				Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+			})
+
+			When("the service instance is managed", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{
+							Type:            resources.ManagedServiceInstance,
+							Name:            serviceInstanceName,
+							GUID:            serviceInstanceGUID,
+							ServicePlanGUID: "fake-plan-guid",
+						},
+						ccv3.IncludedResources{},
+						ccv3.Warnings{"get instance warning"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+						resources.ServicePlan{
+							ServiceBindingCreateSchema: map[string]interface{}{
+								"properties": map[string]interface{}{
+									"foo": map[string]interface{}{"enum": []interface{}{"baz"}},
+								},
+							},
+						},
+						ccv3.Warnings{"get plan warning"},
+						nil,
+					)
+				})
+
+				It("validates the parameters against the binding schema of the plan", func() {
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDArgsForCall(0)).To(Equal("fake-plan-guid"))
+
+					Expect(executionError).To(MatchError(actionerror.InvalidServiceParametersError{
+						Errors: []string{"$.foo: must be one of 'baz'"},
+					}))
+					Expect(warnings).To(ContainElement("get plan warning"))
+					Expect(fakeCloudControllerClient.CreateServiceCredentialBindingCallCount()).To(BeZero())
+				})
+
+				When("getting the plan fails", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+							resources.ServicePlan{},
+							ccv3.Warnings{"get plan warning"},
+							errors.New("plan boom"),
+						)
+					})
+
+					It("returns the error and warnings", func() {
+						Expect(executionError).To(MatchError("plan boom"))
+						Expect(warnings).To(ContainElement("get plan warning"))
+					})
+				})
+			})
+		})
+
 		Describe("initiating the create", func() {
 			It("makes the correct call", func() {
 				Expect(fakeCloudControllerClient.CreateServiceCredentialBindingCallCount()).To(Equal(1))
@@ -310,6 +370,101 @@ var _ = Describe("Service Key Action", func() {
 		})
 	})
 
+	Describe("GetServiceKeyRotation", func() {
+		const (
+			serviceInstanceName = "fake-service-instance-name"
+			spaceGUID           = "fake-space-guid"
+		)
+
+		var (
+			rotation       ServiceKeyRotation
+			warnings       Warnings
+			executionError error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{Name: serviceInstanceName, GUID: "fake-service-instance-guid"},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get instance warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			rotation, warnings, executionError = actor.GetServiceKeyRotation(serviceInstanceName, "mykey", spaceGUID)
+		})
+
+		When("only the original key exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					[]resources.ServiceCredentialBinding{{Name: "otherkey"}, {Name: "mykey"}},
+					ccv3.Warnings{"get keys warning"},
+					nil,
+				)
+			})
+
+			It("rotates to the second version", func() {
+				Expect(executionError).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get instance warning", "get keys warning"))
+				Expect(rotation).To(Equal(ServiceKeyRotation{PreviousKeyNames: []string{"mykey"}, NewKeyName: "mykey-v2"}))
+			})
+		})
+
+		When("versioned keys exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					[]resources.ServiceCredentialBinding{
+						{Name: "mykey-v3"},
+						{Name: "mykey-v10"},
+						{Name: "mykey-vX"},
+						{Name: "mykeyring-v20"},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("rotates from the latest version and returns every existing version, latest last", func() {
+				Expect(executionError).NotTo(HaveOccurred())
+				Expect(rotation).To(Equal(ServiceKeyRotation{PreviousKeyNames: []string{"mykey-v3", "mykey-v10"}, NewKeyName: "mykey-v11"}))
+			})
+		})
+
+		When("no version of the key exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					[]resources.ServiceCredentialBinding{{Name: "otherkey"}},
+					ccv3.Warnings{"get keys warning"},
+					nil,
+				)
+			})
+
+			It("returns a not found error and warnings", func() {
+				Expect(executionError).To(MatchError(actionerror.ServiceKeyNotFoundError{
+					KeyName:             "mykey",
+					ServiceInstanceName: serviceInstanceName,
+				}))
+				Expect(warnings).To(ConsistOf("get instance warning", "get keys warning"))
+			})
+		})
+
+		When("getting the keys fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					nil,
+					ccv3.Warnings{"get keys warning"},
+					errors.New("boom"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("get keys warning"))
+			})
+		})
+	})
+
 	Describe("GetServiceKeyByServiceInstanceAndName", func() {
 		const (
 			serviceInstanceName = "fake-service-instance-name"
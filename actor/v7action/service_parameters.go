@@ -0,0 +1,57 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/jsonschema"
+)
+
+func (actor Actor) validateServiceInstanceUpdateParameters(serviceInstance resources.ServiceInstance, newPlan resources.ServicePlan, parameters types.OptionalObject) (ccv3.Warnings, error) {
+	if !parameters.IsSet {
+		return nil, nil
+	}
+
+	var warnings ccv3.Warnings
+	servicePlan := newPlan
+	if servicePlan.GUID == "" {
+		var err error
+		servicePlan, warnings, err = actor.CloudControllerClient.GetServicePlanByGUID(serviceInstance.ServicePlanGUID)
+		if err != nil {
+			return warnings, err
+		}
+	}
+
+	return warnings, validateServiceParameters(servicePlan.ServiceInstanceUpdateSchema, parameters)
+}
+
+func (actor Actor) validateServiceBindingParameters(serviceInstance resources.ServiceInstance, parameters types.OptionalObject) (ccv3.Warnings, error) {
+	if !parameters.IsSet || serviceInstance.Type != resources.ManagedServiceInstance {
+		return nil, nil
+	}
+
+	servicePlan, warnings, err := actor.CloudControllerClient.GetServicePlanByGUID(serviceInstance.ServicePlanGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	return warnings, validateServiceParameters(servicePlan.ServiceBindingCreateSchema, parameters)
+}
+
+func validateServiceParameters(schema map[string]interface{}, parameters types.OptionalObject) error {
+	if !parameters.IsSet || len(schema) == 0 {
+		return nil
+	}
+
+	validationErrors := jsonschema.Validate(schema, parameters.Value)
+	if len(validationErrors) == 0 {
+		return nil
+	}
+
+	var messages []string
+	for _, validationError := range validationErrors {
+		messages = append(messages, validationError.Error())
+	}
+	return actionerror.InvalidServiceParametersError{Errors: messages}
+}
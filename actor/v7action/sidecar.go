@@ -0,0 +1,62 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// GetApplicationSidecars returns the sidecars of the provided application.
+func (actor Actor) GetApplicationSidecars(appGUID string) ([]resources.Sidecar, Warnings, error) {
+	sidecars, warnings, err := actor.CloudControllerClient.GetApplicationSidecars(appGUID)
+	return sidecars, Warnings(warnings), err
+}
+
+// CreateApplicationSidecar creates a sidecar for the provided application.
+func (actor Actor) CreateApplicationSidecar(appGUID string, sidecar resources.Sidecar) (resources.Sidecar, Warnings, error) {
+	createdSidecar, warnings, err := actor.CloudControllerClient.CreateApplicationSidecar(appGUID, sidecar)
+	return createdSidecar, Warnings(warnings), err
+}
+
+// UpdateApplicationSidecar updates the application's sidecar with the given
+// name. Only the fields that are set on sidecar are changed.
+func (actor Actor) UpdateApplicationSidecar(appGUID string, sidecarName string, sidecar resources.Sidecar) (resources.Sidecar, Warnings, error) {
+	existingSidecar, allWarnings, err := actor.getApplicationSidecarByName(appGUID, sidecarName)
+	if err != nil {
+		return resources.Sidecar{}, allWarnings, err
+	}
+
+	sidecar.GUID = existingSidecar.GUID
+	updatedSidecar, warnings, err := actor.CloudControllerClient.UpdateSidecar(sidecar)
+	allWarnings = append(allWarnings, warnings...)
+
+	return updatedSidecar, allWarnings, err
+}
+
+// DeleteApplicationSidecar deletes the application's sidecar with the given
+// name.
+func (actor Actor) DeleteApplicationSidecar(appGUID string, sidecarName string) (Warnings, error) {
+	sidecar, allWarnings, err := actor.getApplicationSidecarByName(appGUID, sidecarName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.DeleteSidecar(sidecar.GUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+func (actor Actor) getApplicationSidecarByName(appGUID string, sidecarName string) (resources.Sidecar, Warnings, error) {
+	sidecars, warnings, err := actor.CloudControllerClient.GetApplicationSidecars(appGUID)
+	if err != nil {
+		return resources.Sidecar{}, Warnings(warnings), err
+	}
+
+	for _, sidecar := range sidecars {
+		if sidecar.Name == sidecarName {
+			return sidecar, Warnings(warnings), nil
+		}
+	}
+
+	return resources.Sidecar{}, Warnings(warnings), actionerror.SidecarNotFoundError{Name: sidecarName}
+}
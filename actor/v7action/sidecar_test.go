@@ -0,0 +1,139 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+
+		fakeCloudControllerClient.GetApplicationSidecarsReturns(
+			[]resources.Sidecar{
+				{GUID: "sidecar-1-guid", Name: "sidecar-1"},
+				{GUID: "sidecar-2-guid", Name: "sidecar-2"},
+			},
+			ccv3.Warnings{"get-sidecars-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetApplicationSidecars", func() {
+		It("returns the app's sidecars and warnings", func() {
+			sidecars, warnings, err := actor.GetApplicationSidecars("some-app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-sidecars-warning"))
+			Expect(sidecars).To(HaveLen(2))
+			Expect(fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+	})
+
+	Describe("CreateApplicationSidecar", func() {
+		It("creates the sidecar", func() {
+			sidecar := resources.Sidecar{
+				Name:         "sidecar-3",
+				Command:      types.FilteredString{IsSet: true, Value: "start"},
+				ProcessTypes: []string{"web"},
+			}
+			fakeCloudControllerClient.CreateApplicationSidecarReturns(resources.Sidecar{GUID: "sidecar-3-guid"}, ccv3.Warnings{"create-warning"}, nil)
+
+			createdSidecar, warnings, err := actor.CreateApplicationSidecar("some-app-guid", sidecar)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-warning"))
+			Expect(createdSidecar.GUID).To(Equal("sidecar-3-guid"))
+
+			appGUID, passedSidecar := fakeCloudControllerClient.CreateApplicationSidecarArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(passedSidecar).To(Equal(sidecar))
+		})
+	})
+
+	Describe("UpdateApplicationSidecar", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateSidecarReturns(resources.Sidecar{GUID: "sidecar-2-guid"}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		When("the sidecar exists", func() {
+			JustBeforeEach(func() {
+				_, warnings, err = actor.UpdateApplicationSidecar("some-app-guid", "sidecar-2", resources.Sidecar{
+					MemoryInMB: types.NullUint64{IsSet: true, Value: 64},
+				})
+			})
+
+			It("updates it by GUID", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-sidecars-warning", "update-warning"))
+				Expect(fakeCloudControllerClient.UpdateSidecarArgsForCall(0)).To(Equal(resources.Sidecar{
+					GUID:       "sidecar-2-guid",
+					MemoryInMB: types.NullUint64{IsSet: true, Value: 64},
+				}))
+			})
+		})
+
+		When("the sidecar does not exist", func() {
+			JustBeforeEach(func() {
+				_, warnings, err = actor.UpdateApplicationSidecar("some-app-guid", "sidecar-3", resources.Sidecar{})
+			})
+
+			It("returns a SidecarNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.SidecarNotFoundError{Name: "sidecar-3"}))
+				Expect(warnings).To(ConsistOf("get-sidecars-warning"))
+				Expect(fakeCloudControllerClient.UpdateSidecarCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteApplicationSidecar", func() {
+		When("the sidecar exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteSidecarReturns(ccv3.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes it", func() {
+				warnings, err := actor.DeleteApplicationSidecar("some-app-guid", "sidecar-1")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-sidecars-warning", "delete-warning"))
+				Expect(fakeCloudControllerClient.DeleteSidecarArgsForCall(0)).To(Equal("sidecar-1-guid"))
+			})
+		})
+
+		When("listing the sidecars fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(nil, ccv3.Warnings{"get-sidecars-warning"}, errors.New("list-error"))
+			})
+
+			It("returns the error", func() {
+				warnings, err := actor.DeleteApplicationSidecar("some-app-guid", "sidecar-1")
+				Expect(err).To(MatchError("list-error"))
+				Expect(warnings).To(ConsistOf("get-sidecars-warning"))
+				Expect(fakeCloudControllerClient.DeleteSidecarCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the sidecar does not exist", func() {
+			It("returns a SidecarNotFoundError", func() {
+				_, err := actor.DeleteApplicationSidecar("some-app-guid", "sidecar-3")
+				Expect(err).To(MatchError(actionerror.SidecarNotFoundError{Name: "sidecar-3"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,263 @@
+package v7action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/extract"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+	"code.cloudfoundry.org/cli/util/railway"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+type StaleRouteReason string
+
+const (
+	StaleRouteAppStopped        StaleRouteReason = "app stopped"
+	StaleRouteNoInstances       StaleRouteReason = "no instances"
+	StaleRouteMissingProcess    StaleRouteReason = "missing process type"
+	StaleRouteOnlyRouteService  StaleRouteReason = "route service without apps"
+	staleRouteDestinationIsLive StaleRouteReason = ""
+)
+
+// StaleRoute is a route that cannot reach a running app: every destination
+// app is stopped, has no instances or lacks the destination process type, or
+// the route has no destinations but is bound to a route service. A route with
+// a destination app the user cannot see is never stale, because there is no
+// telling whether that app is running.
+type StaleRoute struct {
+	Route               resources.Route
+	SpaceName           string
+	AppNames            []string
+	ServiceInstanceName string
+	RouteBindingGUID    string
+	Reasons             []StaleRouteReason
+}
+
+// GetStaleRoutes returns the stale routes among routes. When createdBefore is
+// not zero, only routes created before it are returned.
+func (actor Actor) GetStaleRoutes(routes []resources.Route, createdBefore time.Time) ([]StaleRoute, Warnings, error) {
+	var candidates []resources.Route
+	for _, route := range routes {
+		if createdBefore.IsZero() {
+			candidates = append(candidates, route)
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, route.CreatedAt)
+		if err == nil && createdAt.Before(createdBefore) {
+			candidates = append(candidates, route)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		spaces           []resources.Space
+		apps             []resources.Application
+		processes        []resources.Process
+		routeBindings    []resources.RouteBinding
+		serviceInstances []resources.ServiceInstance
+	)
+
+	appGUIDs := extract.UniqueList("Destinations.App.GUID", candidates)
+
+	warnings, err := railway.Sequentially(
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(
+				extract.UniqueList("SpaceGUID", candidates),
+				func(guids []string) (ccv3.Warnings, error) {
+					batch, _, warnings, err := actor.CloudControllerClient.GetSpaces(ccv3.Query{
+						Key:    ccv3.GUIDFilter,
+						Values: guids,
+					})
+					spaces = append(spaces, batch...)
+					return warnings, err
+				},
+			)
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(appGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				batch, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{
+					Key:    ccv3.GUIDFilter,
+					Values: guids,
+				})
+				apps = append(apps, batch...)
+				return warnings, err
+			})
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(appGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				batch, warnings, err := actor.CloudControllerClient.GetProcesses(ccv3.Query{
+					Key:    ccv3.AppGUIDFilter,
+					Values: guids,
+				})
+				processes = append(processes, batch...)
+				return warnings, err
+			})
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(
+				extract.UniqueList("GUID", candidates),
+				func(guids []string) (ccv3.Warnings, error) {
+					batch, included, warnings, err := actor.CloudControllerClient.GetRouteBindings(
+						ccv3.Query{Key: ccv3.Include, Values: []string{"service_instance"}},
+						ccv3.Query{Key: ccv3.RouteGUIDFilter, Values: guids},
+					)
+					routeBindings = append(routeBindings, batch...)
+					serviceInstances = append(serviceInstances, included.ServiceInstances...)
+					return warnings, err
+				},
+			)
+		},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	spaceNamesByGUID := lookuptable.NameFromGUID(spaces)
+	serviceInstanceNamesByGUID := lookuptable.NameFromGUID(serviceInstances)
+
+	appsByGUID := map[string]resources.Application{}
+	for _, app := range apps {
+		appsByGUID[app.GUID] = app
+	}
+
+	processesByAppGUIDAndType := map[string]resources.Process{}
+	for _, process := range processes {
+		processesByAppGUIDAndType[process.AppGUID+"/"+process.Type] = process
+	}
+
+	routeBindingsByRouteGUID := map[string]resources.RouteBinding{}
+	for _, routeBinding := range routeBindings {
+		routeBindingsByRouteGUID[routeBinding.RouteGUID] = routeBinding
+	}
+
+	var staleRoutes []StaleRoute
+	for _, route := range candidates {
+		routeBinding, hasRouteService := routeBindingsByRouteGUID[route.GUID]
+
+		staleRoute := StaleRoute{
+			Route:     route,
+			SpaceName: spaceNamesByGUID[route.SpaceGUID],
+		}
+		if hasRouteService {
+			staleRoute.RouteBindingGUID = routeBinding.GUID
+			staleRoute.ServiceInstanceName = serviceInstanceNamesByGUID[routeBinding.ServiceInstanceGUID]
+		}
+
+		if len(route.Destinations) == 0 {
+			if !hasRouteService {
+				continue
+			}
+			staleRoute.Reasons = []StaleRouteReason{StaleRouteOnlyRouteService}
+			staleRoutes = append(staleRoutes, staleRoute)
+			continue
+		}
+
+		isLive := false
+		for _, destination := range route.Destinations {
+			app, found := appsByGUID[destination.App.GUID]
+			if !found {
+				isLive = true
+				break
+			}
+			if !containsString(staleRoute.AppNames, app.Name) {
+				staleRoute.AppNames = append(staleRoute.AppNames, app.Name)
+			}
+
+			reason := staleDestinationReason(app, destination, processesByAppGUIDAndType)
+			if reason == staleRouteDestinationIsLive {
+				isLive = true
+				break
+			}
+			if !containsStaleRouteReason(staleRoute.Reasons, reason) {
+				staleRoute.Reasons = append(staleRoute.Reasons, reason)
+			}
+		}
+
+		if !isLive {
+			staleRoutes = append(staleRoutes, staleRoute)
+		}
+	}
+
+	sortStaleRoutes(staleRoutes)
+
+	return staleRoutes, Warnings(warnings), nil
+}
+
+// DeleteStaleRoute unbinds the route service of the route, if any, and then
+// deletes the route.
+func (actor Actor) DeleteStaleRoute(staleRoute StaleRoute) (Warnings, error) {
+	var allWarnings Warnings
+
+	if staleRoute.RouteBindingGUID != "" {
+		jobURL, warnings, err := actor.CloudControllerClient.DeleteRouteBinding(staleRoute.RouteBindingGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteRoute(staleRoute.Route.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+func staleDestinationReason(app resources.Application, destination resources.RouteDestination, processes map[string]resources.Process) StaleRouteReason {
+	if app.State == constant.ApplicationStopped {
+		return StaleRouteAppStopped
+	}
+
+	processType := destination.App.Process.Type
+	if processType == "" {
+		processType = constant.ProcessTypeWeb
+	}
+
+	process, ok := processes[app.GUID+"/"+processType]
+	if !ok {
+		return StaleRouteMissingProcess
+	}
+	if process.Instances.IsSet && process.Instances.Value == 0 {
+		return StaleRouteNoInstances
+	}
+
+	return staleRouteDestinationIsLive
+}
+
+func containsStaleRouteReason(reasons []StaleRouteReason, reason StaleRouteReason) bool {
+	for _, r := range reasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func sortStaleRoutes(staleRoutes []StaleRoute) {
+	sort.SliceStable(staleRoutes, func(i, j int) bool {
+		if staleRoutes[i].SpaceName != staleRoutes[j].SpaceName {
+			return sorting.LessIgnoreCase(staleRoutes[i].SpaceName, staleRoutes[j].SpaceName)
+		}
+		return staleRoutes[i].Route.URL < staleRoutes[j].Route.URL
+	})
+}
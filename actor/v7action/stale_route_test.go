@@ -0,0 +1,206 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stale Route Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetStaleRoutes", func() {
+		var (
+			routes        []resources.Route
+			createdBefore time.Time
+			staleRoutes   []StaleRoute
+		)
+
+		destination := func(appGUID string, processType string) resources.RouteDestination {
+			destination := resources.RouteDestination{App: resources.RouteDestinationApp{GUID: appGUID}}
+			destination.App.Process.Type = processType
+			return destination
+		}
+
+		BeforeEach(func() {
+			createdBefore = time.Time{}
+			routes = []resources.Route{
+				{GUID: "stopped-route", URL: "stopped.example.com", SpaceGUID: "space-guid", CreatedAt: "2020-01-01T00:00:00Z", Destinations: []resources.RouteDestination{destination("stopped-app-guid", "")}},
+				{GUID: "scaled-down-route", URL: "scaled-down.example.com", SpaceGUID: "space-guid", CreatedAt: "2020-01-01T00:00:00Z", Destinations: []resources.RouteDestination{destination("running-app-guid", "worker")}},
+				{GUID: "missing-process-route", URL: "missing.example.com", SpaceGUID: "space-guid", CreatedAt: "2030-01-01T00:00:00Z", Destinations: []resources.RouteDestination{destination("running-app-guid", "admin")}},
+				{GUID: "live-route", URL: "live.example.com", SpaceGUID: "space-guid", Destinations: []resources.RouteDestination{destination("stopped-app-guid", ""), destination("running-app-guid", "web")}},
+				{GUID: "route-service-route", URL: "service.example.com", SpaceGUID: "space-guid", CreatedAt: "2020-01-01T00:00:00Z"},
+				{GUID: "orphaned-route", URL: "orphaned.example.com", SpaceGUID: "space-guid"},
+			}
+
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{{GUID: "space-guid", Name: "some-space"}}, ccv3.IncludedResources{}, ccv3.Warnings{"get-spaces-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{
+				{GUID: "stopped-app-guid", Name: "stopped-app", State: constant.ApplicationStopped},
+				{GUID: "running-app-guid", Name: "running-app", State: constant.ApplicationStarted},
+			}, ccv3.Warnings{"get-apps-warning"}, nil)
+			fakeCloudControllerClient.GetProcessesReturns([]resources.Process{
+				{AppGUID: "stopped-app-guid", Type: "web", Instances: types.NullInt{IsSet: true, Value: 1}},
+				{AppGUID: "running-app-guid", Type: "web", Instances: types.NullInt{IsSet: true, Value: 2}},
+				{AppGUID: "running-app-guid", Type: "worker", Instances: types.NullInt{IsSet: true, Value: 0}},
+			}, ccv3.Warnings{"get-processes-warning"}, nil)
+			fakeCloudControllerClient.GetRouteBindingsReturns(
+				[]resources.RouteBinding{{GUID: "binding-guid", RouteGUID: "route-service-route", ServiceInstanceGUID: "instance-guid"}},
+				ccv3.IncludedResources{ServiceInstances: []resources.ServiceInstance{{GUID: "instance-guid", Name: "some-route-service"}}},
+				ccv3.Warnings{"get-bindings-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			staleRoutes, warnings, executeErr = actor.GetStaleRoutes(routes, createdBefore)
+		})
+
+		It("returns the routes that cannot reach a running app", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-spaces-warning", "get-apps-warning", "get-processes-warning", "get-bindings-warning"))
+
+			Expect(staleRoutes).To(HaveLen(4))
+
+			Expect(staleRoutes[0].Route.GUID).To(Equal("missing-process-route"))
+			Expect(staleRoutes[0].AppNames).To(Equal([]string{"running-app"}))
+			Expect(staleRoutes[0].Reasons).To(Equal([]StaleRouteReason{StaleRouteMissingProcess}))
+
+			Expect(staleRoutes[1].Route.GUID).To(Equal("scaled-down-route"))
+			Expect(staleRoutes[1].Reasons).To(Equal([]StaleRouteReason{StaleRouteNoInstances}))
+
+			Expect(staleRoutes[2].Route.GUID).To(Equal("route-service-route"))
+			Expect(staleRoutes[2].ServiceInstanceName).To(Equal("some-route-service"))
+			Expect(staleRoutes[2].RouteBindingGUID).To(Equal("binding-guid"))
+			Expect(staleRoutes[2].Reasons).To(Equal([]StaleRouteReason{StaleRouteOnlyRouteService}))
+
+			Expect(staleRoutes[3].Route.GUID).To(Equal("stopped-route"))
+			Expect(staleRoutes[3].SpaceName).To(Equal("some-space"))
+			Expect(staleRoutes[3].AppNames).To(Equal([]string{"stopped-app"}))
+			Expect(staleRoutes[3].Reasons).To(Equal([]StaleRouteReason{StaleRouteAppStopped}))
+
+			Expect(fakeCloudControllerClient.GetProcessesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"stopped-app-guid", "running-app-guid"}},
+			))
+		})
+
+		When("only older routes are requested", func() {
+			BeforeEach(func() {
+				createdBefore = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			})
+
+			It("skips routes created later or without a creation time", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				var guids []string
+				for _, staleRoute := range staleRoutes {
+					guids = append(guids, staleRoute.Route.GUID)
+				}
+				Expect(guids).To(Equal([]string{"scaled-down-route", "route-service-route", "stopped-route"}))
+			})
+		})
+
+		When("a destination app cannot be seen by the user", func() {
+			BeforeEach(func() {
+				routes = []resources.Route{
+					{GUID: "hidden-app-route", URL: "hidden.example.com", SpaceGUID: "space-guid", Destinations: []resources.RouteDestination{destination("stopped-app-guid", ""), destination("hidden-app-guid", "")}},
+				}
+			})
+
+			It("treats the route as live", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(staleRoutes).To(BeEmpty())
+			})
+		})
+
+		When("there are no routes", func() {
+			BeforeEach(func() {
+				routes = nil
+			})
+
+			It("makes no requests", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(staleRoutes).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the processes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, errors.New("processes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("processes-error"))
+				Expect(warnings).To(ConsistOf("get-spaces-warning", "get-apps-warning", "get-processes-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteStaleRoute", func() {
+		var staleRoute StaleRoute
+
+		BeforeEach(func() {
+			staleRoute = StaleRoute{Route: resources.Route{GUID: "route-guid"}}
+			fakeCloudControllerClient.DeleteRouteBindingReturns("binding-job", ccv3.Warnings{"delete-binding-warning"}, nil)
+			fakeCloudControllerClient.DeleteRouteReturns("route-job", ccv3.Warnings{"delete-route-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteStaleRoute(staleRoute)
+		})
+
+		It("deletes the route", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"delete-route-warning", "poll-warning"}))
+			Expect(fakeCloudControllerClient.DeleteRouteBindingCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("route-guid"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("route-job")))
+		})
+
+		When("the route is bound to a route service", func() {
+			BeforeEach(func() {
+				staleRoute.RouteBindingGUID = "binding-guid"
+			})
+
+			It("unbinds the route service first", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(Equal(Warnings{"delete-binding-warning", "poll-warning", "delete-route-warning", "poll-warning"}))
+				Expect(fakeCloudControllerClient.DeleteRouteBindingArgsForCall(0)).To(Equal("binding-guid"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("binding-job")))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(1)).To(Equal(ccv3.JobURL("route-job")))
+			})
+		})
+
+		When("unbinding the route service fails", func() {
+			BeforeEach(func() {
+				staleRoute.RouteBindingGUID = "binding-guid"
+				fakeCloudControllerClient.DeleteRouteBindingReturns("", ccv3.Warnings{"delete-binding-warning"}, errors.New("unbind-error"))
+			})
+
+			It("does not delete the route", func() {
+				Expect(executeErr).To(MatchError("unbind-error"))
+				Expect(warnings).To(ConsistOf("delete-binding-warning"))
+				Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package v7action
 
 import (
+	"path"
 	"strconv"
 	"time"
 
@@ -68,6 +69,39 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 	return resources.Task(tasks[0]), Warnings(warnings), nil
 }
 
+// GetRunningApplicationTasks returns the running tasks of the provided
+// application. If namePattern is not empty, only tasks whose name matches the
+// shell pattern are returned.
+func (actor Actor) GetRunningApplicationTasks(appGUID string, namePattern string) ([]resources.Task, Warnings, error) {
+	if namePattern != "" {
+		if _, err := path.Match(namePattern, ""); err != nil {
+			return nil, nil, actionerror.InvalidTaskNamePatternError{Pattern: namePattern}
+		}
+	}
+
+	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(
+		appGUID,
+		ccv3.Query{Key: ccv3.StatesFilter, Values: []string{string(constant.TaskRunning)}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var runningTasks []resources.Task
+	for _, task := range tasks {
+		if namePattern != "" {
+			if matched, _ := path.Match(namePattern, task.Name); !matched {
+				continue
+			}
+		}
+		runningTasks = append(runningTasks, task)
+	}
+
+	sort.Slice(runningTasks, func(i int, j int) bool { return runningTasks[i].SequenceID < runningTasks[j].SequenceID })
+
+	return runningTasks, Warnings(warnings), nil
+}
+
 func (actor Actor) TerminateTask(taskGUID string) (resources.Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTaskCancel(taskGUID)
 	return resources.Task(task), Warnings(warnings), err
@@ -96,7 +130,11 @@ func (actor Actor) PollTask(task resources.Task) (resources.Task, Warnings, erro
 	}
 
 	if task.State == constant.TaskFailed {
-		return task, allWarnings, actionerror.TaskFailedError{}
+		var reason string
+		if task.Result != nil {
+			reason = task.Result.FailureReason
+		}
+		return task, allWarnings, actionerror.TaskFailedError{Reason: reason}
 	}
 
 	return task, allWarnings, nil
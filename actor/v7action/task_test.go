@@ -266,6 +266,81 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("GetRunningApplicationTasks", func() {
+		var (
+			namePattern string
+			tasks       []resources.Task
+			warnings    Warnings
+			err         error
+		)
+
+		BeforeEach(func() {
+			namePattern = ""
+			fakeCloudControllerClient.GetApplicationTasksReturns(
+				[]resources.Task{
+					{GUID: "task-3-guid", SequenceID: 3, Name: "migrate-users", State: constant.TaskRunning},
+					{GUID: "task-1-guid", SequenceID: 1, Name: "report", State: constant.TaskRunning},
+					{GUID: "task-2-guid", SequenceID: 2, Name: "migrate-orders", State: constant.TaskRunning},
+				},
+				ccv3.Warnings{"get-tasks-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			tasks, warnings, err = actor.GetRunningApplicationTasks("some-app-guid", namePattern)
+		})
+
+		It("queries only running tasks and returns them in sequence order", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-tasks-warning"))
+
+			appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(query).To(ConsistOf(ccv3.Query{Key: ccv3.StatesFilter, Values: []string{"RUNNING"}}))
+
+			Expect(tasks).To(HaveLen(3))
+			Expect(tasks[0].GUID).To(Equal("task-1-guid"))
+			Expect(tasks[1].GUID).To(Equal("task-2-guid"))
+			Expect(tasks[2].GUID).To(Equal("task-3-guid"))
+		})
+
+		When("a name pattern is given", func() {
+			BeforeEach(func() {
+				namePattern = "migrate-*"
+			})
+
+			It("returns only the tasks whose name matches", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tasks).To(HaveLen(2))
+				Expect(tasks[0].Name).To(Equal("migrate-orders"))
+				Expect(tasks[1].Name).To(Equal("migrate-users"))
+			})
+		})
+
+		When("the name pattern is invalid", func() {
+			BeforeEach(func() {
+				namePattern = "migrate-["
+			})
+
+			It("returns an error without calling the cloud controller", func() {
+				Expect(err).To(MatchError(actionerror.InvalidTaskNamePatternError{Pattern: "migrate-["}))
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"get-tasks-warning"}, errors.New("cc-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("cc-error"))
+				Expect(warnings).To(ConsistOf("get-tasks-warning"))
+			})
+		})
+	})
+
 	Describe("TerminateTask", func() {
 		When("the task exists", func() {
 			var returnedTask resources.Task
@@ -365,5 +440,19 @@ var _ = Describe("Task Actions", func() {
 
 			Expect(err).To(MatchError("Task failed to complete successfully"))
 		})
+
+		It("includes the failure reason if the task failed with one", func() {
+			firstTaskResponse := resources.Task{
+				State:  constant.TaskFailed,
+				Result: &resources.TaskResult{FailureReason: "Exited with status 1"},
+			}
+
+			fakeCloudControllerClient.GetTaskReturnsOnCall(0, firstTaskResponse, nil, nil)
+
+			_, _, err := actor.PollTask(resources.Task{})
+
+			Expect(err).To(MatchError(actionerror.TaskFailedError{Reason: "Exited with status 1"}))
+			Expect(err).To(MatchError("Task failed to complete successfully: Exited with status 1"))
+		})
 	})
 })
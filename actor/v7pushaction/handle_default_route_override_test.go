@@ -57,7 +57,7 @@ var _ = Describe("HandleDefaultRouteOverride", func() {
 
 	})
 
-	// CLI doesnt know about the routes field but CAPI ignores default route if routes is specified
+	// CAPI ignores default route if routes is specified
 	// so we are ok adding default route even with the presence of a routes field
 
 	When("the manifest has no routing fields", func() {
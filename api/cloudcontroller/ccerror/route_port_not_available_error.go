@@ -0,0 +1,7 @@
+package ccerror
+
+// RoutePortNotAvailableError is returned when a TCP route port is already
+// taken by a route on another domain of the same router group.
+type RoutePortNotAvailableError struct {
+	UnprocessableEntityError
+}
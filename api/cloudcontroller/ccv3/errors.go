@@ -154,6 +154,7 @@ func handleUnprocessableEntity(errorResponse ccerror.V3Error) error {
 	roleExistsRegexp := regexp.MustCompile(`User '.*' already has '.*' role.*`)
 	quotaExistsRegexp := regexp.MustCompile(`.* Quota '.*' already exists\.`)
 	securityGroupExistsRegexp := regexp.MustCompile(`Security group with name '.*' already exists\.`)
+	routePortNotAvailableRegexp := regexp.MustCompile(`Port [0-9]+ is not available`)
 
 	// boolean switch case with partial/regex string matchers
 	switch {
@@ -165,6 +166,8 @@ func handleUnprocessableEntity(errorResponse ccerror.V3Error) error {
 	case strings.Contains(errorString,
 		"Route already exists"):
 		return ccerror.RouteNotUniqueError{UnprocessableEntityError: err}
+	case routePortNotAvailableRegexp.MatchString(errorString):
+		return ccerror.RoutePortNotAvailableError{UnprocessableEntityError: err}
 	case strings.Contains(errorString,
 		"Buildpack must be an existing admin buildpack or a valid git URI"):
 		return ccerror.InvalidBuildpackError{}
@@ -607,6 +607,29 @@ var _ = Describe("Error Wrapper", func() {
 							}))
 						})
 					})
+
+					When("the route port is taken on another domain of the router group", func() {
+						BeforeEach(func() {
+							serverResponse = `
+{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "Port 1024 is not available on this domain's router group. Try a different port or use a different domain.",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+						})
+
+						It("returns a RoutePortNotAvailableError", func() {
+							Expect(makeError).To(MatchError(ccerror.RoutePortNotAvailableError{
+								UnprocessableEntityError: ccerror.UnprocessableEntityError{
+									Message: "Port 1024 is not available on this domain's router group. Try a different port or use a different domain.",
+								},
+							}))
+						})
+					})
 				})
 			})
 
@@ -8,11 +8,17 @@ import (
 )
 
 type Event struct {
-	GUID      string
-	CreatedAt time.Time
-	Type      string
-	ActorName string
-	Data      map[string]interface{}
+	GUID             string
+	CreatedAt        time.Time
+	Type             string
+	ActorGUID        string
+	ActorName        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Data             map[string]interface{}
 }
 
 func (e *Event) UnmarshalJSON(data []byte) error {
@@ -21,8 +27,20 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 		CreatedAt time.Time `json:"created_at"`
 		Type      string    `json:"type"`
 		Actor     struct {
+			GUID string `json:"guid"`
 			Name string `json:"name"`
 		} `json:"actor"`
+		Target struct {
+			GUID string `json:"guid"`
+			Type string `json:"type"`
+			Name string `json:"name"`
+		} `json:"target"`
+		Space struct {
+			GUID string `json:"guid"`
+		} `json:"space"`
+		Organization struct {
+			GUID string `json:"guid"`
+		} `json:"organization"`
 		Data map[string]interface{} `json:"data"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccEvent)
@@ -33,7 +51,13 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 	e.GUID = ccEvent.GUID
 	e.CreatedAt = ccEvent.CreatedAt
 	e.Type = ccEvent.Type
+	e.ActorGUID = ccEvent.Actor.GUID
 	e.ActorName = ccEvent.Actor.Name
+	e.TargetGUID = ccEvent.Target.GUID
+	e.TargetType = ccEvent.Target.Type
+	e.TargetName = ccEvent.Target.Name
+	e.SpaceGUID = ccEvent.Space.GUID
+	e.OrganizationGUID = ccEvent.Organization.GUID
 	e.Data = ccEvent.Data
 
 	return nil
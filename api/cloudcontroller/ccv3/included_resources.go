@@ -11,4 +11,5 @@ type IncludedResources struct {
 	ServiceBrokers   []resources.ServiceBroker   `json:"service_brokers,omitempty"`
 	ServicePlans     []resources.ServicePlan     `json:"service_plans,omitempty"`
 	Apps             []resources.Application     `json:"apps,omitempty"`
+	Routes           []resources.Route           `json:"routes,omitempty"`
 }
@@ -25,6 +25,7 @@ func (requester RealRequester) paginate(request *cloudcontroller.Request, obj in
 		includes.ServiceInstances = append(includes.ServiceInstances, wrapper.IncludedResources.ServiceInstances...)
 		includes.ServiceOfferings = append(includes.ServiceOfferings, wrapper.IncludedResources.ServiceOfferings...)
 		includes.ServicePlans = append(includes.ServicePlans, wrapper.IncludedResources.ServicePlans...)
+		includes.Routes = append(includes.Routes, wrapper.IncludedResources.Routes...)
 
 		if specificPage || wrapper.NextPage() == "" {
 			break
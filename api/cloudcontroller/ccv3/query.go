@@ -35,6 +35,12 @@ const (
 	StatusValueFilter QueryKey = "status_values"
 	// DomainGUIDFilter is a query param for listing events by target_guid
 	TargetGUIDFilter QueryKey = "target_guids"
+	// EventTypesFilter is a query param for listing events by type
+	EventTypesFilter QueryKey = "types"
+	// CreatedAtsGreaterThanOrEqualFilter is a query param for listing objects created at or after a timestamp
+	CreatedAtsGreaterThanOrEqualFilter QueryKey = "created_ats[gte]"
+	// CreatedAtsLessThanOrEqualFilter is a query param for listing objects created at or before a timestamp
+	CreatedAtsLessThanOrEqualFilter QueryKey = "created_ats[lte]"
 	// DomainGUIDFilter is a query param for listing objects by domain_guid
 	DomainGUIDFilter QueryKey = "domain_guids"
 	// HostsFilter is a query param for listing objects by hostname
@@ -75,6 +81,8 @@ const (
 	ServiceOfferingNamesFilter QueryKey = "service_offering_names"
 	// ServiceOfferingGUIDsFilter is a query parameter when getting resources according to service offering GUIDs
 	ServiceOfferingGUIDsFilter QueryKey = "service_offering_guids"
+	// ServicePlanGUIDsFilter is a query parameter when getting resources according to service plan GUIDs
+	ServicePlanGUIDsFilter QueryKey = "service_plan_guids"
 	// FieldsServiceOfferingServiceBroker is a query parameter to include specific fields from a service broker in a plan response
 	FieldsServiceOfferingServiceBroker QueryKey = "fields[service_offering.service_broker]"
 	// FieldsServiceBroker is a query parameter to include specific fields from a service broker in an offering response
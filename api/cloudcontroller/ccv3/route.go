@@ -143,6 +143,72 @@ func (client Client) UnshareRoute(routeGUID string, spaceGUID string) (Warnings,
 	return warnings, err
 }
 
+// AddRouteDestinations adds the destinations to the route, keeping its
+// existing destinations.
+func (client Client) AddRouteDestinations(routeGUID string, destinations []resources.RouteDestination) (Warnings, error) {
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.MapRouteRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+		RequestBody: newRouteDestinationsBody(destinations),
+	})
+
+	return warnings, err
+}
+
+// ReplaceRouteDestinations replaces all destinations of the route in a single
+// request and returns the resulting destinations.
+func (client Client) ReplaceRouteDestinations(routeGUID string, destinations []resources.RouteDestination) ([]resources.RouteDestination, Warnings, error) {
+	var responseBody struct {
+		Destinations []resources.RouteDestination `json:"destinations"`
+	}
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.PatchRouteDestinationsRequest,
+		URIParams:    internal.Params{"route_guid": routeGUID},
+		RequestBody:  newRouteDestinationsBody(destinations),
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody.Destinations, warnings, err
+}
+
+type routeDestinationProcess struct {
+	Type string `json:"type"`
+}
+
+type routeDestinationApp struct {
+	GUID    string                   `json:"guid"`
+	Process *routeDestinationProcess `json:"process,omitempty"`
+}
+
+type routeDestination struct {
+	App      routeDestinationApp `json:"app"`
+	Port     int                 `json:"port,omitempty"`
+	Protocol string              `json:"protocol,omitempty"`
+	Weight   *int                `json:"weight,omitempty"`
+}
+
+type routeDestinationsBody struct {
+	Destinations []routeDestination `json:"destinations"`
+}
+
+func newRouteDestinationsBody(destinations []resources.RouteDestination) *routeDestinationsBody {
+	body := routeDestinationsBody{Destinations: []routeDestination{}}
+	for _, d := range destinations {
+		dest := routeDestination{
+			App:      routeDestinationApp{GUID: d.App.GUID},
+			Port:     d.Port,
+			Protocol: d.Protocol,
+			Weight:   d.Weight,
+		}
+		if d.App.Process.Type != "" {
+			dest.App.Process = &routeDestinationProcess{Type: d.App.Process.Type}
+		}
+		body.Destinations = append(body.Destinations, dest)
+	}
+	return &body
+}
+
 func (client Client) UpdateDestination(routeGUID string, destinationGUID string, protocol string) (Warnings, error) {
 	type body struct {
 		Protocol string `json:"protocol"`
@@ -209,3 +275,18 @@ func (client Client) MoveRoute(routeGUID string, spaceGUID string) (Warnings, er
 	})
 	return warnings, err
 }
+
+// UpdateRoute sets the options of the route. Options with a nil value are
+// removed.
+func (client Client) UpdateRoute(routeGUID string, options map[string]*string) (resources.Route, Warnings, error) {
+	var responseBody resources.Route
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.PatchRouteRequest,
+		URIParams:    internal.Params{"route_guid": routeGUID},
+		RequestBody:  resources.Route{Options: options},
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody, warnings, err
+}
@@ -348,7 +348,8 @@ var _ = Describe("Route", func() {
 						},
 						{
 							"guid": "route-2-guid",
-							"url": "bye"
+							"url": "bye",
+							"created_at": "2021-01-01T00:00:00Z"
 						}
 					]
 				}`, server.URL())
@@ -399,8 +400,9 @@ var _ = Describe("Route", func() {
 							},
 						},
 						{
-							GUID: "route-2-guid",
-							URL:  "bye",
+							GUID:      "route-2-guid",
+							URL:       "bye",
+							CreatedAt: "2021-01-01T00:00:00Z",
 						},
 						{
 							GUID: "route-3-guid",
@@ -442,8 +444,9 @@ var _ = Describe("Route", func() {
 							},
 						},
 						{
-							GUID: "route-2-guid",
-							URL:  "bye",
+							GUID:      "route-2-guid",
+							URL:       "bye",
+							CreatedAt: "2021-01-01T00:00:00Z",
 						},
 						{
 							GUID: "route-3-guid",
@@ -1186,4 +1189,207 @@ var _ = Describe("Route", func() {
 			})
 		})
 	})
+
+	Describe("UpdateRoute", func() {
+		var (
+			route      resources.Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			roundRobin := "round-robin"
+			route, warnings, executeErr = client.UpdateRoute("route-guid", map[string]*string{
+				"loadbalancing": &roundRobin,
+				"stale":         nil,
+			})
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid"),
+						VerifyJSON(`{"options": {"loadbalancing": "round-robin", "stale": null}}`),
+						RespondWith(http.StatusOK, `{"guid": "route-guid", "options": {"loadbalancing": "round-robin"}}`, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+						}),
+					),
+				)
+			})
+
+			It("returns the updated route and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				roundRobin := "round-robin"
+				Expect(route).To(Equal(resources.Route{
+					GUID:    "route-guid",
+					Options: map[string]*string{"loadbalancing": &roundRobin},
+				}))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "Options Loadbalancing must be one of 'round-robin, least-connection'",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+						}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Options Loadbalancing must be one of 'round-robin, least-connection'",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("AddRouteDestinations", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/routes/route-guid/destinations"),
+					VerifyJSON(`{"destinations": [
+						{"app": {"guid": "app-guid", "process": {"type": "worker"}}, "port": 9090, "protocol": "http2"},
+						{"app": {"guid": "app-guid"}}
+					]}`),
+					RespondWith(http.StatusOK, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.AddRouteDestinations("route-guid", []resources.RouteDestination{
+				{
+					App:      resources.RouteDestinationApp{GUID: "app-guid", Process: struct{ Type string }{Type: "worker"}},
+					Port:     9090,
+					Protocol: "http2",
+				},
+				{
+					App: resources.RouteDestinationApp{GUID: "app-guid"},
+				},
+			})
+		})
+
+		It("adds the destinations with their process types, ports and protocols", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("ReplaceRouteDestinations", func() {
+		var (
+			destinations []resources.RouteDestination
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			ninety, ten := 90, 10
+			destinations, warnings, executeErr = client.ReplaceRouteDestinations("route-guid", []resources.RouteDestination{
+				{
+					App:    resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
+					Weight: &ninety,
+				},
+				{
+					App:      resources.RouteDestinationApp{GUID: "app-2-guid"},
+					Protocol: "http2",
+					Weight:   &ten,
+				},
+			})
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+	"destinations": [
+		{"guid": "destination-1-guid", "app": {"guid": "app-1-guid", "process": {"type": "web"}}, "weight": 90},
+		{"guid": "destination-2-guid", "app": {"guid": "app-2-guid", "process": {"type": "web"}}, "protocol": "http2", "weight": 10}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						VerifyJSON(`{"destinations": [
+							{"app": {"guid": "app-1-guid", "process": {"type": "web"}}, "weight": 90},
+							{"app": {"guid": "app-2-guid"}, "protocol": "http2", "weight": 10}
+						]}`),
+						RespondWith(http.StatusOK, response, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+						}),
+					),
+				)
+			})
+
+			It("returns the new destinations and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				ninety, ten := 90, 10
+				Expect(destinations).To(Equal([]resources.RouteDestination{
+					{
+						GUID:   "destination-1-guid",
+						App:    resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
+						Weight: &ninety,
+					},
+					{
+						GUID:     "destination-2-guid",
+						App:      resources.RouteDestinationApp{GUID: "app-2-guid", Process: struct{ Type string }{Type: "web"}},
+						Protocol: "http2",
+						Weight:   &ten,
+					},
+				}))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "Destinations weights must sum to 100.",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+						}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Destinations weights must sum to 100.",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -16,7 +16,9 @@ func (client *Client) CreateServiceCredentialBinding(binding resources.ServiceCr
 // GetServiceCredentialBindings queries the CC API with the specified query
 // and returns a slice of ServiceCredentialBindings. Additionally if Apps are
 // included in the API response (by having `include=app` in the query) then the
-// App names will be added into each ServiceCredentialBinding for app bindings
+// App names will be added into each ServiceCredentialBinding for app bindings.
+// Likewise, service instance names are added when `include=service_instance`
+// is in the query.
 func (client *Client) GetServiceCredentialBindings(query ...Query) ([]resources.ServiceCredentialBinding, Warnings, error) {
 	var result []resources.ServiceCredentialBinding
 
@@ -39,6 +41,14 @@ func (client *Client) GetServiceCredentialBindings(query ...Query) ([]resources.
 		}
 	}
 
+	if len(included.ServiceInstances) > 0 {
+		serviceInstanceNameLookup := lookuptable.NameFromGUID(included.ServiceInstances)
+
+		for i := range result {
+			result[i].ServiceInstanceName = serviceInstanceNameLookup[result[i].ServiceInstanceGUID]
+		}
+	}
+
 	return result, warnings, err
 }
 
@@ -66,11 +66,12 @@ var _ = Describe("Service Credential Bindings", func() {
 
 	Describe("GetServiceCredentialBindings", func() {
 		var (
-			query        []Query
-			bindings     []resources.ServiceCredentialBinding
-			includedApps []resources.Application
-			warnings     Warnings
-			executeErr   error
+			query                    []Query
+			bindings                 []resources.ServiceCredentialBinding
+			includedApps             []resources.Application
+			includedServiceInstances []resources.ServiceInstance
+			warnings                 Warnings
+			executeErr               error
 		)
 
 		BeforeEach(func() {
@@ -85,10 +86,11 @@ var _ = Describe("Service Credential Bindings", func() {
 						Type:                types[i%2],
 					})).NotTo(HaveOccurred())
 				}
-				return IncludedResources{Apps: includedApps}, Warnings{"warning-1", "warning-2"}, nil
+				return IncludedResources{Apps: includedApps, ServiceInstances: includedServiceInstances}, Warnings{"warning-1", "warning-2"}, nil
 			})
 
 			includedApps = nil
+			includedServiceInstances = nil
 
 			query = []Query{
 				{Key: ServiceInstanceGUIDFilter, Values: []string{"si-1-guid", "si-2-guid", "si-3-guid", "si-4-guid"}},
@@ -159,6 +161,25 @@ var _ = Describe("Service Credential Bindings", func() {
 			})
 		})
 
+		When("service instance resources are included via the query", func() {
+			BeforeEach(func() {
+				query = append(query, Query{Key: Include, Values: []string{"service_instance"}})
+
+				includedServiceInstances = []resources.ServiceInstance{
+					{GUID: "si-1-guid", Name: "si-1"},
+					{GUID: "si-2-guid", Name: "si-2"},
+				}
+			})
+
+			It("returns the service instance names", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(bindings[0].ServiceInstanceName).To(Equal("si-1"))
+				Expect(bindings[1].ServiceInstanceName).To(Equal("si-2"))
+				Expect(bindings[2].ServiceInstanceName).To(BeEmpty())
+			})
+		})
+
 		When("the cloud controller returns errors and warnings", func() {
 			BeforeEach(func() {
 				errors := []ccerror.V3Error{
@@ -182,6 +182,26 @@ var _ = Describe("Service Plan", func() {
 										  "guid": "59d428b9-75b4-44db-addf-19c85c7f0f1e"
 									   }
 									}
+								},
+								"schemas": {
+									"service_instance": {
+										"create": {
+											"parameters": {
+												"type": "object",
+												"required": ["size"]
+											}
+										},
+										"update": {
+											"parameters": {}
+										}
+									},
+									"service_binding": {
+										"create": {
+											"parameters": {
+												"type": "object"
+											}
+										}
+									}
 								}
 							}
 						]
@@ -244,6 +264,14 @@ var _ = Describe("Service Plan", func() {
 						Free:                true,
 						VisibilityType:      "organization",
 						ServiceOfferingGUID: "59d428b9-75b4-44db-addf-19c85c7f0f1e",
+						ServiceInstanceCreateSchema: map[string]interface{}{
+							"type":     "object",
+							"required": []interface{}{"size"},
+						},
+						ServiceInstanceUpdateSchema: map[string]interface{}{},
+						ServiceBindingCreateSchema: map[string]interface{}{
+							"type": "object",
+						},
 					},
 				))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
@@ -84,14 +84,18 @@ var _ = Describe("Sidecar", func() {
 				Expect(warnings).To(ConsistOf("this is a warning"))
 				Expect(len(processSidecars)).To(Equal(2))
 				Expect(processSidecars[0]).To(MatchAllFields(Fields{
-					"GUID":    Equal("process-1-guid"),
-					"Name":    Equal("auth-sidecar"),
-					"Command": Equal(types.FilteredString{IsSet: true, Value: "bundle exec rackup"}),
+					"GUID":         Equal("process-1-guid"),
+					"Name":         Equal("auth-sidecar"),
+					"Command":      Equal(types.FilteredString{IsSet: true, Value: "bundle exec rackup"}),
+					"ProcessTypes": Equal([]string{"web", "worker"}),
+					"MemoryInMB":   Equal(types.NullUint64{IsSet: true, Value: 300}),
 				}))
 				Expect(processSidecars[1]).To(MatchAllFields(Fields{
-					"GUID":    Equal("process-2-guid"),
-					"Name":    Equal("echo-sidecar"),
-					"Command": Equal(types.FilteredString{IsSet: true, Value: "start-echo-server"}),
+					"GUID":         Equal("process-2-guid"),
+					"Name":         Equal("echo-sidecar"),
+					"Command":      Equal(types.FilteredString{IsSet: true, Value: "start-echo-server"}),
+					"ProcessTypes": Equal([]string{"web"}),
+					"MemoryInMB":   Equal(types.NullUint64{IsSet: true, Value: 300}),
 				}))
 			})
 		})
@@ -185,4 +189,148 @@ var _ = Describe("Sidecar", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationSidecars", func() {
+		When("the app has sidecars", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {"next": null},
+					"resources": [
+						{
+							"guid": "sidecar-guid",
+							"name": "auth-sidecar",
+							"command": "bundle exec rackup",
+							"process_types": ["web"],
+							"memory_in_mb": null
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the sidecars and all warnings", func() {
+				sidecars, warnings, err := client.GetApplicationSidecars("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(sidecars).To(Equal([]resources.Sidecar{
+					{
+						GUID:         "sidecar-guid",
+						Name:         "auth-sidecar",
+						Command:      types.FilteredString{IsSet: true, Value: "bundle exec rackup"},
+						ProcessTypes: []string{"web"},
+					},
+				}))
+			})
+		})
+	})
+
+	Describe("CreateApplicationSidecar", func() {
+		BeforeEach(func() {
+			response := `{
+				"guid": "sidecar-guid",
+				"name": "auth-sidecar",
+				"command": "bundle exec rackup",
+				"process_types": ["web", "worker"],
+				"memory_in_mb": 300
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/sidecars"),
+					VerifyJSON(`{"name": "auth-sidecar", "command": "bundle exec rackup", "process_types": ["web", "worker"], "memory_in_mb": 300}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("creates the sidecar and returns it with all warnings", func() {
+			sidecar, warnings, err := client.CreateApplicationSidecar("some-app-guid", resources.Sidecar{
+				Name:         "auth-sidecar",
+				Command:      types.FilteredString{IsSet: true, Value: "bundle exec rackup"},
+				ProcessTypes: []string{"web", "worker"},
+				MemoryInMB:   types.NullUint64{IsSet: true, Value: 300},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(sidecar.GUID).To(Equal("sidecar-guid"))
+			Expect(sidecar.MemoryInMB).To(Equal(types.NullUint64{IsSet: true, Value: 300}))
+		})
+	})
+
+	Describe("UpdateSidecar", func() {
+		BeforeEach(func() {
+			response := `{
+				"guid": "sidecar-guid",
+				"name": "auth-sidecar",
+				"command": "new-command",
+				"process_types": ["web"]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/v3/sidecars/sidecar-guid"),
+					VerifyJSON(`{"command": "new-command"}`),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("sends only the fields that are set", func() {
+			sidecar, warnings, err := client.UpdateSidecar(resources.Sidecar{
+				GUID:    "sidecar-guid",
+				Command: types.FilteredString{IsSet: true, Value: "new-command"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(sidecar.Command.Value).To(Equal("new-command"))
+		})
+	})
+
+	Describe("DeleteSidecar", func() {
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "Sidecar not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/sidecars/sidecar-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.DeleteSidecar("sidecar-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Sidecar not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the sidecar is deleted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/sidecars/sidecar-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.DeleteSidecar("sidecar-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
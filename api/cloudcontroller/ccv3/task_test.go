@@ -453,6 +453,7 @@ var _ = Describe("Task", func() {
 					"name": "task-1",
 					"command": "some-command",
 					"state": "SUCCEEDED",
+					"droplet_guid": "some-droplet-guid",
 					"created_at": "2016-11-07T05:59:01Z"
 				}`
 
@@ -469,12 +470,13 @@ var _ = Describe("Task", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				expectedTask := resources.Task{
-					GUID:       "the-task-guid",
-					SequenceID: 1,
-					Name:       "task-1",
-					State:      constant.TaskSucceeded,
-					CreatedAt:  "2016-11-07T05:59:01Z",
-					Command:    "some-command",
+					GUID:        "the-task-guid",
+					SequenceID:  1,
+					Name:        "task-1",
+					State:       constant.TaskSucceeded,
+					CreatedAt:   "2016-11-07T05:59:01Z",
+					Command:     "some-command",
+					DropletGUID: "some-droplet-guid",
 				}
 
 				Expect(task).To(Equal(expectedTask))
@@ -13,13 +13,15 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"apps", "app", "create-app"},
 			{"push", "scale", "delete", "rename"},
+			{"blue-green-push"},
 			{"cancel-deployment"},
 			{"start", "stop", "restart", "stage-package", "restage", "restart-app-instance"},
-			{"run-task", "tasks", "terminate-task"},
+			{"run-task", "task", "tasks", "terminate-task"},
+			{"sidecars", "create-sidecar", "update-sidecar", "delete-sidecar"},
 			{"packages", "create-package"},
 			{"droplets", "set-droplet", "download-droplet"},
-			{"events", "logs"},
-			{"env", "set-env", "unset-env"},
+			{"events", "audit-events", "logs", "app-crashes"},
+			{"env", "set-env", "unset-env", "export-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
@@ -28,13 +30,13 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "SERVICES:",
 		CommandList: [][]string{
-			{"marketplace", "services", "service"},
-			{"create-service", "update-service", "upgrade-service", "delete-service", "rename-service"},
-			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
-			{"bind-service", "unbind-service"},
+			{"marketplace", "services", "service", "services-diff"},
+			{"create-service", "update-service", "upgrade-service", "delete-service", "rename-service", "upgrade-services", "service-upgrades"},
+			{"create-service-key", "service-keys", "service-key", "delete-service-key", "rotate-service-key"},
+			{"bind-service", "unbind-service", "rebind-service", "bindings"},
 			{"bind-route-service", "unbind-route-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
-			{"share-service", "unshare-service"},
+			{"share-service", "unshare-service", "shared-services"},
 		},
 	},
 	{
@@ -58,15 +60,16 @@ var HelpCategoryList = []HelpCategory{
 			{"domains"},
 			{"create-private-domain", "delete-private-domain"},
 			{"create-shared-domain", "delete-shared-domain"},
-			{"router-groups"},
+			{"router-groups", "router-group"},
 		},
 	},
 	{
 		CategoryName: "ROUTES:",
 		CommandList: [][]string{
 			{"routes", "route"},
-			{"create-route", "check-route", "map-route", "unmap-route", "delete-route"},
-			{"delete-orphaned-routes"},
+			{"create-route", "check-route", "map-route", "unmap-route", "delete-route", "update-route"},
+			{"set-route-weights", "shift-traffic"},
+			{"delete-orphaned-routes", "stale-routes"},
 			{"update-destination"},
 			{"share-route", "unshare-route"},
 			{"move-route"},
@@ -76,6 +79,8 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "NETWORK POLICIES:",
 		CommandList: [][]string{
 			{"network-policies", "add-network-policy", "remove-network-policy"},
+			{"apply-network-policies"},
+			{"internal-routes", "check-internal-connectivity"},
 		},
 	},
 	{
@@ -111,7 +116,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "SERVICE ADMIN:",
 		CommandList: [][]string{
-			{"service-brokers", "create-service-broker", "update-service-broker", "delete-service-broker", "rename-service-broker"},
+			{"service-brokers", "create-service-broker", "update-service-broker", "delete-service-broker", "rename-service-broker", "service-broker-catalog"},
 			{"purge-service-offering", "purge-service-instance"},
 			{"service-access", "enable-service-access", "disable-service-access"},
 		},
@@ -122,6 +127,7 @@ var HelpCategoryList = []HelpCategory{
 			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
+			{"effective-security-rules", "apply-security-groups"},
 		},
 	},
 	{
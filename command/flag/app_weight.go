@@ -0,0 +1,33 @@
+package flag
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// AppWeight is an APP=WEIGHT pair giving the percentage of a route's traffic
+// an app receives.
+type AppWeight struct {
+	AppName string
+	Weight  int
+}
+
+func (w *AppWeight) UnmarshalFlag(val string) error {
+	parts := strings.SplitN(val, "=", 2)
+	if len(parts) == 2 && strings.TrimSpace(parts[0]) != "" {
+		weight, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err == nil && weight >= 1 && weight <= 100 {
+			w.AppName = strings.TrimSpace(parts[0])
+			w.Weight = weight
+			return nil
+		}
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("App weight '%s' must be given as APP=WEIGHT, with a weight between 1 and 100", val),
+	}
+}
@@ -0,0 +1,39 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AppWeight", func() {
+	var weight AppWeight
+
+	BeforeEach(func() {
+		weight = AppWeight{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("splits the app name and weight", func() {
+			Expect(weight.UnmarshalFlag("my-app=90")).To(Succeed())
+			Expect(weight).To(Equal(AppWeight{AppName: "my-app", Weight: 90}))
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := weight.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "App weight '" + input + "' must be given as APP=WEIGHT, with a weight between 1 and 100",
+				}))
+			},
+			Entry("no equals sign", "my-app"),
+			Entry("no app name", "=50"),
+			Entry("a weight that is not a number", "my-app=half"),
+			Entry("a weight of zero", "my-app=0"),
+			Entry("a weight above 100", "my-app=101"),
+		)
+	})
+})
@@ -212,6 +212,10 @@ type BindServiceArgs struct {
 	ServiceInstanceName string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
 }
 
+type RouteURL struct {
+	Route string `positional-arg-name:"ROUTE" required:"true" description:"The route, such as myapp.example.com or myapp.example.com/path"`
+}
+
 type RouteServiceArgs struct {
 	Domain          string `positional-arg-name:"DOMAIN" required:"true" description:"The domain of the route"`
 	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
@@ -364,11 +368,21 @@ type RunTaskArgsV7 struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
-type TerminateTaskArgs struct {
+type SidecarArgs struct {
+	AppName     string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	SidecarName string `positional-arg-name:"SIDECAR_NAME" required:"true" description:"The sidecar name"`
+}
+
+type TaskArgs struct {
 	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
 }
 
+type TerminateTaskArgs struct {
+	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	SequenceID string `positional-arg-name:"TASK_ID" description:"The task's unique sequence ID"`
+}
+
 type IsolationSegmentName struct {
 	IsolationSegmentName string `positional-arg-name:"SEGMENT_NAME" required:"true" description:"The isolation segment name"`
 }
@@ -395,16 +409,39 @@ type AddNetworkPolicyArgs struct {
 	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
 }
 
+// AddNetworkPolicyArgsV7 are the apps of the policy. Apps selected by
+// --source-labels or --destination-labels are not given as arguments, so
+// the arguments are assigned to the remaining ends in order.
 type AddNetworkPolicyArgsV7 struct {
-	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
-	DestApp   string `positional-arg-name:"DESTINATION_APP" required:"true" description:"The destination app"`
+	SourceApp string `positional-arg-name:"SOURCE_APP" description:"The source app"`
+	DestApp   string `positional-arg-name:"DESTINATION_APP" description:"The destination app"`
 }
 
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
 
+// RemoveNetworkPolicyArgsV7 are the apps of the policy. Apps selected by
+// --source-labels or --destination-labels are not given as arguments, so
+// the arguments are assigned to the remaining ends in order.
 type RemoveNetworkPolicyArgsV7 struct {
-	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
-	DestApp   string `positional-arg-name:"DESTINATION_APP" required:"true" description:"The destination app"`
+	SourceApp string `positional-arg-name:"SOURCE_APP" description:"The source app"`
+	DestApp   string `positional-arg-name:"DESTINATION_APP" description:"The destination app"`
+}
+
+type CheckInternalConnectivityArgs struct {
+	SourceApp string `positional-arg-name:"SRC_APP" required:"true" description:"The app to connect from"`
+	Route     string `positional-arg-name:"DEST_ROUTE" required:"true" description:"The internal route to connect to"`
+	Port      int    `positional-arg-name:"PORT" required:"true" description:"The port to connect to"`
+}
+
+// CreateRouteArgs are the domain of the route and, when the port is picked
+// with --port auto-range, the range to pick it from.
+type CreateRouteArgs struct {
+	Domain    string      `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
+	PortRange NetworkPort `positional-arg-name:"START-END" description:"The range to pick the port of a TCP route from"`
+}
+
+type RouterGroup struct {
+	RouterGroup string `positional-arg-name:"ROUTER_GROUP" required:"true" description:"The router group name"`
 }
@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Duration accepts a non-negative Go duration such as "30s" or "1h30m".
+type Duration struct {
+	Value time.Duration
+	IsSet bool
+}
+
+func (d *Duration) UnmarshalFlag(rawValue string) error {
+	duration, err := time.ParseDuration(rawValue)
+	if err != nil || duration < 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Duration must be a non-negative duration (e.g. 30s, 15m or 1h30m)",
+		}
+	}
+
+	d.Value = duration
+	d.IsSet = true
+	return nil
+}
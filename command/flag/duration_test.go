@@ -0,0 +1,51 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Duration", func() {
+	var duration Duration
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			duration = Duration{}
+		})
+
+		When("passed a duration", func() {
+			It("sets the value", func() {
+				err := duration.UnmarshalFlag("1h30m")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(duration.Value).To(Equal(90 * time.Minute))
+				Expect(duration.IsSet).To(BeTrue())
+			})
+		})
+
+		When("passed a negative duration", func() {
+			It("returns an error", func() {
+				err := duration.UnmarshalFlag("-5m")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Duration must be a non-negative duration (e.g. 30s, 15m or 1h30m)",
+				}))
+				Expect(duration.IsSet).To(BeFalse())
+			})
+		})
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := duration.UnmarshalFlag("soon")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Duration must be a non-negative duration (e.g. 30s, 15m or 1h30m)",
+				}))
+			})
+		})
+	})
+})
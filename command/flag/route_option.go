@@ -0,0 +1,44 @@
+package flag
+
+import (
+	"fmt"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// RouteOption is a KEY=VALUE route option, such as
+// loadbalancing=least-connection.
+type RouteOption struct {
+	Key   string
+	Value string
+}
+
+func (o *RouteOption) UnmarshalFlag(val string) error {
+	parts := strings.SplitN(val, "=", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || parts[1] == "" {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Route option '%s' must be given as KEY=VALUE", val),
+		}
+	}
+
+	o.Key = strings.TrimSpace(parts[0])
+	o.Value = parts[1]
+	return nil
+}
+
+// RouteOptions converts the flag values into route options. It returns nil
+// when no options are given.
+func RouteOptions(options []RouteOption) map[string]*string {
+	if len(options) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*string, len(options))
+	for _, option := range options {
+		value := option.Value
+		result[option.Key] = &value
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RouteOption", func() {
+	var option RouteOption
+
+	BeforeEach(func() {
+		option = RouteOption{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("splits the key and value", func() {
+			Expect(option.UnmarshalFlag("loadbalancing=least-connection")).To(Succeed())
+			Expect(option).To(Equal(RouteOption{Key: "loadbalancing", Value: "least-connection"}))
+		})
+
+		It("keeps equals signs in the value", func() {
+			Expect(option.UnmarshalFlag("key=a=b")).To(Succeed())
+			Expect(option).To(Equal(RouteOption{Key: "key", Value: "a=b"}))
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := option.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Route option '" + input + "' must be given as KEY=VALUE",
+				}))
+			},
+			Entry("no equals sign", "loadbalancing"),
+			Entry("no key", "=round-robin"),
+			Entry("no value", "loadbalancing="),
+		)
+	})
+
+	Describe("RouteOptions", func() {
+		It("converts the options into a map", func() {
+			options := RouteOptions([]RouteOption{
+				{Key: "loadbalancing", Value: "round-robin"},
+				{Key: "other", Value: "value"},
+			})
+			Expect(options).To(HaveLen(2))
+			Expect(*options["loadbalancing"]).To(Equal("round-robin"))
+			Expect(*options["other"]).To(Equal("value"))
+		})
+
+		It("returns nil when there are no options", func() {
+			Expect(RouteOptions(nil)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,32 @@
+package flag
+
+import (
+	"strconv"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// TCPRoutePort is the port of a TCP route: a port number, or "auto-range" to
+// use the first free port of a range given as an argument.
+type TCPRoutePort struct {
+	Port      int
+	AutoRange bool
+}
+
+func (p *TCPRoutePort) UnmarshalFlag(val string) error {
+	if val == "auto-range" {
+		p.AutoRange = true
+		return nil
+	}
+
+	port, err := strconv.Atoi(val)
+	if err != nil || port < 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--port' (expected int > 0 or auto-range)",
+		}
+	}
+
+	p.Port = port
+	return nil
+}
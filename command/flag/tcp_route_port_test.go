@@ -0,0 +1,41 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TCPRoutePort", func() {
+	var port TCPRoutePort
+
+	BeforeEach(func() {
+		port = TCPRoutePort{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("it sets the port",
+			func(input string, expected TCPRoutePort) {
+				err := port.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(port).To(Equal(expected))
+			},
+			Entry("when provided '5000' it sets the port", "5000", TCPRoutePort{Port: 5000}),
+			Entry("when provided 'auto-range' it requests a port from a range", "auto-range", TCPRoutePort{AutoRange: true}),
+		)
+
+		DescribeTable("errors correctly",
+			func(input string) {
+				err := port.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--port' (expected int > 0 or auto-range)",
+				}))
+			},
+			Entry("when provided a word", "random"),
+			Entry("when provided a negative port", "-1"),
+		)
+	})
+})
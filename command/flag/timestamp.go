@@ -0,0 +1,34 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timestamp accepts either an RFC3339 timestamp or a duration such as "2h30m",
+// which is taken to mean that long before now.
+type Timestamp struct {
+	Value time.Time
+	IsSet bool
+}
+
+func (t *Timestamp) UnmarshalFlag(rawValue string) error {
+	if duration, err := time.ParseDuration(rawValue); err == nil && duration >= 0 {
+		t.Value = time.Now().Add(-duration)
+		t.IsSet = true
+		return nil
+	}
+
+	value, err := time.Parse(time.RFC3339, rawValue)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Timestamp must be an RFC3339 time (e.g. 2006-01-02T15:04:05Z) or a duration (e.g. 2h30m)",
+		}
+	}
+
+	t.Value = value
+	t.IsSet = true
+	return nil
+}
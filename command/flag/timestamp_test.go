@@ -0,0 +1,50 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Timestamp", func() {
+	var timestamp Timestamp
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			timestamp = Timestamp{}
+		})
+
+		When("passed an RFC3339 timestamp", func() {
+			It("sets the value", func() {
+				err := timestamp.UnmarshalFlag("2020-01-02T03:04:05Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Value).To(Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)))
+				Expect(timestamp.IsSet).To(BeTrue())
+			})
+		})
+
+		When("passed a duration", func() {
+			It("sets the value relative to now", func() {
+				err := timestamp.UnmarshalFlag("2h")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Value).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+				Expect(timestamp.IsSet).To(BeTrue())
+			})
+		})
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := timestamp.UnmarshalFlag("yesterday")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Timestamp must be an RFC3339 time (e.g. 2006-01-02T15:04:05Z) or a duration (e.g. 2h30m)",
+				}))
+				Expect(timestamp.IsSet).To(BeFalse())
+			})
+		})
+	})
+})
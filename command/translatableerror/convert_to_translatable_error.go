@@ -62,6 +62,8 @@ func ConvertToTranslatableError(err error) error {
 		return PortNotAllowedWithHTTPDomainError(e)
 	case actionerror.InvalidRouteError:
 		return InvalidRouteError(e)
+	case actionerror.InvalidServiceParametersError:
+		return InvalidServiceParametersError(e)
 	case actionerror.InvalidTCPRouteSettings:
 		return HostAndPathNotAllowedWithTCPDomainError(e)
 	case actionerror.IsolationSegmentNotFoundError:
@@ -120,6 +120,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidRouteError{Route: "some-invalid-route"},
 			InvalidRouteError{Route: "some-invalid-route"}),
 
+		Entry("actionerror.InvalidServiceParametersError -> InvalidServiceParametersError",
+			actionerror.InvalidServiceParametersError{Errors: []string{"$.size: expected string, got integer"}},
+			InvalidServiceParametersError{Errors: []string{"$.size: expected string, got integer"}}),
+
 		Entry("actionerror.InvalidTCPRouteSettings -> HostAndPathNotAllowedWithTCPDomainError",
 			actionerror.InvalidTCPRouteSettings{Domain: "some-domain"},
 			HostAndPathNotAllowedWithTCPDomainError{Domain: "some-domain"}),
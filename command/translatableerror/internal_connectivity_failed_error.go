@@ -0,0 +1,19 @@
+package translatableerror
+
+type InternalConnectivityFailedError struct {
+	SourceApp string
+	Route     string
+	Port      int
+}
+
+func (InternalConnectivityFailedError) Error() string {
+	return "App {{.SourceApp}} cannot connect to {{.Route}} on port {{.Port}}."
+}
+
+func (e InternalConnectivityFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SourceApp": e.SourceApp,
+		"Route":     e.Route,
+		"Port":      e.Port,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+import "strings"
+
+type InvalidServiceParametersError struct {
+	Errors []string
+}
+
+func (InvalidServiceParametersError) Error() string {
+	return "Parameters do not match the schema published by the service plan:\n{{.Errors}}"
+}
+
+func (e InvalidServiceParametersError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Errors": "  " + strings.Join(e.Errors, "\n  "),
+	})
+}
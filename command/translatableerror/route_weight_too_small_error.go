@@ -0,0 +1,21 @@
+package translatableerror
+
+// RouteWeightTooSmallError is returned when an app's weight on a route cannot
+// be split across all of its destinations on the route.
+type RouteWeightTooSmallError struct {
+	AppName      string
+	Weight       int
+	Destinations int
+}
+
+func (RouteWeightTooSmallError) Error() string {
+	return "App {{.AppName}} has {{.Destinations}} destinations on the route, so its weight must be at least {{.Destinations}}, got {{.Weight}}."
+}
+
+func (e RouteWeightTooSmallError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":      e.AppName,
+		"Weight":       e.Weight,
+		"Destinations": e.Destinations,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+type ScheduledServiceInstanceUpgradesFailedError struct {
+	Failed      int
+	Total       int
+	JournalPath string
+}
+
+func (ScheduledServiceInstanceUpgradesFailedError) Error() string {
+	return "{{.Failed}} of {{.Total}} scheduled service instance upgrades failed. The failures are recorded in {{.JournalPath}}."
+}
+
+func (e ScheduledServiceInstanceUpgradesFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed":      e.Failed,
+		"Total":       e.Total,
+		"JournalPath": e.JournalPath,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// ServiceInstanceSharedBindingsError is returned when unsharing a service
+// instance from every space would delete app bindings.
+type ServiceInstanceSharedBindingsError struct {
+	ServiceInstanceName string
+	BoundAppCount       int
+}
+
+func (ServiceInstanceSharedBindingsError) Error() string {
+	return "Service instance {{.ServiceInstanceName}} is bound to {{.BoundAppCount}} apps in the spaces it is shared into. Unsharing deletes these bindings; use -f to unshare anyway."
+}
+
+func (e ServiceInstanceSharedBindingsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceInstanceName": e.ServiceInstanceName,
+		"BoundAppCount":       e.BoundAppCount,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceInstanceUpgradesFailedError struct {
+	Failed int
+	Total  int
+}
+
+func (ServiceInstanceUpgradesFailedError) Error() string {
+	return "{{.Failed}} of {{.Total}} service instance upgrades failed."
+}
+
+func (e ServiceInstanceUpgradesFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed": e.Failed,
+		"Total":  e.Total,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ServiceInstancesNotAppliedError is returned when services-diff --apply
+// could not change some service instances to match the file.
+type ServiceInstancesNotAppliedError struct {
+	Names []string
+}
+
+func (ServiceInstancesNotAppliedError) Error() string {
+	return "Service instances {{.Names}} could not be updated to match the file; the space still differs from it."
+}
+
+func (e ServiceInstancesNotAppliedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Names": strings.Join(e.Names, ", "),
+	})
+}
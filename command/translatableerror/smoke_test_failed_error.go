@@ -0,0 +1,20 @@
+package translatableerror
+
+// SmokeTestFailedError is returned when the smoke test of a blue-green push
+// fails. The new app has been deleted by then and the current app still
+// serves the production routes.
+type SmokeTestFailedError struct {
+	AppName string
+	Reason  string
+}
+
+func (SmokeTestFailedError) Error() string {
+	return "Smoke test of the new version of app {{.AppName}} failed: {{.Reason}}. The new version has been deleted; the current version still serves all routes."
+}
+
+func (e SmokeTestFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Reason":  e.Reason,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+import "strings"
+
+type TasksNotTerminatedError struct {
+	SequenceIDs []string
+}
+
+func (TasksNotTerminatedError) Error() string {
+	return "Failed to terminate tasks: {{.SequenceIDs}}"
+}
+
+func (e TasksNotTerminatedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SequenceIDs": strings.Join(e.SequenceIDs, ", "),
+	})
+}
@@ -0,0 +1,20 @@
+package translatableerror
+
+// TrafficShiftAbortedError is returned when shift-traffic stops because the
+// app receiving the traffic crashed, after restoring the route's original
+// destinations.
+type TrafficShiftAbortedError struct {
+	AppName    string
+	CrashCount int
+}
+
+func (TrafficShiftAbortedError) Error() string {
+	return "Traffic shift aborted: app {{.AppName}} crashed {{.CrashCount}} times. The original route destinations have been restored."
+}
+
+func (e TrafficShiftAbortedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"CrashCount": e.CrashCount,
+	})
+}
@@ -14,6 +14,8 @@ import (
 
 type NetworkingActor interface {
 	AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	AddNetworkPolicies(srcApps []resources.Application, destApps []resources.Application, protocol string, startPort int, endPort int) error
+	GetPolicyApps(selector cfnetworkingaction.PolicyAppSelector) ([]resources.Application, cfnetworkingaction.Warnings, error)
 }
 
 type AddNetworkPolicyCommand struct {
@@ -26,7 +28,11 @@ type AddNetworkPolicyCommand struct {
 	DestinationOrg   string `short:"o" description:"The org of the destination app (Default: targeted org)"`
 	DestinationSpace string `short:"s" description:"The space of the destination app (Default: targeted space)"`
 
-	usage           interface{} `usage:"CF_NAME add-network-policy SOURCE_APP DESTINATION_APP [-s DESTINATION_SPACE_NAME [-o DESTINATION_ORG_NAME]] [--protocol (tcp | udp) --port RANGE]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend backend -s backend-space -o backend-org --protocol tcp --port 8080-8090"`
+	SourceLabels      string `long:"source-labels" description:"Use every app in the targeted space matching this label selector as a source instead of SOURCE_APP"`
+	DestinationLabels string `long:"destination-labels" description:"Use every app in the destination space matching this label selector as a destination instead of DESTINATION_APP"`
+	Force             bool   `short:"f" description:"Add the policies selected by labels without asking for confirmation"`
+
+	usage           interface{} `usage:"CF_NAME add-network-policy (SOURCE_APP | --source-labels SELECTOR) (DESTINATION_APP | --destination-labels SELECTOR) [-s DESTINATION_SPACE_NAME [-o DESTINATION_ORG_NAME]] [--protocol (tcp | udp) --port RANGE] [-f]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend backend -s backend-space -o backend-org --protocol tcp --port 8080-8090\n   CF_NAME add-network-policy --source-labels tier=web backend --protocol tcp --port 8080"`
 	relatedCommands interface{} `related_commands:"apps, network-policies, remove-network-policy"`
 
 	NetworkingActor NetworkingActor
@@ -63,7 +69,12 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 		cmd.Port.EndPort = 8080
 	}
 
-	err := cmd.SharedActor.CheckTarget(true, true)
+	selection, err := newNetworkPolicySelection([]string{cmd.RequiredArgs.SourceApp, cmd.RequiredArgs.DestApp}, cmd.SourceLabels, cmd.DestinationLabels)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -103,31 +114,81 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 		return err
 	}
 
+	if selection.usesLabels() {
+		displayDestinationSpace := cmd.DestinationSpace
+		if displayDestinationSpace == "" {
+			displayDestinationSpace = cmd.Config.TargetedSpace().Name
+		}
+
+		return cmd.addPoliciesBySelection(selection, destSpaceGUID, displayDestinationOrg, displayDestinationSpace, user.Name)
+	}
+
 	if cmd.DestinationSpace != "" {
 		cmd.UI.DisplayTextWithFlavor("Adding network policy from app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} to app {{.DstAppName}} in org {{.DstOrg}} / space {{.DstSpace}} as {{.User}}...", map[string]interface{}{
-			"SrcAppName": cmd.RequiredArgs.SourceApp,
+			"SrcAppName": selection.SourceApp,
 			"Org":        cmd.Config.TargetedOrganization().Name,
 			"Space":      cmd.Config.TargetedSpace().Name,
-			"DstAppName": cmd.RequiredArgs.DestApp,
+			"DstAppName": selection.DestinationApp,
 			"DstOrg":     displayDestinationOrg,
 			"DstSpace":   cmd.DestinationSpace,
 			"User":       user.Name,
 		})
 	} else {
 		cmd.UI.DisplayTextWithFlavor("Adding network policy from app {{.SrcAppName}} to app {{.DstAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-			"SrcAppName": cmd.RequiredArgs.SourceApp,
+			"SrcAppName": selection.SourceApp,
 			"Org":        cmd.Config.TargetedOrganization().Name,
 			"Space":      cmd.Config.TargetedSpace().Name,
-			"DstAppName": cmd.RequiredArgs.DestApp,
+			"DstAppName": selection.DestinationApp,
 			"User":       user.Name,
 		})
 	}
 
-	warnings, err := cmd.NetworkingActor.AddNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, destSpaceGUID, cmd.RequiredArgs.DestApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
+	warnings, err := cmd.NetworkingActor.AddNetworkPolicy(cmd.Config.TargetedSpace().GUID, selection.SourceApp, destSpaceGUID, selection.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd AddNetworkPolicyCommand) addPoliciesBySelection(selection networkPolicySelection, destSpaceGUID string, destOrgName string, destSpaceName string, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Adding network policies from {{.Source}} in org {{.Org}} / space {{.Space}} to {{.Destination}} in org {{.DstOrg}} / space {{.DstSpace}} as {{.User}}...", map[string]interface{}{
+		"Source":      describePolicyEnd(selection.SourceApp, selection.SourceLabels),
+		"Org":         cmd.Config.TargetedOrganization().Name,
+		"Space":       cmd.Config.TargetedSpace().Name,
+		"Destination": describePolicyEnd(selection.DestinationApp, selection.DestinationLabels),
+		"DstOrg":      destOrgName,
+		"DstSpace":    destSpaceName,
+		"User":        userName,
+	})
+
+	srcApps, warnings, err := cmd.NetworkingActor.GetPolicyApps(selection.sourceSelector(cmd.Config.TargetedSpace().GUID))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	destApps, warnings, err := cmd.NetworkingActor.GetPolicyApps(selection.destinationSelector(destSpaceGUID))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
+
+	confirmed, err := displayNetworkPolicyPairs(cmd.UI, srcApps, destApps, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort, cmd.Force, "Add {{.Count}} network policies?")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		cmd.UI.DisplayText("Network policies have not been added.")
+		return nil
+	}
+
+	err = cmd.NetworkingActor.AddNetworkPolicies(srcApps, destApps, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
+	if err != nil {
+		return err
+	}
 	cmd.UI.DisplayOK()
 
 	return nil
@@ -281,5 +281,117 @@ var _ = Describe("add-network-policy Command", func() {
 				Expect(testUI.Out).To(Say("OK"))
 			})
 		})
+
+		When("only one app is given without label selectors", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.AddNetworkPolicyArgsV7{SourceApp: srcApp}
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "DESTINATION_APP"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("both ends are selected by labels and an app is given", func() {
+			BeforeEach(func() {
+				cmd.SourceLabels = "tier=web"
+				cmd.DestinationLabels = "tier=api"
+				cmd.RequiredArgs = flag.AddNetworkPolicyArgsV7{SourceApp: srcApp}
+			})
+
+			It("returns a TooManyArgumentsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.TooManyArgumentsError{ExtraArgument: srcApp}))
+			})
+		})
+
+		When("the source apps are selected by labels", func() {
+			var (
+				input    *Buffer
+				srcApps  []resources.Application
+				destApps []resources.Application
+			)
+
+			BeforeEach(func() {
+				input = NewBuffer()
+				testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+				cmd.UI = testUI
+
+				cmd.SourceLabels = "tier=web"
+				cmd.RequiredArgs = flag.AddNetworkPolicyArgsV7{SourceApp: "backend"}
+
+				srcApps = []resources.Application{{Name: "web-1", GUID: "web-1-guid"}, {Name: "web-2", GUID: "web-2-guid"}}
+				destApps = []resources.Application{{Name: "backend", GUID: "backend-guid"}}
+				fakeNetworkingActor.GetPolicyAppsReturnsOnCall(0, srcApps, cfnetworkingaction.Warnings{"source-apps-warning"}, nil)
+				fakeNetworkingActor.GetPolicyAppsReturnsOnCall(1, destApps, cfnetworkingaction.Warnings{"destination-apps-warning"}, nil)
+
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("uses the argument as the destination app and adds a policy for every pair", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeNetworkingActor.GetPolicyAppsArgsForCall(0)).To(Equal(cfnetworkingaction.PolicyAppSelector{SpaceGUID: "some-space-guid", LabelSelector: "tier=web"}))
+				Expect(fakeNetworkingActor.GetPolicyAppsArgsForCall(1)).To(Equal(cfnetworkingaction.PolicyAppSelector{SpaceGUID: "some-space-guid", AppName: "backend"}))
+
+				Expect(testUI.Out).To(Say(`Adding network policies from apps matching 'tier=web' in org some-org / space some-space to app backend in org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`source\s+destination\s+protocol\s+ports`))
+				Expect(testUI.Out).To(Say(`web-1\s+backend\s+tcp\s+8080`))
+				Expect(testUI.Out).To(Say(`web-2\s+backend\s+tcp\s+8080`))
+				Expect(testUI.Out).To(Say(`Add 2 network policies\?`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("source-apps-warning"))
+				Expect(testUI.Err).To(Say("destination-apps-warning"))
+
+				Expect(fakeNetworkingActor.AddNetworkPoliciesCallCount()).To(Equal(1))
+				passedSrcApps, passedDestApps, passedProtocol, startPort, endPort := fakeNetworkingActor.AddNetworkPoliciesArgsForCall(0)
+				Expect(passedSrcApps).To(Equal(srcApps))
+				Expect(passedDestApps).To(Equal(destApps))
+				Expect(passedProtocol).To(Equal("tcp"))
+				Expect(startPort).To(Equal(8080))
+				Expect(endPort).To(Equal(8080))
+				Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(0))
+			})
+
+			When("the user declines", func() {
+				BeforeEach(func() {
+					input.Clear()
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("does not add the policies", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Network policies have not been added."))
+					Expect(fakeNetworkingActor.AddNetworkPoliciesCallCount()).To(Equal(0))
+				})
+			})
+
+			When("-f is passed", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+					input.Clear()
+				})
+
+				It("adds the policies without asking", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).NotTo(Say(`Add 2 network policies\?`))
+					Expect(fakeNetworkingActor.AddNetworkPoliciesCallCount()).To(Equal(1))
+				})
+			})
+
+			When("no apps match the label selector", func() {
+				BeforeEach(func() {
+					fakeNetworkingActor.GetPolicyAppsReturnsOnCall(0, nil, cfnetworkingaction.Warnings{"source-apps-warning"}, actionerror.NoAppsMatchLabelSelectorError{LabelSelector: "tier=web"})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.NoAppsMatchLabelSelectorError{LabelSelector: "tier=web"}))
+					Expect(testUI.Err).To(Say("source-apps-warning"))
+					Expect(fakeNetworkingActor.AddNetworkPoliciesCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
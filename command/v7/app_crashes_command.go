@@ -0,0 +1,97 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type AppCrashesCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName   `positional-args:"yes"`
+	Since           flag.Timestamp `long:"since" description:"Only show crashes after this time, given as an RFC3339 timestamp or a duration such as 2h"`
+	Lines           int            `long:"lines" default:"10" description:"Number of log lines to show before each crash"`
+	usage           interface{}    `usage:"CF_NAME app-crashes APP_NAME [--since (TIMESTAMP | DURATION)] [--lines NUMBER]\n\nEXAMPLES:\n   CF_NAME app-crashes my-app\n   CF_NAME app-crashes my-app --since 24h --lines 20"`
+	relatedCommands interface{}    `related_commands:"app, events, logs, restart-app-instance"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *AppCrashesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd AppCrashesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting crashes for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	crashes, warnings, err := cmd.Actor.GetApplicationCrashesByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.Since.Value,
+		cmd.Lines,
+		cmd.LogCacheClient,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(crashes) == 0 {
+		cmd.UI.DisplayText("No crashes found.")
+		return nil
+	}
+
+	for i, crash := range crashes {
+		if i > 0 {
+			cmd.UI.DisplayNewline()
+		}
+		cmd.displayCrash(crash)
+	}
+
+	return nil
+}
+
+func (cmd AppCrashesCommand) displayCrash(crash v7action.ApplicationCrash) {
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("time:"), crash.Time.Local().Format("2006-01-02T15:04:05.00-0700")},
+		{cmd.UI.TranslateText("event:"), crash.Type},
+		{cmd.UI.TranslateText("process:"), crash.ProcessType},
+		{cmd.UI.TranslateText("instance:"), crash.Index},
+		{cmd.UI.TranslateText("reason:"), crash.Reason},
+		{cmd.UI.TranslateText("exit description:"), crash.ExitDescription},
+	}, 3)
+
+	if len(crash.Logs) == 0 {
+		return
+	}
+
+	cmd.UI.DisplayText("last log lines:")
+	for _, message := range crash.Logs {
+		cmd.UI.DisplayLogMessage(message, true)
+	}
+}
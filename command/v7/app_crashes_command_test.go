@@ -0,0 +1,189 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-crashes Command", func() {
+	var (
+		cmd                AppCrashesCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v7fakes.FakeActor
+		fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+		binaryName         string
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = AppCrashesCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Lines:        10,
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+			},
+			LogCacheClient: fakeLogCacheClient,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is not logged in", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeActor.GetCurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(Equal(expectedErr))
+		})
+	})
+
+	When("getting the crashes returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashesByNameAndSpaceReturns(nil, v7action.Warnings{"warning-1"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+
+			Expect(testUI.Out).To(Say(`Getting crashes for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("there are no crashes", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashesByNameAndSpaceReturns(nil, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No crashes found."))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("there are crashes", func() {
+		var since time.Time
+
+		BeforeEach(func() {
+			since = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			cmd.Since = flag.Timestamp{Value: since, IsSet: true}
+			cmd.Lines = 3
+
+			fakeActor.GetApplicationCrashesByNameAndSpaceReturns(
+				[]v7action.ApplicationCrash{
+					{
+						Time:            time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+						Type:            "audit.app.process.crash",
+						ProcessType:     "web",
+						Index:           "1",
+						ExitDescription: "APP/PROC/WEB: Exited with status 137",
+						Reason:          "CRASHED",
+						Logs: []sharedaction.LogMessage{
+							*sharedaction.NewLogMessage("out of memory", "ERR", time.Date(2020, 1, 2, 3, 4, 4, 0, time.UTC), "APP/PROC/WEB", "1"),
+						},
+					},
+					{
+						Time:        time.Date(2020, 1, 1, 3, 4, 5, 0, time.UTC),
+						Type:        "audit.app.process.rescheduling",
+						ProcessType: "worker",
+						Index:       "0",
+						Reason:      "Cell is being evacuated",
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("passes the flags to the actor", func() {
+			Expect(fakeActor.GetApplicationCrashesByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, actualSince, logLines, client := fakeActor.GetApplicationCrashesByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(actualSince).To(Equal(since))
+			Expect(logLines).To(Equal(3))
+			Expect(client).To(Equal(fakeLogCacheClient))
+		})
+
+		It("displays each crash with the logs that preceded it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`event:\s+audit\.app\.process\.crash`))
+			Expect(testUI.Out).To(Say(`process:\s+web`))
+			Expect(testUI.Out).To(Say(`instance:\s+1`))
+			Expect(testUI.Out).To(Say(`reason:\s+CRASHED`))
+			Expect(testUI.Out).To(Say(`exit description:\s+APP/PROC/WEB: Exited with status 137`))
+			Expect(testUI.Out).To(Say(`last log lines:`))
+			Expect(testUI.Out).To(Say(`\[APP/PROC/WEB/1\] ERR out of memory`))
+
+			Expect(testUI.Out).To(Say(`event:\s+audit\.app\.process\.rescheduling`))
+			Expect(testUI.Out).To(Say(`process:\s+worker`))
+			Expect(testUI.Out).To(Say(`instance:\s+0`))
+			Expect(testUI.Out).To(Say(`reason:\s+Cell is being evacuated`))
+			Expect(testUI.Out).ToNot(Say(`last log lines:`))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
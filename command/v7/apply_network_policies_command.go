@@ -0,0 +1,174 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/policymanifest"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ApplyNetworkPoliciesActor
+
+type ApplyNetworkPoliciesActor interface {
+	ApplyNetworkPolicies(spaceGUID string, definitions []cfnetworkingaction.PolicyDefinition, prune bool, dryRun bool) (cfnetworkingaction.PolicyChanges, cfnetworkingaction.Warnings, error)
+}
+
+type ApplyNetworkPoliciesCommand struct {
+	BaseCommand
+
+	PathToFile      flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a file declaring the network policies"`
+	Prune           bool                        `long:"prune" description:"Remove policies that are not declared in the file from the apps in the targeted space and the declared source apps"`
+	DryRun          bool                        `long:"dry-run" description:"Show the changes without making them"`
+	usage           interface{}                 `usage:"CF_NAME apply-network-policies -f POLICIES_FILE [--prune] [--dry-run]\n\n   Creates the network policies declared in the file that do not exist yet. Apps without an org\n   or space are looked up in the targeted org and space. The file format is the one printed by\n   'CF_NAME network-policies --output yaml'.\n\nEXAMPLES:\n   CF_NAME apply-network-policies -f policies.yml\n   CF_NAME apply-network-policies -f policies.yml --prune --dry-run"`
+	relatedCommands interface{}                 `related_commands:"add-network-policy, network-policies, remove-network-policy"`
+
+	NetworkingActor ApplyNetworkPoliciesActor
+}
+
+func (cmd *ApplyNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := policymanifest.Read(string(cmd.PathToFile))
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	template := "Applying network policies in {{.Path}} to org {{.Org}} / space {{.Space}} as {{.User}}..."
+	if cmd.DryRun {
+		template = "Comparing network policies in {{.Path}} with org {{.Org}} / space {{.Space}} as {{.User}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
+		"Path":  cmd.PathToFile,
+		"Org":   cmd.Config.TargetedOrganization().Name,
+		"Space": cmd.Config.TargetedSpace().Name,
+		"User":  user.Name,
+	})
+
+	definitions, err := cmd.policyDefinitions(manifest)
+	if err != nil {
+		return err
+	}
+
+	changes, warnings, err := cmd.NetworkingActor.ApplyNetworkPolicies(cmd.Config.TargetedSpace().GUID, definitions, cmd.Prune, cmd.DryRun)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(changes.Added)+len(changes.Removed) == 0 {
+		cmd.UI.DisplayText("Network policies are up to date.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("source"),
+			cmd.UI.TranslateText("source space"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("destination space"),
+			cmd.UI.TranslateText("destination org"),
+		},
+	}
+	for _, policy := range changes.Added {
+		table = append(table, policyChangeRow("+", policy))
+	}
+	for _, policy := range changes.Removed {
+		table = append(table, policyChangeRow("-", policy))
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("Dry run: no network policies were changed.")
+	} else {
+		cmd.UI.DisplayText("Added {{.Added}} and removed {{.Removed}} network policies.", map[string]interface{}{
+			"Added":   len(changes.Added),
+			"Removed": len(changes.Removed),
+		})
+	}
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// policyDefinitions converts the policies in the file, defaulting apps
+// without an org or space to the targeted ones.
+func (cmd ApplyNetworkPoliciesCommand) policyDefinitions(manifest policymanifest.Manifest) ([]cfnetworkingaction.PolicyDefinition, error) {
+	var definitions []cfnetworkingaction.PolicyDefinition
+	for _, policy := range manifest.Policies {
+		startPort, endPort, err := policymanifest.ParsePorts(policy.Ports)
+		if err != nil {
+			return nil, err
+		}
+
+		definitions = append(definitions, cfnetworkingaction.PolicyDefinition{
+			Source:      cmd.policyApp(policy.Source),
+			Destination: cmd.policyApp(policy.Destination),
+			Protocol:    policy.Protocol,
+			StartPort:   startPort,
+			EndPort:     endPort,
+		})
+	}
+	return definitions, nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) policyApp(app policymanifest.App) cfnetworkingaction.PolicyApp {
+	policyApp := cfnetworkingaction.PolicyApp{
+		OrgName:   app.Org,
+		SpaceName: app.Space,
+		AppName:   app.Name,
+	}
+	if policyApp.OrgName == "" {
+		policyApp.OrgName = cmd.Config.TargetedOrganization().Name
+	}
+	if policyApp.SpaceName == "" {
+		policyApp.SpaceName = cmd.Config.TargetedSpace().Name
+	}
+	return policyApp
+}
+
+func policyChangeRow(change string, policy cfnetworkingaction.Policy) []string {
+	return []string{
+		change,
+		policy.SourceName,
+		policy.SourceSpaceName,
+		policy.DestinationName,
+		policy.Protocol,
+		policymanifest.FormatPorts(policy.StartPort, policy.EndPort),
+		policy.DestinationSpaceName,
+		policy.DestinationOrgName,
+	}
+}
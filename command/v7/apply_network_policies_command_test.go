@@ -0,0 +1,202 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/policymanifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-network-policies Command", func() {
+	var (
+		cmd                  ApplyNetworkPoliciesCommand
+		testUI               *ui.UI
+		fakeConfig           *commandfakes.FakeConfig
+		fakeSharedActor      *commandfakes.FakeSharedActor
+		fakeActor            *v7fakes.FakeActor
+		fakeNetworkingActor  *v7fakes.FakeApplyNetworkPoliciesActor
+		binaryName           string
+		dir                  string
+		policiesFile         string
+		policiesFileContents string
+		executeErr           error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeApplyNetworkPoliciesActor)
+
+		var err error
+		dir, err = ioutil.TempDir("", "apply-network-policies-test")
+		Expect(err).NotTo(HaveOccurred())
+		policiesFile = filepath.Join(dir, "policies.yml")
+		policiesFileContents = `network_policies:
+- source: {app: frontend}
+  destination: {app: backend, space: other-space, org: other-org}
+  protocol: tcp
+  ports: 8080-8090
+`
+
+		cmd = ApplyNetworkPoliciesCommand{
+			BaseCommand: BaseCommand{
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkingActor,
+			PathToFile:      flag.PathWithExistenceCheck(policiesFile),
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(policiesFile, []byte(policiesFileContents), 0600)).To(Succeed())
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the file is invalid", func() {
+		BeforeEach(func() {
+			policiesFileContents = "network_policies:\n- source: {app: frontend}\n"
+		})
+
+		It("returns the error without applying anything", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(policymanifest.InvalidManifestError{}))
+			Expect(fakeNetworkingActor.ApplyNetworkPoliciesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("applying the policies changes them", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.ApplyNetworkPoliciesReturns(cfnetworkingaction.PolicyChanges{
+				Added: []cfnetworkingaction.Policy{{
+					SourceName:           "frontend",
+					SourceSpaceName:      "some-space",
+					DestinationName:      "backend",
+					Protocol:             "tcp",
+					StartPort:            8080,
+					EndPort:              8090,
+					DestinationSpaceName: "other-space",
+					DestinationOrgName:   "other-org",
+				}},
+				Removed: []cfnetworkingaction.Policy{{
+					SourceName:           "frontend",
+					SourceSpaceName:      "some-space",
+					DestinationName:      "cache",
+					Protocol:             "udp",
+					StartPort:            6379,
+					EndPort:              6379,
+					DestinationSpaceName: "some-space",
+					DestinationOrgName:   "some-org",
+				}},
+			}, cfnetworkingaction.Warnings{"apply-warning"}, nil)
+			cmd.Prune = true
+		})
+
+		It("passes the definitions, defaulting to the targeted org and space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeNetworkingActor.ApplyNetworkPoliciesCallCount()).To(Equal(1))
+			spaceGUID, definitions, prune, dryRun := fakeNetworkingActor.ApplyNetworkPoliciesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(definitions).To(Equal([]cfnetworkingaction.PolicyDefinition{{
+				Source:      cfnetworkingaction.PolicyApp{OrgName: "some-org", SpaceName: "some-space", AppName: "frontend"},
+				Destination: cfnetworkingaction.PolicyApp{OrgName: "other-org", SpaceName: "other-space", AppName: "backend"},
+				Protocol:    "tcp",
+				StartPort:   8080,
+				EndPort:     8090,
+			}}))
+			Expect(prune).To(BeTrue())
+			Expect(dryRun).To(BeFalse())
+		})
+
+		It("displays the changes", func() {
+			Expect(testUI.Out).To(Say(`Applying network policies in .*policies\.yml to org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`source\s+source space\s+destination\s+protocol\s+ports\s+destination space\s+destination org`))
+			Expect(testUI.Out).To(Say(`\+\s+frontend\s+some-space\s+backend\s+tcp\s+8080-8090\s+other-space\s+other-org`))
+			Expect(testUI.Out).To(Say(`-\s+frontend\s+some-space\s+cache\s+udp\s+6379\s+some-space\s+some-org`))
+			Expect(testUI.Out).To(Say(`Added 1 and removed 1 network policies\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+		})
+
+		When("it is a dry run", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("says that nothing was changed", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				_, _, _, dryRun := fakeNetworkingActor.ApplyNetworkPoliciesArgsForCall(0)
+				Expect(dryRun).To(BeTrue())
+
+				Expect(testUI.Out).To(Say(`Comparing network policies in .*policies\.yml with org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`\+\s+frontend`))
+				Expect(testUI.Out).To(Say(`Dry run: no network policies were changed\.`))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+	})
+
+	When("the policies are up to date", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.ApplyNetworkPoliciesReturns(cfnetworkingaction.PolicyChanges{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Network policies are up to date\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("applying the policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.ApplyNetworkPoliciesReturns(cfnetworkingaction.PolicyChanges{}, cfnetworkingaction.Warnings{"apply-warning"}, errors.New("apply-error"))
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("apply-error"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+		})
+	})
+})
@@ -0,0 +1,185 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/securitygroupmanifest"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ApplySecurityGroupsCommand struct {
+	BaseCommand
+
+	PathToFile      flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a file declaring the security groups"`
+	Prune           bool                        `long:"prune" description:"Delete security groups that are not declared in the file"`
+	Force           bool                        `long:"force" description:"Apply the changes without confirmation"`
+	usage           interface{}                 `usage:"CF_NAME apply-security-groups -f GROUPS_FILE [--prune] [--force]\n\n   Creates and updates the security groups declared in the file, and binds and unbinds them\n   so that they are bound globally and to spaces exactly as declared. The changes are shown\n   before they are made.\n\n   Valid file example:\n   security_groups:\n   - name: public-https\n     rules:\n     - protocol: tcp\n       destination: 0.0.0.0/0\n       ports: \"443\"\n     globally_enabled:\n       running: true\n       staging: true\n   - name: backend\n     rules:\n     - protocol: all\n       destination: 10.0.0.0/8\n     running_spaces:\n     - {org: my-org, space: production}\n     staging_spaces:\n     - {org: my-org, space: production}\n\nEXAMPLES:\n   CF_NAME apply-security-groups -f groups.yml\n   CF_NAME apply-security-groups -f groups.yml --prune --force"`
+	relatedCommands interface{}                 `related_commands:"create-security-group, effective-security-rules, security-groups, update-security-group"`
+}
+
+func (cmd ApplySecurityGroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := securitygroupmanifest.Read(string(cmd.PathToFile))
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying security groups in {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"Path":     cmd.PathToFile,
+		"Username": user.Name,
+	})
+
+	changes, warnings, err := cmd.Actor.PlanSecurityGroups(securityGroupDefinitions(manifest), cmd.Prune)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("Security groups are up to date.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displaySecurityGroupChanges(changes)
+	cmd.UI.DisplayNewline()
+
+	if !cmd.Force {
+		apply, promptErr := cmd.UI.DisplayBoolPrompt(false, "Apply these changes?")
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !apply {
+			cmd.UI.DisplayText("Security groups have not been changed.")
+			return nil
+		}
+	}
+
+	warnings, err = cmd.Actor.ApplySecurityGroupChanges(changes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ApplySecurityGroupsCommand) displaySecurityGroupChanges(changes []v7action.SecurityGroupChange) {
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("security group"),
+			cmd.UI.TranslateText("change"),
+		},
+	}
+
+	for _, change := range changes {
+		switch change.Action {
+		case v7action.SecurityGroupCreated:
+			table = append(table, []string{"+", change.Name, cmd.UI.TranslateText("create with {{.Count}} rules", map[string]interface{}{"Count": len(change.Rules)})})
+		case v7action.SecurityGroupDeleted:
+			table = append(table, []string{"-", change.Name, cmd.UI.TranslateText("delete")})
+			continue
+		default:
+			if change.RulesChanged {
+				table = append(table, []string{"~", change.Name, cmd.UI.TranslateText("replace rules with {{.Count}} rules", map[string]interface{}{"Count": len(change.Rules)})})
+			}
+		}
+
+		for _, binding := range change.Bind {
+			table = append(table, []string{"+", change.Name, cmd.describeSecurityGroupBinding(false, binding)})
+		}
+		for _, binding := range change.Unbind {
+			table = append(table, []string{"-", change.Name, cmd.describeSecurityGroupBinding(true, binding)})
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd ApplySecurityGroupsCommand) describeSecurityGroupBinding(unbind bool, binding v7action.SecurityGroupBinding) string {
+	template := "bind {{.Lifecycle}} to org {{.Org}} / space {{.Space}}"
+	switch {
+	case binding.IsGlobal() && unbind:
+		template = "unbind {{.Lifecycle}} globally"
+	case binding.IsGlobal():
+		template = "bind {{.Lifecycle}} globally"
+	case unbind:
+		template = "unbind {{.Lifecycle}} from org {{.Org}} / space {{.Space}}"
+	}
+
+	return cmd.UI.TranslateText(template, map[string]interface{}{
+		"Lifecycle": binding.Lifecycle,
+		"Org":       binding.OrgName,
+		"Space":     binding.SpaceName,
+	})
+}
+
+func securityGroupDefinitions(manifest securitygroupmanifest.Manifest) []v7action.SecurityGroupDefinition {
+	var definitions []v7action.SecurityGroupDefinition
+	for _, group := range manifest.SecurityGroups {
+		definition := v7action.SecurityGroupDefinition{Name: group.Name}
+
+		for _, rule := range group.Rules {
+			definition.Rules = append(definition.Rules, securityGroupRule(rule))
+		}
+
+		if group.GloballyEnabled.Running {
+			definition.Bindings = append(definition.Bindings, v7action.SecurityGroupBinding{Lifecycle: constant.SecurityGroupLifecycleRunning})
+		}
+		if group.GloballyEnabled.Staging {
+			definition.Bindings = append(definition.Bindings, v7action.SecurityGroupBinding{Lifecycle: constant.SecurityGroupLifecycleStaging})
+		}
+		for _, space := range group.RunningSpaces {
+			definition.Bindings = append(definition.Bindings, v7action.SecurityGroupBinding{
+				Lifecycle: constant.SecurityGroupLifecycleRunning,
+				OrgName:   space.Org,
+				SpaceName: space.Space,
+			})
+		}
+		for _, space := range group.StagingSpaces {
+			definition.Bindings = append(definition.Bindings, v7action.SecurityGroupBinding{
+				Lifecycle: constant.SecurityGroupLifecycleStaging,
+				OrgName:   space.Org,
+				SpaceName: space.Space,
+			})
+		}
+
+		definitions = append(definitions, definition)
+	}
+	return definitions
+}
+
+func securityGroupRule(rule securitygroupmanifest.Rule) resources.Rule {
+	converted := resources.Rule{
+		Protocol:    rule.Protocol,
+		Destination: rule.Destination,
+		Type:        rule.Type,
+		Code:        rule.Code,
+		Log:         rule.Log,
+	}
+	if rule.Ports != "" {
+		ports := rule.Ports
+		converted.Ports = &ports
+	}
+	if rule.Description != "" {
+		description := rule.Description
+		converted.Description = &description
+	}
+	return converted
+}
@@ -0,0 +1,229 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/securitygroupmanifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-security-groups Command", func() {
+	var (
+		cmd                ApplySecurityGroupsCommand
+		testUI             *ui.UI
+		input              *Buffer
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v7fakes.FakeActor
+		binaryName         string
+		dir                string
+		groupsFile         string
+		groupsFileContents string
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		var err error
+		dir, err = ioutil.TempDir("", "apply-security-groups-test")
+		Expect(err).NotTo(HaveOccurred())
+		groupsFile = filepath.Join(dir, "groups.yml")
+		groupsFileContents = `security_groups:
+- name: public
+  rules:
+  - {protocol: tcp, destination: 0.0.0.0/0, ports: 443, description: https}
+  globally_enabled: {staging: true}
+  running_spaces:
+  - {org: some-org, space: some-space}
+`
+
+		cmd = ApplySecurityGroupsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			PathToFile: flag.PathWithExistenceCheck(groupsFile),
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(groupsFile, []byte(groupsFileContents), 0600)).To(Succeed())
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the file is invalid", func() {
+		BeforeEach(func() {
+			groupsFileContents = "security_groups:\n- rules: []\n"
+		})
+
+		It("returns the error without planning anything", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(securitygroupmanifest.InvalidManifestError{}))
+			Expect(fakeActor.PlanSecurityGroupsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the security groups are up to date", func() {
+		It("plans the declared groups and says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			definitions, prune := fakeActor.PlanSecurityGroupsArgsForCall(0)
+			Expect(prune).To(BeFalse())
+			ports, description := "443", "https"
+			Expect(definitions).To(Equal([]v7action.SecurityGroupDefinition{{
+				Name:  "public",
+				Rules: []resources.Rule{{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: &ports, Description: &description}},
+				Bindings: []v7action.SecurityGroupBinding{
+					{Lifecycle: constant.SecurityGroupLifecycleStaging},
+					{Lifecycle: constant.SecurityGroupLifecycleRunning, OrgName: "some-org", SpaceName: "some-space"},
+				},
+			}}))
+
+			Expect(testUI.Out).To(Say(`Applying security groups in .*groups\.yml as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Security groups are up to date\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplySecurityGroupChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are changes", func() {
+		var changes []v7action.SecurityGroupChange
+
+		BeforeEach(func() {
+			changes = []v7action.SecurityGroupChange{
+				{
+					Action:       v7action.SecurityGroupUpdated,
+					Name:         "public",
+					GUID:         "public-guid",
+					RulesChanged: true,
+					Rules:        []resources.Rule{{Protocol: "all", Destination: "10.0.0.0/8"}},
+					Bind:         []v7action.SecurityGroupBinding{{Lifecycle: constant.SecurityGroupLifecycleStaging}},
+					Unbind: []v7action.SecurityGroupBinding{
+						{Lifecycle: constant.SecurityGroupLifecycleRunning, OrgName: "some-org", SpaceName: "old-space", SpaceGUID: "old-space-guid"},
+					},
+				},
+				{Action: v7action.SecurityGroupDeleted, Name: "old", GUID: "old-guid"},
+			}
+			fakeActor.PlanSecurityGroupsReturns(changes, v7action.Warnings{"plan-warning"}, nil)
+			fakeActor.ApplySecurityGroupChangesReturns(v7action.Warnings{"apply-warning"}, nil)
+			cmd.Prune = true
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("displays and applies the changes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				_, prune := fakeActor.PlanSecurityGroupsArgsForCall(0)
+				Expect(prune).To(BeTrue())
+
+				Expect(testUI.Out).To(Say(`security group\s+change`))
+				Expect(testUI.Out).To(Say(`~\s+public\s+replace rules with 1 rules`))
+				Expect(testUI.Out).To(Say(`\+\s+public\s+bind staging globally`))
+				Expect(testUI.Out).To(Say(`-\s+public\s+unbind running from org some-org / space old-space`))
+				Expect(testUI.Out).To(Say(`-\s+old\s+delete`))
+				Expect(testUI.Out).To(Say(`Apply these changes\?`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("plan-warning"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+
+				Expect(fakeActor.ApplySecurityGroupChangesArgsForCall(0)).To(Equal(changes))
+			})
+		})
+
+		When("the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not apply the changes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Security groups have not been changed\.`))
+				Expect(fakeActor.ApplySecurityGroupChangesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--force is set", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("applies the changes without asking", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).NotTo(Say(`Apply these changes\?`))
+				Expect(fakeActor.ApplySecurityGroupChangesCallCount()).To(Equal(1))
+			})
+		})
+
+		When("applying the changes fails", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+				fakeActor.ApplySecurityGroupChangesReturns(v7action.Warnings{"apply-warning"}, errors.New("apply-error"))
+			})
+
+			It("displays warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError("apply-error"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+			})
+		})
+	})
+
+	When("planning fails", func() {
+		BeforeEach(func() {
+			fakeActor.PlanSecurityGroupsReturns(nil, v7action.Warnings{"plan-warning"}, errors.New("plan-error"))
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("plan-error"))
+			Expect(testUI.Err).To(Say("plan-warning"))
+		})
+	})
+})
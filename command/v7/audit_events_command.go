@@ -0,0 +1,199 @@
+package v7
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type AuditEventsCommand struct {
+	BaseCommand
+
+	Types               []string       `long:"type" description:"Only show events of this type (e.g. audit.app.update). Can be specified multiple times"`
+	TargetGUID          string         `long:"target-guid" description:"Only show events targeting the resource with this GUID"`
+	AppName             string         `long:"app" description:"Only show events targeting this app in the targeted space"`
+	ServiceInstanceName string         `long:"service" description:"Only show events targeting this service instance in the targeted space"`
+	Space               string         `long:"space" description:"Only show events in this space of the targeted org, or of the org given with --org"`
+	Org                 string         `long:"org" description:"Only show events in this org"`
+	ActorName           string         `long:"actor" description:"Only show events triggered by this user or client"`
+	Since               flag.Timestamp `long:"since" description:"Only show events after this time, given as an RFC3339 timestamp or a duration such as 2h"`
+	Until               flag.Timestamp `long:"until" description:"Only show events before this time, given as an RFC3339 timestamp or a duration such as 2h"`
+	JSON                bool           `long:"json" description:"Print the events as JSON"`
+	usage               interface{}    `usage:"CF_NAME audit-events [--type EVENT_TYPE]... [--target-guid GUID | --app APP_NAME | --service SERVICE_INSTANCE] [--space SPACE] [--org ORG] [--actor ACTOR] [--since TIME] [--until TIME] [--json]\n\nEXAMPLES:\n   CF_NAME audit-events --app my-app --since 24h\n   CF_NAME audit-events --org my-org --type audit.space.create --type audit.space.delete\n   CF_NAME audit-events --actor admin --since 2020-01-01T00:00:00Z --json"`
+	relatedCommands     interface{}    `related_commands:"events, app-crashes"`
+}
+
+type auditEventJSON struct {
+	GUID             string    `json:"guid"`
+	Time             time.Time `json:"created_at"`
+	Type             string    `json:"type"`
+	ActorName        string    `json:"actor"`
+	TargetGUID       string    `json:"target_guid"`
+	TargetType       string    `json:"target_type"`
+	TargetName       string    `json:"target_name"`
+	SpaceGUID        string    `json:"space_guid,omitempty"`
+	OrganizationGUID string    `json:"organization_guid,omitempty"`
+	Description      string    `json:"description"`
+}
+
+func (cmd AuditEventsCommand) Execute(args []string) error {
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	needsSpace := cmd.AppName != "" || cmd.ServiceInstanceName != ""
+	needsOrg := needsSpace || (cmd.Space != "" && cmd.Org == "")
+	err = cmd.SharedActor.CheckTarget(needsOrg, needsSpace)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.JSON {
+		cmd.UI.DisplayTextWithFlavor("Getting audit events as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	filter, err := cmd.buildFilter()
+	if err != nil {
+		return err
+	}
+
+	events, warnings, err := cmd.Actor.GetAuditEvents(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.JSON {
+		return cmd.displayJSON(events)
+	}
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No events found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("event"),
+			cmd.UI.TranslateText("actor"),
+			cmd.UI.TranslateText("target"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+
+	for _, event := range events {
+		table = append(table, []string{
+			event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.Type,
+			event.ActorName,
+			event.TargetName,
+			event.Description,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd AuditEventsCommand) validateFlags() error {
+	var targetFlags []string
+	if cmd.TargetGUID != "" {
+		targetFlags = append(targetFlags, "--target-guid")
+	}
+	if cmd.AppName != "" {
+		targetFlags = append(targetFlags, "--app")
+	}
+	if cmd.ServiceInstanceName != "" {
+		targetFlags = append(targetFlags, "--service")
+	}
+
+	if len(targetFlags) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: targetFlags}
+	}
+
+	return nil
+}
+
+func (cmd AuditEventsCommand) buildFilter() (v7action.EventFilter, error) {
+	filter := v7action.EventFilter{
+		Types:     cmd.Types,
+		ActorName: cmd.ActorName,
+		Since:     cmd.Since.Value,
+		Until:     cmd.Until.Value,
+	}
+
+	switch {
+	case cmd.TargetGUID != "":
+		filter.TargetGUIDs = []string{cmd.TargetGUID}
+	case cmd.AppName != "":
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v7action.EventFilter{}, err
+		}
+		filter.TargetGUIDs = []string{app.GUID}
+	case cmd.ServiceInstanceName != "":
+		serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(cmd.ServiceInstanceName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v7action.EventFilter{}, err
+		}
+		filter.TargetGUIDs = []string{serviceInstance.GUID}
+	}
+
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	if cmd.Org != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Org)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v7action.EventFilter{}, err
+		}
+		orgGUID = org.GUID
+		filter.OrganizationGUIDs = []string{org.GUID}
+	}
+
+	if cmd.Space != "" {
+		space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, orgGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v7action.EventFilter{}, err
+		}
+		filter.SpaceGUIDs = []string{space.GUID}
+	}
+
+	return filter, nil
+}
+
+func (cmd AuditEventsCommand) displayJSON(events []v7action.Event) error {
+	output := []auditEventJSON{}
+	for _, event := range events {
+		output = append(output, auditEventJSON{
+			GUID:             event.GUID,
+			Time:             event.Time,
+			Type:             event.Type,
+			ActorName:        event.ActorName,
+			TargetGUID:       event.TargetGUID,
+			TargetType:       event.TargetType,
+			TargetName:       event.TargetName,
+			SpaceGUID:        event.SpaceGUID,
+			OrganizationGUID: event.OrganizationGUID,
+			Description:      event.Description,
+		})
+	}
+
+	return cmd.UI.DisplayJSON("", output)
+}
@@ -0,0 +1,258 @@
+package v7_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("audit-events Command", func() {
+	var (
+		cmd             AuditEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = AuditEventsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetAuditEventsReturns(
+			[]v7action.Event{
+				{
+					GUID:       "event-guid-1",
+					Time:       time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+					Type:       "audit.app.update",
+					ActorName:  "admin",
+					TargetGUID: "app-guid",
+					TargetType: "app",
+					TargetName: "some-app",
+					SpaceGUID:  "some-space-guid",
+				},
+			},
+			v7action.Warnings{"events-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("more than one target flag is given", func() {
+		BeforeEach(func() {
+			cmd.TargetGUID = "some-guid"
+			cmd.AppName = "some-app"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--target-guid", "--app"},
+			}))
+			Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("no filters are given", func() {
+		It("lists all events", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.EventFilter{}))
+
+			Expect(testUI.Out).To(Say(`Getting audit events as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target\s+description`))
+			Expect(testUI.Out).To(Say(`audit\.app\.update\s+admin\s+some-app`))
+			Expect(testUI.Err).To(Say("events-warning"))
+		})
+	})
+
+	When("filtering by app, space, type, actor and time", func() {
+		BeforeEach(func() {
+			cmd.AppName = "some-app"
+			cmd.Space = "other-space"
+			cmd.Types = []string{"audit.app.update", "audit.app.restage"}
+			cmd.ActorName = "admin"
+			cmd.Since = flag.Timestamp{Value: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), IsSet: true}
+			cmd.Until = flag.Timestamp{Value: time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), IsSet: true}
+
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "app-guid"}, v7action.Warnings{"app-warning"}, nil)
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{GUID: "other-space-guid"}, v7action.Warnings{"space-warning"}, nil)
+		})
+
+		It("requires a targeted space", func() {
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+
+		It("resolves the names and passes the filter to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.EventFilter{
+				Types:       []string{"audit.app.update", "audit.app.restage"},
+				TargetGUIDs: []string{"app-guid"},
+				SpaceGUIDs:  []string{"other-space-guid"},
+				ActorName:   "admin",
+				Since:       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				Until:       time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+			}))
+
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("space-warning"))
+		})
+	})
+
+	When("filtering by service instance", func() {
+		BeforeEach(func() {
+			cmd.ServiceInstanceName = "some-service"
+			fakeActor.GetServiceInstanceByNameAndSpaceReturns(resources.ServiceInstance{GUID: "service-guid"}, nil, nil)
+		})
+
+		It("filters by the service instance GUID", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetAuditEventsArgsForCall(0).TargetGUIDs).To(Equal([]string{"service-guid"}))
+		})
+	})
+
+	When("filtering by org and space", func() {
+		BeforeEach(func() {
+			cmd.Org = "other-org"
+			cmd.Space = "other-space"
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{GUID: "other-org-guid"}, nil, nil)
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{GUID: "other-space-guid"}, nil, nil)
+		})
+
+		It("looks up the space in the given org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+
+			_, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(orgGUID).To(Equal("other-org-guid"))
+
+			filter := fakeActor.GetAuditEventsArgsForCall(0)
+			Expect(filter.OrganizationGUIDs).To(Equal([]string{"other-org-guid"}))
+			Expect(filter.SpaceGUIDs).To(Equal([]string{"other-space-guid"}))
+		})
+	})
+
+	When("the app cannot be found", func() {
+		BeforeEach(func() {
+			cmd.AppName = "some-app"
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAuditEventsReturns(nil, v7action.Warnings{"events-warning"}, errors.New("events-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("events-error"))
+			Expect(testUI.Err).To(Say("events-warning"))
+		})
+	})
+
+	When("there are no events", func() {
+		BeforeEach(func() {
+			fakeActor.GetAuditEventsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No events found."))
+		})
+	})
+
+	When("the --json flag is given", func() {
+		BeforeEach(func() {
+			cmd.JSON = true
+		})
+
+		It("prints only the events as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var output []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+			Expect(output).To(HaveLen(1))
+			Expect(output[0]).To(HaveKeyWithValue("guid", "event-guid-1"))
+			Expect(output[0]).To(HaveKeyWithValue("type", "audit.app.update"))
+			Expect(output[0]).To(HaveKeyWithValue("actor", "admin"))
+			Expect(output[0]).To(HaveKeyWithValue("target_guid", "app-guid"))
+			Expect(output[0]).To(HaveKeyWithValue("created_at", "2020-01-02T03:04:05Z"))
+			Expect(output[0]).ToNot(HaveKey("organization_guid"))
+
+			Expect(testUI.Err).To(Say("events-warning"))
+		})
+	})
+})
@@ -0,0 +1,124 @@
+package v7
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type BindingsCommand struct {
+	BaseCommand
+
+	AppName             string      `long:"app" description:"Only show the bindings of this app"`
+	ServiceInstanceName string      `long:"service" description:"Only show the bindings of this service instance"`
+	Type                string      `long:"type" choice:"app" choice:"key" choice:"route" description:"Only show bindings of this type: app, key or route"`
+	usage               interface{} `usage:"CF_NAME bindings [--app APP_NAME | --service SERVICE_INSTANCE] [--type app|key|route]\n\nEXAMPLES:\n   CF_NAME bindings\n   CF_NAME bindings --service mydb\n   CF_NAME bindings --type route"`
+	relatedCommands     interface{} `related_commands:"bind-route-service, bind-service, service-keys, services"`
+}
+
+func (cmd BindingsCommand) Execute(args []string) error {
+	if cmd.AppName != "" && cmd.ServiceInstanceName != "" {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--app", "--service"}}
+	}
+
+	if cmd.AppName != "" && cmd.Type != "" && cmd.Type != string(resources.AppBinding) {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--app", "--type " + cmd.Type}}
+	}
+
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting bindings in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	bindings, warnings, err := cmd.Actor.GetBindings(v7action.GetBindingsParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		AppName:             cmd.AppName,
+		ServiceInstanceName: cmd.ServiceInstanceName,
+		Type:                cmd.Type,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(bindings) == 0 {
+		cmd.UI.DisplayText("No bindings found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("service instance"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("bound to"),
+			cmd.UI.TranslateText("binding name"),
+			cmd.UI.TranslateText("last operation"),
+			cmd.UI.TranslateText("created"),
+		},
+	}
+
+	for _, binding := range bindings {
+		table = append(table, []string{
+			binding.ServiceInstanceName,
+			binding.Type,
+			boundTo(binding),
+			bindingName(binding),
+			bindingLastOperation(binding),
+			cmd.bindingCreatedAt(binding),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd BindingsCommand) bindingCreatedAt(binding v7action.Binding) string {
+	t, err := time.Parse(time.RFC3339, binding.CreatedAt)
+	if err != nil {
+		return binding.CreatedAt
+	}
+	return cmd.UI.UserFriendlyDate(t)
+}
+
+func boundTo(binding v7action.Binding) string {
+	switch binding.Type {
+	case string(resources.AppBinding):
+		return binding.AppName
+	case v7action.RouteBindingType:
+		return binding.RouteURL
+	default:
+		return binding.Name
+	}
+}
+
+// bindingName returns the name of an app binding. Keys are identified by
+// their name, so it is already shown as what the binding is bound to.
+func bindingName(binding v7action.Binding) string {
+	if binding.Type == string(resources.AppBinding) {
+		return binding.Name
+	}
+	return ""
+}
+
+func bindingLastOperation(binding v7action.Binding) string {
+	if binding.LastOperation.Type == "" || binding.LastOperation.State == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s %s", binding.LastOperation.Type, binding.LastOperation.State)
+}
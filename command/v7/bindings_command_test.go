@@ -0,0 +1,173 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("bindings Command", func() {
+	var (
+		cmd             v7.BindingsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.BindingsCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetBindingsReturns(
+			[]v7action.Binding{
+				{
+					Type:                "app",
+					Name:                "primary",
+					AppName:             "app-1",
+					ServiceInstanceName: "si-1",
+					LastOperation:       resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationSucceeded},
+					CreatedAt:           "2026-01-02T03:04:05Z",
+				},
+				{
+					Type:                "route",
+					RouteURL:            "app.example.com",
+					ServiceInstanceName: "si-1",
+					LastOperation:       resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationInProgress},
+				},
+				{
+					Type:                "key",
+					Name:                "key-1",
+					ServiceInstanceName: "si-2",
+				},
+			},
+			v7action.Warnings{"bindings warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in, and targeting an org and space", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		org, space := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(org).To(BeTrue())
+		Expect(space).To(BeTrue())
+	})
+
+	It("gets the bindings in the space", func() {
+		Expect(fakeActor.GetBindingsCallCount()).To(Equal(1))
+		Expect(fakeActor.GetBindingsArgsForCall(0)).To(Equal(v7action.GetBindingsParams{SpaceGUID: "some-space-guid"}))
+	})
+
+	It("displays the bindings and warnings", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(testUI.Out).To(SatisfyAll(
+			Say(`Getting bindings in org some-org / space some-space as steve\.\.\.\n\n`),
+			Say(`service instance\s+type\s+bound to\s+binding name\s+last operation\s+created\n`),
+			Say(`si-1\s+app\s+app-1\s+primary\s+create succeeded\s+Fri 02 Jan 03:04:05 UTC 2026\n`),
+			Say(`si-1\s+route\s+app.example.com\s+create in progress\s*\n`),
+			Say(`si-2\s+key\s+key-1\s*\n`),
+		))
+		Expect(testUI.Err).To(Say("bindings warning"))
+	})
+
+	When("filters are provided", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--service", "si-1")
+			setFlag(&cmd, "--type", "route")
+		})
+
+		It("passes them to the actor", func() {
+			Expect(fakeActor.GetBindingsArgsForCall(0)).To(Equal(v7action.GetBindingsParams{
+				SpaceGUID:           "some-space-guid",
+				ServiceInstanceName: "si-1",
+				Type:                "route",
+			}))
+		})
+	})
+
+	When("--app and --service are both provided", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--app", "app-1")
+			setFlag(&cmd, "--service", "si-1")
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--app", "--service"}}))
+			Expect(fakeActor.GetBindingsCallCount()).To(BeZero())
+		})
+	})
+
+	When("--app is combined with a non-app type", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--app", "app-1")
+			setFlag(&cmd, "--type", "key")
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--app", "--type key"}}))
+		})
+	})
+
+	When("there are no bindings", func() {
+		BeforeEach(func() {
+			fakeActor.GetBindingsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No bindings found\.`))
+		})
+	})
+
+	When("getting the bindings fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetBindingsReturns(nil, v7action.Warnings{"bindings warning"}, actionerror.ServiceInstanceNotFoundError{Name: "si-1"})
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "si-1"}))
+			Expect(testUI.Err).To(Say("bindings warning"))
+		})
+	})
+
+	When("getting the user fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("no user"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("no user"))
+		})
+	})
+})
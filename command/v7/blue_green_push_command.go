@@ -0,0 +1,660 @@
+package v7
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/randomword"
+)
+
+type BlueGreenPushCommand struct {
+	BaseCommand
+
+	RequiredArgs        flag.AppName                        `positional-args:"yes"`
+	PathToManifest      flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" required:"true" description:"Path to manifest"`
+	AppPath             flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	Vars                []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles    []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	SmokeTest           string                              `long:"smoke-test" description:"Command to run against the new version before it receives production traffic; the URL of its temporary route is in the APP_URL environment variable"`
+	SmokeTestPath       string                              `long:"smoke-test-path" description:"Path to request on the temporary route of the new version before it receives production traffic; the check passes on a 2xx response"`
+	SmokeTestTimeout    flag.Duration                       `long:"smoke-test-timeout" default:"1m" description:"How long to keep retrying the smoke test while the temporary route becomes reachable, e.g. 30s or 2m"`
+	KeepOld             bool                                `long:"keep-old" description:"Stop the previous version and keep it as APP_NAME-venerable instead of deleting it"`
+	usage               interface{}                         `usage:"CF_NAME blue-green-push APP_NAME -f MANIFEST_PATH [-p PATH] [--smoke-test COMMAND | --smoke-test-path PATH] [--smoke-test-timeout DURATION] [--keep-old]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n\n   Pushes the new version as APP_NAME-new, waits for it to become healthy and optionally smoke tests it on a temporary route.\n   It then moves the routes of APP_NAME to the new version and maps the routes in the manifest to it, renames the previous version to APP_NAME-venerable and deletes it.\n   When the app would otherwise have no routes, it gets a route named after APP_NAME on the default domain, as with push.\n   If APP_NAME does not exist yet, the new version is renamed to APP_NAME once its routes are mapped.\n   The smoke test is retried until it passes or --smoke-test-timeout has elapsed, because the router takes a few seconds to pick up the temporary route.\n   If the new version fails to start or the smoke test fails, the new version is deleted and APP_NAME keeps serving its routes.\n\nEXAMPLES:\n   CF_NAME blue-green-push myapp -f manifest.yml --smoke-test-path /health\n   CF_NAME blue-green-push myapp -f manifest.yml --smoke-test './smoke.sh \"$APP_URL\"' --keep-old"`
+	relatedCommands     interface{}                         `related_commands:"apps, map-route, push, rename, unmap-route"`
+	envCFStagingTimeout interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	LogCacheClient  sharedaction.LogCacheClient
+	PushActor       PushActor
+	VersionActor    V7ActorForPush
+	ProgressBar     ProgressBar
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+	Stager          shared.AppStager
+	SmokeTester     shared.SmokeTester
+	Sleep           func(time.Duration)
+}
+
+func (cmd *BlueGreenPushCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return err
+	}
+
+	cmd.ProgressBar = progressbar.NewProgressBar()
+	cmd.VersionActor = cmd.Actor
+	cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestParser = manifestparser.ManifestParser{}
+	cmd.Stager = shared.NewAppStager(cmd.Actor, cmd.UI, cmd.Config, cmd.LogCacheClient)
+	cmd.SmokeTester = shared.NewSmokeTester(cmd.UI, config.SkipSSLValidation())
+	cmd.Sleep = time.Sleep
+
+	return nil
+}
+
+func (cmd BlueGreenPushCommand) Execute(args []string) error {
+	if cmd.SmokeTest != "" && cmd.SmokeTestPath != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--smoke-test", "--smoke-test-path"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Blue-green pushing app {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	currentApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	currentAppExists := true
+	switch err.(type) {
+	case nil:
+	case actionerror.ApplicationNotFoundError:
+		currentAppExists = false
+	default:
+		return err
+	}
+
+	var routes []resources.Route
+	if currentAppExists {
+		routes, warnings, err = cmd.Actor.GetApplicationRoutes(currentApp.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	push := cmd.pushCommand()
+	defer func() {
+		if push.stopStreamingFunc != nil {
+			push.stopStreamingFunc()
+		}
+	}()
+
+	manifest, manifestApp, overrides, err := cmd.newAppManifest(push)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.pushNewApp(&push, manifest, overrides)
+	if err != nil {
+		return cmd.rollback(err)
+	}
+
+	newApp, err := cmd.startNewApp()
+	if err != nil {
+		return cmd.rollback(err)
+	}
+
+	if cmd.SmokeTest != "" || cmd.SmokeTestPath != "" {
+		err = cmd.smokeTest(newApp)
+		if err != nil {
+			return cmd.rollback(err)
+		}
+	}
+
+	err = cmd.mapRoutes(routes, currentApp, newApp)
+	if err != nil {
+		return cmd.rollback(err)
+	}
+
+	err = cmd.mapManifestRoutes(manifestApp, len(routes) > 0)
+	if err != nil {
+		return cmd.rollback(err)
+	}
+
+	if !currentAppExists {
+		return cmd.renameNewApp()
+	}
+
+	err = cmd.unmapRoutes(routes, currentApp)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.renameApps()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.retireOldApp(currentApp)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd BlueGreenPushCommand) newAppName() string {
+	return cmd.RequiredArgs.AppName + "-new"
+}
+
+func (cmd BlueGreenPushCommand) oldAppName() string {
+	return cmd.RequiredArgs.AppName + "-venerable"
+}
+
+// pushCommand returns a push command sharing this command's clients, used to
+// read the manifest and display the push progress.
+func (cmd BlueGreenPushCommand) pushCommand() PushCommand {
+	return PushCommand{
+		BaseCommand:     cmd.BaseCommand,
+		LogCacheClient:  cmd.LogCacheClient,
+		PushActor:       cmd.PushActor,
+		VersionActor:    cmd.VersionActor,
+		ProgressBar:     cmd.ProgressBar,
+		ManifestLocator: cmd.ManifestLocator,
+		ManifestParser:  cmd.ManifestParser,
+	}
+}
+
+// newAppManifest returns a manifest containing only the app's entry, renamed
+// to APP_NAME-new and without routes, along with the app's original entry.
+func (cmd BlueGreenPushCommand) newAppManifest(push PushCommand) (manifestparser.Manifest, manifestparser.Application, v7pushaction.FlagOverrides, error) {
+	var pathsToVarsFiles []string
+	for _, varFilePath := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	}
+
+	overrides := v7pushaction.FlagOverrides{
+		ManifestPath:     string(cmd.PathToManifest),
+		PathsToVarsFiles: pathsToVarsFiles,
+		Vars:             cmd.Vars,
+		ProvidedAppPath:  string(cmd.AppPath),
+		NoStart:          true,
+	}
+
+	manifest, err := push.GetBaseManifest(overrides)
+	if err != nil {
+		return manifestparser.Manifest{}, manifestparser.Application{}, overrides, err
+	}
+
+	manifestApp, err := manifest.GetAppByName(cmd.RequiredArgs.AppName)
+	if err != nil {
+		return manifestparser.Manifest{}, manifestparser.Application{}, overrides, translatableerror.AppNotFoundInManifestError{Name: cmd.RequiredArgs.AppName}
+	}
+	app := manifestApp
+	app.Name = cmd.newAppName()
+	app.Routes = nil
+	app.RandomRoute = false
+	app.DefaultRoute = false
+	app.NoRoute = true
+	manifest.Applications = []manifestparser.Application{app}
+
+	manifest, err = cmd.PushActor.HandleFlagOverrides(manifest, overrides)
+	return manifest, manifestApp, overrides, err
+}
+
+// pushNewApp creates APP_NAME-new and uploads its bits without starting it.
+func (cmd BlueGreenPushCommand) pushNewApp(push *PushCommand, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) error {
+	rawManifest, err := cmd.ManifestParser.MarshalManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Pushing new version as app {{.AppName}}...", map[string]interface{}{
+		"AppName": cmd.newAppName(),
+	})
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	warnings, err := cmd.VersionActor.SetSpaceManifest(spaceGUID, rawManifest)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	plans, warnings, err := cmd.PushActor.CreatePushPlans(spaceGUID, cmd.Config.TargetedOrganization().GUID, manifest, overrides)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, plan := range plans {
+		err = push.eventStreamHandler(cmd.PushActor.Actualize(plan, cmd.ProgressBar))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startNewApp stages and starts APP_NAME-new and waits until it is healthy.
+func (cmd BlueGreenPushCommand) startNewApp() (resources.Application, error) {
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.newAppName(), cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return resources.Application{}, err
+	}
+
+	pkg, warnings, err := cmd.Actor.GetNewestReadyPackageForApplication(app)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return resources.Application{}, mapErr(cmd.Config, app.Name, err)
+	}
+
+	err = cmd.Stager.StageAndStart(
+		app,
+		cmd.Config.TargetedSpace(),
+		cmd.Config.TargetedOrganization(),
+		pkg.GUID,
+		constant.DeploymentStrategyDefault,
+		false,
+		constant.ApplicationStarting,
+	)
+	if err != nil {
+		return resources.Application{}, mapErr(cmd.Config, app.Name, err)
+	}
+
+	return app, nil
+}
+
+var invalidHostnameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+const smokeTestRetryInterval = 5 * time.Second
+
+// smokeTest maps a temporary route on the default domain to the new app and
+// runs the smoke test against it. The temporary route is always deleted.
+func (cmd BlueGreenPushCommand) smokeTest(app resources.Application) error {
+	domain, warnings, err := cmd.Actor.GetDefaultDomain(cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	hostname := routeHostname(app.Name)
+	route, warnings, err := cmd.Actor.CreateRoute(cmd.Config.TargetedSpace().GUID, domain.Name, hostname, "", 0, nil)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		warnings, err := cmd.Actor.DeleteRoute(domain.Name, hostname, "", 0)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning("Unable to delete temporary route {{.URL}}: {{.Error}}", map[string]interface{}{
+				"URL":   route.URL,
+				"Error": err.Error(),
+			})
+		}
+	}()
+
+	warnings, err = cmd.Actor.MapRoute(route.GUID, app.GUID, "")
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	appURL := cmd.appURLScheme() + "://" + hostname + "." + domain.Name
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Smoke testing app {{.AppName}} on {{.URL}}...", map[string]interface{}{
+		"AppName": app.Name,
+		"URL":     appURL,
+	})
+
+	err = cmd.runSmokeTest(appURL)
+	if err != nil {
+		return translatableerror.SmokeTestFailedError{AppName: cmd.RequiredArgs.AppName, Reason: err.Error()}
+	}
+
+	cmd.UI.DisplayText("Smoke test passed.")
+	return nil
+}
+
+// runSmokeTest runs the smoke test until it passes, retrying while the
+// router has not picked up the temporary route yet. It gives up once it has
+// waited --smoke-test-timeout between attempts.
+func (cmd BlueGreenPushCommand) runSmokeTest(appURL string) error {
+	var waited time.Duration
+	for {
+		var err error
+		if cmd.SmokeTest != "" {
+			err = cmd.SmokeTester.RunCommand(cmd.SmokeTest, appURL)
+		} else {
+			err = cmd.SmokeTester.CheckURL(appURL + "/" + strings.TrimPrefix(cmd.SmokeTestPath, "/"))
+		}
+		if err == nil || waited+smokeTestRetryInterval > cmd.SmokeTestTimeout.Value {
+			return err
+		}
+
+		cmd.UI.DisplayText("Smoke test failed: {{.Error}}. Retrying in {{.Interval}}...", map[string]interface{}{
+			"Error":    err.Error(),
+			"Interval": smokeTestRetryInterval.String(),
+		})
+		cmd.Sleep(smokeTestRetryInterval)
+		waited += smokeTestRetryInterval
+	}
+}
+
+// appURLScheme returns the scheme of the API the CLI targets, so that the
+// smoke test reaches apps over plain HTTP on foundations that do not use TLS.
+func (cmd BlueGreenPushCommand) appURLScheme() string {
+	target, err := url.Parse(cmd.Config.Target())
+	if err != nil || target.Scheme != "http" {
+		return "https"
+	}
+	return "http"
+}
+
+// mapRoutes gives the new app a copy of every destination the current app
+// has on its routes, with the same process type, port and protocol. Weights
+// are not copied because the weights of a route must add up to 100.
+func (cmd BlueGreenPushCommand) mapRoutes(routes []resources.Route, currentApp resources.Application, newApp resources.Application) error {
+	for _, route := range routes {
+		var destinations []resources.RouteDestination
+		for _, destination := range appDestinations(route, currentApp.GUID) {
+			destinations = append(destinations, resources.RouteDestination{
+				App:      resources.RouteDestinationApp{GUID: newApp.GUID, Process: destination.App.Process},
+				Port:     destination.Port,
+				Protocol: destination.Protocol,
+			})
+		}
+		if len(destinations) == 0 {
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithFlavor("Mapping route {{.URL}} to app {{.AppName}}...", map[string]interface{}{
+			"URL":     route.URL,
+			"AppName": newApp.Name,
+		})
+
+		warnings, err := cmd.Actor.AddRouteDestinations(route.GUID, destinations)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mapManifestRoutes maps the routes declared in the app's manifest entry to
+// the new app. They are left out of the push so that the new app receives no
+// production traffic before it is healthy and smoke tested. As with push, an
+// app that would otherwise have no routes gets a route on the default domain,
+// or a random one when random-route is set. That route is named after
+// APP_NAME, not after the temporary name of the new app.
+func (cmd BlueGreenPushCommand) mapManifestRoutes(manifestApp manifestparser.Application, hasRoutes bool) error {
+	if manifestApp.NoRoute {
+		return nil
+	}
+
+	routes := manifestApp.Routes
+	if len(routes) == 0 {
+		if hasRoutes {
+			return nil
+		}
+
+		route, err := cmd.defaultRoute(manifestApp.RandomRoute)
+		if err != nil {
+			return err
+		}
+		routes = []manifestparser.Route{route}
+	}
+
+	rawManifest, err := cmd.ManifestParser.MarshalManifest(manifestparser.Manifest{
+		Applications: []manifestparser.Application{{
+			Name:   cmd.newAppName(),
+			Routes: routes,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Mapping routes from the manifest to app {{.AppName}}...", map[string]interface{}{
+		"AppName": cmd.newAppName(),
+	})
+
+	warnings, err := cmd.VersionActor.SetSpaceManifest(cmd.Config.TargetedSpace().GUID, rawManifest)
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
+// defaultRoute returns the route push would give APP_NAME on the default
+// domain, with a random suffix added to the host when random is set.
+func (cmd BlueGreenPushCommand) defaultRoute(random bool) (manifestparser.Route, error) {
+	domain, warnings, err := cmd.Actor.GetDefaultDomain(cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return manifestparser.Route{}, err
+	}
+
+	hostname := routeHostname(cmd.RequiredArgs.AppName)
+	if random {
+		hostname += "-" + randomword.NewGenerator().Babble()
+	}
+
+	return manifestparser.Route{Route: hostname + "." + domain.Name}, nil
+}
+
+// routeHostname turns an app name into a valid route host.
+func routeHostname(appName string) string {
+	return invalidHostnameChars.ReplaceAllString(strings.ToLower(appName), "-")
+}
+
+func (cmd BlueGreenPushCommand) unmapRoutes(routes []resources.Route, currentApp resources.Application) error {
+	for _, route := range routes {
+		destinations := appDestinations(route, currentApp.GUID)
+		if len(destinations) == 0 {
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithFlavor("Unmapping route {{.URL}} from app {{.AppName}}...", map[string]interface{}{
+			"URL":     route.URL,
+			"AppName": currentApp.Name,
+		})
+
+		for _, destination := range destinations {
+			warnings, err := cmd.Actor.UnmapRoute(route.GUID, destination.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// appDestinations returns the destinations of the route that send traffic to
+// the app, one per process type and port.
+func appDestinations(route resources.Route, appGUID string) []resources.RouteDestination {
+	var destinations []resources.RouteDestination
+	for _, destination := range route.Destinations {
+		if destination.App.GUID == appGUID {
+			destinations = append(destinations, destination)
+		}
+	}
+	return destinations
+}
+
+// renameApps renames the current app to APP_NAME-venerable, replacing one
+// kept by an earlier --keep-old run, and the new app to APP_NAME. If a rename
+// fails, the apps already renamed get their previous names back.
+func (cmd BlueGreenPushCommand) renameApps() error {
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	_, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.oldAppName(), spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+		err = cmd.deleteApp(cmd.oldAppName())
+		if err != nil {
+			return err
+		}
+	case actionerror.ApplicationNotFoundError:
+	default:
+		return err
+	}
+
+	renames := [][2]string{
+		{cmd.RequiredArgs.AppName, cmd.oldAppName()},
+		{cmd.newAppName(), cmd.RequiredArgs.AppName},
+	}
+	for i, rename := range renames {
+		err = cmd.renameApp(rename[0], rename[1])
+		if err != nil {
+			cmd.undoRenames(renames[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+// renameNewApp renames the new app to APP_NAME when there is no current app
+// to replace.
+func (cmd BlueGreenPushCommand) renameNewApp() error {
+	err := cmd.renameApp(cmd.newAppName(), cmd.RequiredArgs.AppName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd BlueGreenPushCommand) renameApp(oldName string, newName string) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Renaming app {{.OldName}} to {{.NewName}}...", map[string]interface{}{
+		"OldName": oldName,
+		"NewName": newName,
+	})
+
+	_, warnings, err := cmd.Actor.RenameApplicationByNameAndSpaceGUID(oldName, newName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
+// undoRenames reverts the renames, last one first. Failures are only reported
+// so that the error that caused the undo is returned.
+func (cmd BlueGreenPushCommand) undoRenames(renames [][2]string) {
+	for i := len(renames) - 1; i >= 0; i-- {
+		oldName, newName := renames[i][0], renames[i][1]
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayWarning("Rolling back: renaming app {{.NewName}} back to {{.OldName}}...", map[string]interface{}{
+			"OldName": oldName,
+			"NewName": newName,
+		})
+
+		_, warnings, err := cmd.Actor.RenameApplicationByNameAndSpaceGUID(newName, oldName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning("Unable to rename app {{.NewName}} back to {{.OldName}}: {{.Error}}", map[string]interface{}{
+				"OldName": oldName,
+				"NewName": newName,
+				"Error":   err.Error(),
+			})
+		}
+	}
+}
+
+func (cmd BlueGreenPushCommand) retireOldApp(oldApp resources.Application) error {
+	if !cmd.KeepOld {
+		return cmd.deleteApp(cmd.oldAppName())
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}}...", map[string]interface{}{
+		"AppName": cmd.oldAppName(),
+	})
+
+	warnings, err := cmd.Actor.StopApplication(oldApp.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
+func (cmd BlueGreenPushCommand) deleteApp(appName string) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Deleting app {{.AppName}}...", map[string]interface{}{
+		"AppName": appName,
+	})
+
+	warnings, err := cmd.Actor.DeleteApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, false)
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
+// rollback deletes the new app so that the current app keeps serving its
+// routes, and returns the error that caused the rollback.
+func (cmd BlueGreenPushCommand) rollback(cause error) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayWarning("Rolling back: deleting app {{.AppName}}...", map[string]interface{}{
+		"AppName": cmd.newAppName(),
+	})
+
+	warnings, err := cmd.Actor.DeleteApplicationByNameAndSpace(cmd.newAppName(), cmd.Config.TargetedSpace().GUID, false)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, notFound := err.(actionerror.ApplicationNotFoundError); !notFound {
+			cmd.UI.DisplayWarning("Unable to delete app {{.AppName}}: {{.Error}}", map[string]interface{}{
+				"AppName": cmd.newAppName(),
+				"Error":   err.Error(),
+			})
+		}
+	}
+
+	return cause
+}
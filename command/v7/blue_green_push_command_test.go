@@ -0,0 +1,629 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("blue-green-push Command", func() {
+	var (
+		cmd                 v7.BlueGreenPushCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakePushActor       *v7fakes.FakePushActor
+		fakeManifestLocator *v7fakes.FakeManifestLocator
+		fakeManifestParser  *v7fakes.FakeManifestParser
+		fakeStager          *sharedfakes.FakeAppStager
+		fakeSmokeTester     *sharedfakes.FakeSmokeTester
+		productionRoute     resources.Route
+		sleeps              []time.Duration
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakePushActor = new(v7fakes.FakePushActor)
+		fakeManifestLocator = new(v7fakes.FakeManifestLocator)
+		fakeManifestParser = new(v7fakes.FakeManifestParser)
+		fakeStager = new(sharedfakes.FakeAppStager)
+		fakeSmokeTester = new(sharedfakes.FakeSmokeTester)
+
+		cmd = v7.BlueGreenPushCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			PushActor:       fakePushActor,
+			VersionActor:    fakeActor,
+			ProgressBar:     new(v7fakes.FakeProgressBar),
+			ManifestLocator: fakeManifestLocator,
+			ManifestParser:  fakeManifestParser,
+			Stager:          fakeStager,
+			SmokeTester:     fakeSmokeTester,
+			Sleep: func(duration time.Duration) {
+				sleeps = append(sleeps, duration)
+			},
+		}
+		cmd.RequiredArgs.AppName = "myapp"
+		sleeps = nil
+		cmd.PathToManifest = "manifest.yml"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "space-guid"})
+		fakeConfig.BinaryNameReturns("cf")
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (resources.Application, v7action.Warnings, error) {
+			switch name {
+			case "myapp":
+				return resources.Application{Name: "myapp", GUID: "current-guid"}, v7action.Warnings{"get-app-warning"}, nil
+			case "myapp-new":
+				return resources.Application{Name: "myapp-new", GUID: "new-guid"}, nil, nil
+			default:
+				return resources.Application{}, nil, actionerror.ApplicationNotFoundError{Name: name}
+			}
+		}
+
+		productionRoute = resources.Route{
+			GUID: "route-guid",
+			URL:  "myapp.example.com",
+			Destinations: []resources.RouteDestination{
+				{GUID: "destination-guid", App: resources.RouteDestinationApp{GUID: "current-guid", Process: struct{ Type string }{Type: "web"}}, Protocol: "http2"},
+				{GUID: "worker-destination-guid", App: resources.RouteDestinationApp{GUID: "current-guid", Process: struct{ Type string }{Type: "worker"}}, Port: 9090},
+				{GUID: "other-app-destination-guid", App: resources.RouteDestinationApp{GUID: "other-app-guid"}},
+			},
+		}
+		fakeActor.GetApplicationRoutesReturns([]resources.Route{productionRoute}, v7action.Warnings{"routes-warning"}, nil)
+
+		fakeManifestLocator.PathReturns("manifest.yml", true, nil)
+		fakeManifestParser.InterpolateManifestReturns([]byte("raw"), nil)
+		fakeManifestParser.ParseManifestReturns(manifestparser.Manifest{
+			Applications: []manifestparser.Application{
+				{Name: "other-app"},
+				{Name: "myapp", Path: "app-dir", Routes: []manifestparser.Route{{Route: "myapp.example.com"}}},
+			},
+		}, nil)
+		fakeManifestParser.MarshalManifestReturns([]byte("marshalled"), nil)
+		fakePushActor.HandleFlagOverridesStub = func(manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) (manifestparser.Manifest, error) {
+			return manifest, nil
+		}
+		fakePushActor.CreatePushPlansReturns([]v7pushaction.PushPlan{{Application: resources.Application{Name: "myapp-new"}}}, nil, nil)
+		fakePushActor.ActualizeStub = func(plan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+			events := make(chan *v7pushaction.PushEvent, 1)
+			events <- &v7pushaction.PushEvent{Plan: plan, Event: v7pushaction.ApplyManifestComplete, Warnings: v7pushaction.Warnings{"actualize-warning"}}
+			close(events)
+			return events
+		}
+
+		fakeActor.GetNewestReadyPackageForApplicationReturns(resources.Package{GUID: "package-guid"}, nil, nil)
+		fakeActor.GetDefaultDomainReturns(resources.Domain{Name: "apps.example.com"}, nil, nil)
+		fakeActor.CreateRouteReturns(resources.Route{GUID: "temp-route-guid", URL: "myapp-new.apps.example.com"}, nil, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the target", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("pushes the new version without routes and without starting it", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeManifestLocator.PathArgsForCall(0)).To(Equal("manifest.yml"))
+
+		Expect(fakePushActor.HandleFlagOverridesCallCount()).To(Equal(1))
+		manifest, overrides := fakePushActor.HandleFlagOverridesArgsForCall(0)
+		Expect(manifest.Applications).To(Equal([]manifestparser.Application{
+			{Name: "myapp-new", Path: "app-dir", NoRoute: true},
+		}))
+		Expect(overrides.NoStart).To(BeTrue())
+
+		spaceGUID, rawManifest := fakeActor.SetSpaceManifestArgsForCall(0)
+		Expect(spaceGUID).To(Equal("space-guid"))
+		Expect(rawManifest).To(Equal([]byte("marshalled")))
+
+		Expect(fakePushActor.ActualizeCallCount()).To(Equal(1))
+		Expect(testUI.Out).To(Say(`Blue-green pushing app myapp to org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`Pushing new version as app myapp-new\.\.\.`))
+		Expect(testUI.Out).To(Say(`Manifest applied`))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("routes-warning"))
+		Expect(testUI.Err).To(Say("actualize-warning"))
+	})
+
+	It("waits for the new version to become healthy", func() {
+		Expect(fakeStager.StageAndStartCallCount()).To(Equal(1))
+		app, space, org, packageGUID, strategy, noWait, action := fakeStager.StageAndStartArgsForCall(0)
+		Expect(app.GUID).To(Equal("new-guid"))
+		Expect(space.GUID).To(Equal("space-guid"))
+		Expect(org.GUID).To(Equal("org-guid"))
+		Expect(packageGUID).To(Equal("package-guid"))
+		Expect(strategy).To(Equal(constant.DeploymentStrategyDefault))
+		Expect(noWait).To(BeFalse())
+		Expect(action).To(Equal(constant.ApplicationStarting))
+	})
+
+	It("moves the production routes to the new version, then replaces the current version", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.AddRouteDestinationsCallCount()).To(Equal(1))
+		routeGUID, destinations := fakeActor.AddRouteDestinationsArgsForCall(0)
+		Expect(routeGUID).To(Equal("route-guid"))
+		Expect(destinations).To(Equal([]resources.RouteDestination{
+			{App: resources.RouteDestinationApp{GUID: "new-guid", Process: struct{ Type string }{Type: "web"}}, Protocol: "http2"},
+			{App: resources.RouteDestinationApp{GUID: "new-guid", Process: struct{ Type string }{Type: "worker"}}, Port: 9090},
+		}))
+
+		Expect(fakeActor.UnmapRouteCallCount()).To(Equal(2))
+		routeGUID, destinationGUID := fakeActor.UnmapRouteArgsForCall(0)
+		Expect(routeGUID).To(Equal("route-guid"))
+		Expect(destinationGUID).To(Equal("destination-guid"))
+		_, destinationGUID = fakeActor.UnmapRouteArgsForCall(1)
+		Expect(destinationGUID).To(Equal("worker-destination-guid"))
+
+		Expect(fakeActor.RenameApplicationByNameAndSpaceGUIDCallCount()).To(Equal(2))
+		oldName, newName, _ := fakeActor.RenameApplicationByNameAndSpaceGUIDArgsForCall(0)
+		Expect([]string{oldName, newName}).To(Equal([]string{"myapp", "myapp-venerable"}))
+		oldName, newName, _ = fakeActor.RenameApplicationByNameAndSpaceGUIDArgsForCall(1)
+		Expect([]string{oldName, newName}).To(Equal([]string{"myapp-new", "myapp"}))
+
+		Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID, deleteRoutes := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("myapp-venerable"))
+		Expect(spaceGUID).To(Equal("space-guid"))
+		Expect(deleteRoutes).To(BeFalse())
+
+		Expect(fakeActor.CreateRouteCallCount()).To(Equal(0))
+		Expect(fakeSmokeTester.CheckURLCallCount()).To(Equal(0))
+
+		Expect(testUI.Out).To(Say(`Mapping route myapp\.example\.com to app myapp-new\.\.\.`))
+		Expect(testUI.Out).To(Say(`Mapping routes from the manifest to app myapp-new\.\.\.`))
+		Expect(testUI.Out).To(Say(`Unmapping route myapp\.example\.com from app myapp\.\.\.`))
+		Expect(testUI.Out).To(Say(`Renaming app myapp to myapp-venerable\.\.\.`))
+		Expect(testUI.Out).To(Say(`Renaming app myapp-new to myapp\.\.\.`))
+		Expect(testUI.Out).To(Say(`Deleting app myapp-venerable\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	It("maps the routes in the manifest to the new version once it has taken over the production routes", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeManifestParser.MarshalManifestCallCount()).To(Equal(2))
+		Expect(fakeManifestParser.MarshalManifestArgsForCall(1)).To(Equal(manifestparser.Manifest{
+			Applications: []manifestparser.Application{
+				{Name: "myapp-new", Routes: []manifestparser.Route{{Route: "myapp.example.com"}}},
+			},
+		}))
+
+		Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(2))
+		spaceGUID, rawManifest := fakeActor.SetSpaceManifestArgsForCall(1)
+		Expect(spaceGUID).To(Equal("space-guid"))
+		Expect(rawManifest).To(Equal([]byte("marshalled")))
+	})
+
+	When("the manifest has no routes for the app", func() {
+		BeforeEach(func() {
+			fakeManifestParser.ParseManifestReturns(manifestparser.Manifest{
+				Applications: []manifestparser.Application{{Name: "myapp", Path: "app-dir"}},
+			}, nil)
+		})
+
+		It("only moves the production routes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(1))
+			Expect(fakeActor.AddRouteDestinationsCallCount()).To(Equal(1))
+		})
+
+		When("random-route is set", func() {
+			BeforeEach(func() {
+				fakeManifestParser.ParseManifestReturns(manifestparser.Manifest{
+					Applications: []manifestparser.Application{{Name: "myapp", Path: "app-dir", RandomRoute: true}},
+				}, nil)
+			})
+
+			It("does not add a route, because the new version already has the production routes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	When("mapping the routes in the manifest fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetSpaceManifestReturnsOnCall(1, v7action.Warnings{"manifest-warning"}, errors.New("route is taken"))
+		})
+
+		It("rolls back", func() {
+			Expect(executeErr).To(MatchError("route is taken"))
+			Expect(testUI.Err).To(Say("manifest-warning"))
+			appName, _, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("myapp-new"))
+			Expect(fakeActor.UnmapRouteCallCount()).To(Equal(0))
+			Expect(fakeActor.RenameApplicationByNameAndSpaceGUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the app is not in the manifest", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = "missing"
+			fakeActor.GetApplicationByNameAndSpaceStub = nil
+		})
+
+		It("returns an error without pushing anything", func() {
+			Expect(executeErr).To(MatchError(translatableerror.AppNotFoundInManifestError{Name: "missing"}))
+			Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+			Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("both smoke test flags are given", func() {
+		BeforeEach(func() {
+			cmd.SmokeTest = "./smoke.sh"
+			cmd.SmokeTestPath = "/health"
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--smoke-test", "--smoke-test-path"},
+			}))
+		})
+	})
+
+	When("the new version fails to start", func() {
+		BeforeEach(func() {
+			fakeStager.StageAndStartReturns(actionerror.AllInstancesCrashedError{})
+		})
+
+		It("deletes the new version and keeps the routes on the current version", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationUnableToStartError{AppName: "myapp-new", BinaryName: "cf"}))
+
+			Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, _, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("myapp-new"))
+
+			Expect(fakeActor.MapRouteCallCount()).To(Equal(0))
+			Expect(fakeActor.RenameApplicationByNameAndSpaceGUIDCallCount()).To(Equal(0))
+			Expect(testUI.Err).To(Say(`Rolling back: deleting app myapp-new\.\.\.`))
+		})
+	})
+
+	When("a smoke test path is given", func() {
+		BeforeEach(func() {
+			cmd.SmokeTestPath = "health"
+		})
+
+		It("checks the path on a temporary route, then deletes the route", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
+			spaceGUID, domainName, hostname, path, port, _ := fakeActor.CreateRouteArgsForCall(0)
+			Expect(spaceGUID).To(Equal("space-guid"))
+			Expect(domainName).To(Equal("apps.example.com"))
+			Expect(hostname).To(Equal("myapp-new"))
+			Expect(path).To(BeEmpty())
+			Expect(port).To(Equal(0))
+
+			routeGUID, appGUID, _ := fakeActor.MapRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("temp-route-guid"))
+			Expect(appGUID).To(Equal("new-guid"))
+
+			Expect(fakeSmokeTester.CheckURLArgsForCall(0)).To(Equal("https://myapp-new.apps.example.com/health"))
+
+			Expect(fakeActor.DeleteRouteCallCount()).To(Equal(1))
+			domainName, hostname, _, _ = fakeActor.DeleteRouteArgsForCall(0)
+			Expect(domainName).To(Equal("apps.example.com"))
+			Expect(hostname).To(Equal("myapp-new"))
+
+			Expect(testUI.Out).To(Say(`Smoke testing app myapp-new on https://myapp-new\.apps\.example\.com\.\.\.`))
+			Expect(testUI.Out).To(Say(`Smoke test passed\.`))
+			Expect(testUI.Out).To(Say(`Mapping route myapp\.example\.com to app myapp-new\.\.\.`))
+		})
+
+		When("the temporary route is not reachable yet", func() {
+			BeforeEach(func() {
+				cmd.SmokeTestTimeout.Value = time.Minute
+				fakeSmokeTester.CheckURLReturnsOnCall(0, errors.New("returned status 404"))
+				fakeSmokeTester.CheckURLReturnsOnCall(1, errors.New("returned status 404"))
+				fakeSmokeTester.CheckURLReturnsOnCall(2, nil)
+			})
+
+			It("retries the check until it passes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeSmokeTester.CheckURLCallCount()).To(Equal(3))
+				Expect(sleeps).To(Equal([]time.Duration{5 * time.Second, 5 * time.Second}))
+				Expect(testUI.Out).To(Say(`Smoke test failed: returned status 404\. Retrying in 5s\.\.\.`))
+				Expect(testUI.Out).To(Say(`Smoke test passed\.`))
+				appName, _, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("myapp-venerable"))
+			})
+		})
+
+		When("the check keeps failing until the timeout", func() {
+			BeforeEach(func() {
+				cmd.SmokeTestTimeout.Value = 12 * time.Second
+				fakeSmokeTester.CheckURLReturns(errors.New("returned status 404"))
+			})
+
+			It("gives up and rolls back", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SmokeTestFailedError{AppName: "myapp", Reason: "returned status 404"}))
+				Expect(fakeSmokeTester.CheckURLCallCount()).To(Equal(3))
+				Expect(sleeps).To(HaveLen(2))
+				appName, _, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("myapp-new"))
+			})
+		})
+
+		When("the API is targeted over plain HTTP", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetReturns("http://api.example.com")
+			})
+
+			It("checks the path over plain HTTP", func() {
+				Expect(fakeSmokeTester.CheckURLArgsForCall(0)).To(Equal("http://myapp-new.apps.example.com/health"))
+			})
+		})
+
+		When("the check fails", func() {
+			BeforeEach(func() {
+				fakeSmokeTester.CheckURLReturns(errors.New("returned status 500"))
+			})
+
+			It("deletes the temporary route and the new version", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SmokeTestFailedError{AppName: "myapp", Reason: "returned status 500"}))
+
+				Expect(fakeActor.DeleteRouteCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, _, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("myapp-new"))
+
+				Expect(fakeActor.MapRouteCallCount()).To(Equal(1))
+				Expect(fakeActor.UnmapRouteCallCount()).To(Equal(0))
+				Expect(fakeActor.RenameApplicationByNameAndSpaceGUIDCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("a smoke test command is given", func() {
+		BeforeEach(func() {
+			cmd.SmokeTest = "./smoke.sh"
+		})
+
+		It("runs the command against the temporary route", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeSmokeTester.RunCommandCallCount()).To(Equal(1))
+			command, appURL := fakeSmokeTester.RunCommandArgsForCall(0)
+			Expect(command).To(Equal("./smoke.sh"))
+			Expect(appURL).To(Equal("https://myapp-new.apps.example.com"))
+		})
+	})
+
+	When("--keep-old is given", func() {
+		BeforeEach(func() {
+			cmd.KeepOld = true
+		})
+
+		It("stops the previous version instead of deleting it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.StopApplicationArgsForCall(0)).To(Equal("current-guid"))
+			Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say(`Stopping app myapp-venerable\.\.\.`))
+		})
+	})
+
+	When("a previous version is still kept", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (resources.Application, v7action.Warnings, error) {
+				return resources.Application{Name: name, GUID: name + "-guid"}, nil, nil
+			}
+			cmd.KeepOld = true
+		})
+
+		It("deletes it before renaming the current version", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, _, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("myapp-venerable"))
+			Expect(testUI.Out).To(Say(`Deleting app myapp-venerable\.\.\.`))
+			Expect(testUI.Out).To(Say(`Renaming app myapp to myapp-venerable\.\.\.`))
+		})
+	})
+
+	When("mapping a production route fails", func() {
+		BeforeEach(func() {
+			fakeActor.AddRouteDestinationsReturns(nil, errors.New("map failed"))
+		})
+
+		It("rolls back", func() {
+			Expect(executeErr).To(MatchError("map failed"))
+			appName, _, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("myapp-new"))
+			Expect(fakeActor.UnmapRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("renaming the new version fails", func() {
+		BeforeEach(func() {
+			fakeActor.RenameApplicationByNameAndSpaceGUIDReturnsOnCall(1, resources.Application{}, nil, errors.New("rename failed"))
+		})
+
+		It("renames the current version back", func() {
+			Expect(executeErr).To(MatchError("rename failed"))
+
+			Expect(fakeActor.RenameApplicationByNameAndSpaceGUIDCallCount()).To(Equal(3))
+			oldName, newName, _ := fakeActor.RenameApplicationByNameAndSpaceGUIDArgsForCall(2)
+			Expect([]string{oldName, newName}).To(Equal([]string{"myapp-venerable", "myapp"}))
+
+			Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(testUI.Err).To(Say(`Rolling back: renaming app myapp-venerable back to myapp\.\.\.`))
+		})
+
+		When("renaming the current version back fails too", func() {
+			BeforeEach(func() {
+				fakeActor.RenameApplicationByNameAndSpaceGUIDReturnsOnCall(2, resources.Application{}, nil, errors.New("undo failed"))
+			})
+
+			It("reports it and returns the original error", func() {
+				Expect(executeErr).To(MatchError("rename failed"))
+				Expect(testUI.Err).To(Say(`Unable to rename app myapp-venerable back to myapp: undo failed`))
+			})
+		})
+	})
+
+	When("the current app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (resources.Application, v7action.Warnings, error) {
+				if name == "myapp-new" {
+					return resources.Application{Name: "myapp-new", GUID: "new-guid"}, nil, nil
+				}
+				return resources.Application{}, nil, actionerror.ApplicationNotFoundError{Name: name}
+			}
+		})
+
+		It("pushes the new version, maps the routes in the manifest and renames it to the app name", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationRoutesCallCount()).To(Equal(0))
+			Expect(fakeStager.StageAndStartCallCount()).To(Equal(1))
+			Expect(fakeActor.AddRouteDestinationsCallCount()).To(Equal(0))
+			Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(2))
+			Expect(fakeActor.UnmapRouteCallCount()).To(Equal(0))
+
+			Expect(fakeActor.RenameApplicationByNameAndSpaceGUIDCallCount()).To(Equal(1))
+			oldName, newName, _ := fakeActor.RenameApplicationByNameAndSpaceGUIDArgsForCall(0)
+			Expect([]string{oldName, newName}).To(Equal([]string{"myapp-new", "myapp"}))
+
+			Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("the manifest has no routes for the app", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppName = "My_App"
+				fakeActor.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (resources.Application, v7action.Warnings, error) {
+					if name == "My_App-new" {
+						return resources.Application{Name: "My_App-new", GUID: "new-guid"}, nil, nil
+					}
+					return resources.Application{}, nil, actionerror.ApplicationNotFoundError{Name: name}
+				}
+				fakeManifestParser.ParseManifestReturns(manifestparser.Manifest{
+					Applications: []manifestparser.Application{{Name: "My_App", Path: "app-dir"}},
+				}, nil)
+			})
+
+			It("maps the default route named after the app, not the new version", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeActor.GetDefaultDomainArgsForCall(0)).To(Equal("org-guid"))
+				Expect(fakeManifestParser.MarshalManifestArgsForCall(1)).To(Equal(manifestparser.Manifest{
+					Applications: []manifestparser.Application{
+						{Name: "My_App-new", Routes: []manifestparser.Route{{Route: "my-app.apps.example.com"}}},
+					},
+				}))
+				Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(2))
+			})
+
+			When("default-route is set", func() {
+				BeforeEach(func() {
+					fakeManifestParser.ParseManifestReturns(manifestparser.Manifest{
+						Applications: []manifestparser.Application{{Name: "My_App", Path: "app-dir", DefaultRoute: true}},
+					}, nil)
+				})
+
+				It("maps the default route named after the app", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeManifestParser.MarshalManifestArgsForCall(1).Applications[0].Routes).To(Equal([]manifestparser.Route{
+						{Route: "my-app.apps.example.com"},
+					}))
+				})
+			})
+
+			When("random-route is set", func() {
+				BeforeEach(func() {
+					fakeManifestParser.ParseManifestReturns(manifestparser.Manifest{
+						Applications: []manifestparser.Application{{Name: "My_App", Path: "app-dir", RandomRoute: true}},
+					}, nil)
+				})
+
+				It("maps a random route based on the app name", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					routes := fakeManifestParser.MarshalManifestArgsForCall(1).Applications[0].Routes
+					Expect(routes).To(HaveLen(1))
+					Expect(routes[0].Route).To(MatchRegexp(`^my-app-[a-z]+-[a-z]+-[a-z]{2}\.apps\.example\.com$`))
+				})
+			})
+
+			When("no-route is set", func() {
+				BeforeEach(func() {
+					fakeManifestParser.ParseManifestReturns(manifestparser.Manifest{
+						Applications: []manifestparser.Application{{Name: "My_App", Path: "app-dir", NoRoute: true}},
+					}, nil)
+				})
+
+				It("maps no routes", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(1))
+				})
+			})
+
+			When("getting the default domain fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetDefaultDomainReturns(resources.Domain{}, v7action.Warnings{"domain-warning"}, errors.New("no default domain"))
+				})
+
+				It("rolls back", func() {
+					Expect(executeErr).To(MatchError("no default domain"))
+					Expect(testUI.Err).To(Say("domain-warning"))
+					appName, _, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+					Expect(appName).To(Equal("My_App-new"))
+					Expect(fakeActor.RenameApplicationByNameAndSpaceGUIDCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	When("getting the current app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceStub = nil
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, nil, errors.New("get app failed"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("get app failed"))
+			Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+		})
+	})
+})
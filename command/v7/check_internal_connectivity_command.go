@@ -0,0 +1,216 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/policymanifest"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . CheckInternalConnectivityActor
+
+type CheckInternalConnectivityActor interface {
+	NetworkPoliciesToApplications(destApps []resources.Application) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+}
+
+type CheckInternalConnectivityCommand struct {
+	BaseCommand
+
+	RequiredArgs       flag.CheckInternalConnectivityArgs `positional-args:"yes"`
+	ProcessIndex       uint                               `long:"app-instance-index" short:"i" default:"0" description:"Instance of the source app to connect from"`
+	ProcessType        string                             `long:"process" default:"web" description:"Process of the source app to connect from"`
+	SkipHostValidation bool                               `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	usage              interface{}                        `usage:"CF_NAME check-internal-connectivity SRC_APP DEST_ROUTE PORT [--process PROCESS] [-i INDEX]\n\n   Checks that an instance of the source app can reach the internal route on the port. Reports\n   whether a network policy allows the traffic, and, over SSH from the instance, whether the route\n   resolves and a TCP connection to it can be opened. The connection check needs bash, getent and\n   timeout in the container of the source app.\n\nEXAMPLES:\n   CF_NAME check-internal-connectivity frontend backend.apps.internal 8080"`
+	relatedCommands    interface{}                        `related_commands:"add-network-policy, internal-routes, network-policies, ssh"`
+
+	NetworkingActor CheckInternalConnectivityActor
+	SSHActor        SharedSSHActor
+	SSHClient       *clissh.SecureShell
+}
+
+func (cmd *CheckInternalConnectivityCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+	cmd.SSHActor = sharedaction.NewActor(config)
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+
+	return nil
+}
+
+func (cmd CheckInternalConnectivityCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.Config.TargetedOrganization().Name
+	spaceName := cmd.Config.TargetedSpace().Name
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	cmd.UI.DisplayTextWithFlavor("Checking connectivity from app {{.SourceApp}} to {{.Route}} on port {{.Port}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"SourceApp": cmd.RequiredArgs.SourceApp,
+		"Route":     cmd.RequiredArgs.Route,
+		"Port":      cmd.RequiredArgs.Port,
+		"Org":       orgName,
+		"Space":     spaceName,
+		"User":      user.Name,
+	})
+
+	sourceApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.SourceApp, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	internalRoute, warnings, err := cmd.Actor.GetInternalRoute(cmd.RequiredArgs.Route, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var destApps []resources.Application
+	for _, destination := range internalRoute.Destinations {
+		destApps = append(destApps, destination.App)
+	}
+
+	var allowingPolicies []cfnetworkingaction.Policy
+	if len(destApps) > 0 {
+		policies, policyWarnings, policyErr := cmd.NetworkingActor.NetworkPoliciesToApplications(destApps)
+		cmd.UI.DisplayWarnings(policyWarnings)
+		if policyErr != nil {
+			return policyErr
+		}
+
+		for _, destApp := range destApps {
+			for _, policy := range policiesAllowing(policies, orgName, spaceName, destApp.Name, cmd.RequiredArgs.Port) {
+				if policy.SourceName == sourceApp.Name &&
+					policy.SourceSpaceName == spaceName &&
+					policy.SourceOrgName == orgName &&
+					policy.Protocol == "tcp" {
+					allowingPolicies = append(allowingPolicies, policy)
+				}
+			}
+		}
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		sourceApp.Name,
+		spaceGUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	output, err := cmd.SSHActor.RunSecureShellCommand(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Commands:           []string{v7action.InternalConnectivityProbeCommand(internalRoute.Route.URL, cmd.RequiredArgs.Port)},
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		})
+	if err != nil {
+		return err
+	}
+
+	probe, err := v7action.ParseInternalConnectivityProbe(output)
+	if err != nil {
+		return err
+	}
+
+	cmd.displayResult(allowingPolicies, probe)
+
+	if probe.Connected {
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displayHint(internalRoute, allowingPolicies, probe)
+
+	return translatableerror.InternalConnectivityFailedError{
+		SourceApp: sourceApp.Name,
+		Route:     internalRoute.Route.URL,
+		Port:      cmd.RequiredArgs.Port,
+	}
+}
+
+func (cmd CheckInternalConnectivityCommand) displayResult(allowingPolicies []cfnetworkingaction.Policy, probe v7action.InternalConnectivityProbe) {
+	policyResult := cmd.UI.TranslateText("none allows tcp on this port")
+	if len(allowingPolicies) > 0 {
+		var allowed []string
+		for _, policy := range allowingPolicies {
+			allowed = append(allowed, fmt.Sprintf("%s -> %s %s %s", policy.SourceName, policy.DestinationName, policy.Protocol, policymanifest.FormatPorts(policy.StartPort, policy.EndPort)))
+		}
+		policyResult = cmd.UI.TranslateText("allowed by {{.Policies}}", map[string]interface{}{
+			"Policies": strings.Join(allowed, ", "),
+		})
+	}
+
+	dnsResult := cmd.UI.TranslateText("not resolved")
+	if len(probe.Addresses) > 0 {
+		dnsResult = strings.Join(probe.Addresses, ", ")
+	}
+
+	tcpResult := cmd.UI.TranslateText("failed")
+	if probe.Connected {
+		tcpResult = cmd.UI.TranslateText("connected")
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("network policy:"), policyResult},
+		{cmd.UI.TranslateText("dns:"), dnsResult},
+		{cmd.UI.TranslateText("tcp:"), tcpResult},
+	}, 3)
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd CheckInternalConnectivityCommand) displayHint(internalRoute v7action.InternalRoute, allowingPolicies []cfnetworkingaction.Policy, probe v7action.InternalConnectivityProbe) {
+	switch {
+	case len(internalRoute.Destinations) == 0:
+		cmd.UI.DisplayText("TIP: The route is not mapped to any app. Use '{{.Command}}' to map it.", map[string]interface{}{
+			"Command": fmt.Sprintf("%s map-route APP_NAME %s", cmd.Config.BinaryName(), internalRoute.Route.URL),
+		})
+	case len(allowingPolicies) == 0:
+		for _, destination := range internalRoute.Destinations {
+			cmd.UI.DisplayText("TIP: Use '{{.Command}}' to allow the traffic.", map[string]interface{}{
+				"Command": fmt.Sprintf("%s add-network-policy %s %s --protocol tcp --port %d", cmd.Config.BinaryName(), cmd.RequiredArgs.SourceApp, destination.App.Name, cmd.RequiredArgs.Port),
+			})
+		}
+	case len(probe.Addresses) == 0:
+		cmd.UI.DisplayText("TIP: The route does not resolve. Check that its apps are running.")
+	default:
+		cmd.UI.DisplayText("TIP: Check that the route's apps listen on port {{.Port}}.", map[string]interface{}{
+			"Port": cmd.RequiredArgs.Port,
+		})
+	}
+}
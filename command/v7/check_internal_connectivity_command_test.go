@@ -0,0 +1,218 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("check-internal-connectivity Command", func() {
+	var (
+		cmd                 CheckInternalConnectivityCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeCheckInternalConnectivityActor
+		fakeSSHActor        *v7fakes.FakeSharedSSHActor
+		binaryName          string
+		executeErr          error
+		backendApp          resources.Application
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeCheckInternalConnectivityActor)
+		fakeSSHActor = new(v7fakes.FakeSharedSSHActor)
+
+		cmd = CheckInternalConnectivityCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs:    flag.CheckInternalConnectivityArgs{SourceApp: "frontend", Route: "backend.apps.internal", Port: 8080},
+			ProcessType:     "web",
+			NetworkingActor: fakeNetworkingActor,
+			SSHActor:        fakeSSHActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		backendApp = resources.Application{GUID: "backend-guid", Name: "backend"}
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "frontend-guid", Name: "frontend"}, v7action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetInternalRouteReturns(v7action.InternalRoute{
+			Route:        resources.Route{URL: "backend.apps.internal"},
+			Destinations: []v7action.InternalRouteDestination{{App: backendApp, ProcessType: "web", Port: 8080}},
+		}, v7action.Warnings{"get-route-warning"}, nil)
+		fakeNetworkingActor.NetworkPoliciesToApplicationsReturns([]cfnetworkingaction.Policy{
+			{SourceName: "frontend", SourceSpaceName: "some-space", SourceOrgName: "some-org", DestinationName: "backend", DestinationSpaceName: "some-space", DestinationOrgName: "some-org", Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+		}, cfnetworkingaction.Warnings{"get-policies-warning"}, nil)
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v7action.SSHAuthentication{
+			Endpoint:           "some-endpoint",
+			HostKeyFingerprint: "some-fingerprint",
+			Passcode:           "some-passcode",
+			Username:           "some-username",
+		}, v7action.Warnings{"get-ssh-config-warning"}, nil)
+		fakeSSHActor.RunSecureShellCommandReturns([]byte("dns:10.255.0.1\ntcp:open\n"), nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the app can connect to the route", func() {
+		It("reports the policy and the probe", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("frontend"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			routePath, spaceGUID := fakeActor.GetInternalRouteArgsForCall(0)
+			Expect(routePath).To(Equal("backend.apps.internal"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeNetworkingActor.NetworkPoliciesToApplicationsArgsForCall(0)).To(Equal([]resources.Application{backendApp}))
+
+			appName, spaceGUID, processType, index := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("frontend"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("web"))
+			Expect(index).To(Equal(uint(0)))
+
+			_, sshOptions := fakeSSHActor.RunSecureShellCommandArgsForCall(0)
+			Expect(sshOptions).To(Equal(sharedaction.SSHOptions{
+				Commands:           []string{v7action.InternalConnectivityProbeCommand("backend.apps.internal", 8080)},
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			}))
+
+			Expect(testUI.Out).To(Say(`Checking connectivity from app frontend to backend\.apps\.internal on port 8080 in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`network policy:\s+allowed by frontend -> backend tcp 8080-8090`))
+			Expect(testUI.Out).To(Say(`dns:\s+10\.255\.0\.1`))
+			Expect(testUI.Out).To(Say(`tcp:\s+connected`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-route-warning"))
+			Expect(testUI.Err).To(Say("get-policies-warning"))
+			Expect(testUI.Err).To(Say("get-ssh-config-warning"))
+		})
+	})
+
+	When("no policy allows the traffic", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.NetworkPoliciesToApplicationsReturns([]cfnetworkingaction.Policy{
+				{SourceName: "frontend", SourceSpaceName: "some-space", SourceOrgName: "some-org", DestinationName: "backend", DestinationSpaceName: "some-space", DestinationOrgName: "some-org", Protocol: "udp", StartPort: 8080, EndPort: 8080},
+				{SourceName: "other", SourceSpaceName: "some-space", SourceOrgName: "some-org", DestinationName: "backend", DestinationSpaceName: "some-space", DestinationOrgName: "some-org", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+			}, nil, nil)
+			fakeSSHActor.RunSecureShellCommandReturns([]byte("dns:10.255.0.1\ntcp:closed\n"), nil)
+		})
+
+		It("reports the failure and suggests a policy", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InternalConnectivityFailedError{SourceApp: "frontend", Route: "backend.apps.internal", Port: 8080}))
+
+			Expect(testUI.Out).To(Say(`network policy:\s+none allows tcp on this port`))
+			Expect(testUI.Out).To(Say(`tcp:\s+failed`))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman add-network-policy frontend backend --protocol tcp --port 8080' to allow the traffic\.`))
+		})
+	})
+
+	When("the route does not resolve", func() {
+		BeforeEach(func() {
+			fakeSSHActor.RunSecureShellCommandReturns([]byte("dns:\ntcp:closed\n"), nil)
+		})
+
+		It("reports the failure", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InternalConnectivityFailedError{SourceApp: "frontend", Route: "backend.apps.internal", Port: 8080}))
+
+			Expect(testUI.Out).To(Say(`dns:\s+not resolved`))
+			Expect(testUI.Out).To(Say(`TIP: The route does not resolve\. Check that its apps are running\.`))
+		})
+	})
+
+	When("the route is not mapped to an app", func() {
+		BeforeEach(func() {
+			fakeActor.GetInternalRouteReturns(v7action.InternalRoute{Route: resources.Route{URL: "backend.apps.internal"}}, nil, nil)
+			fakeSSHActor.RunSecureShellCommandReturns([]byte("dns:\ntcp:closed\n"), nil)
+		})
+
+		It("suggests mapping it", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(fakeNetworkingActor.NetworkPoliciesToApplicationsCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say(`TIP: The route is not mapped to any app\. Use 'faceman map-route APP_NAME backend\.apps\.internal' to map it\.`))
+		})
+	})
+
+	When("the route is not internal", func() {
+		BeforeEach(func() {
+			fakeActor.GetInternalRouteReturns(v7action.InternalRoute{}, v7action.Warnings{"get-route-warning"}, actionerror.RouteNotInternalError{URL: "backend.example.com"})
+		})
+
+		It("returns the error without probing", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteNotInternalError{URL: "backend.example.com"}))
+			Expect(testUI.Err).To(Say("get-route-warning"))
+			Expect(fakeSSHActor.RunSecureShellCommandCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the probe cannot be run", func() {
+		BeforeEach(func() {
+			fakeSSHActor.RunSecureShellCommandReturns(nil, errors.New("ssh-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("ssh-error"))
+		})
+	})
+
+	When("the probe output is unexpected", func() {
+		BeforeEach(func() {
+			fakeSSHActor.RunSecureShellCommandReturns([]byte("bash: not found"), nil)
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.InternalConnectivityProbeError{Output: "bash: not found"}))
+		})
+	})
+})
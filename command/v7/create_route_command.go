@@ -4,21 +4,36 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type CreateRouteCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.Domain      `positional-args:"yes"`
-	usage           interface{}      `usage:"Create an HTTP route:\n      CF_NAME create-route DOMAIN [--hostname HOSTNAME] [--path PATH]\n\n   Create a TCP route:\n      CF_NAME create-route DOMAIN [--port PORT]\n\nEXAMPLES:\n   CF_NAME create-route example.com                             # example.com\n   CF_NAME create-route example.com --hostname myapp            # myapp.example.com\n   CF_NAME create-route example.com --hostname myapp --path foo # myapp.example.com/foo\n   CF_NAME create-route example.com --port 5000                 # example.com:5000"`
-	Hostname        string           `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
-	Path            flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
-	Port            int              `long:"port" description:"Port for the TCP route (default: random port)"`
-	relatedCommands interface{}      `related_commands:"check-route, domains, map-route, routes, unmap-route"`
+	RequiredArgs    flag.CreateRouteArgs `positional-args:"yes"`
+	usage           interface{}          `usage:"Create an HTTP route:\n      CF_NAME create-route DOMAIN [--hostname HOSTNAME] [--path PATH] [--option KEY=VALUE]...\n\n   Create a TCP route:\n      CF_NAME create-route DOMAIN [--port PORT] [--option KEY=VALUE]...\n\n   Create a TCP route with the first free port of a range:\n      CF_NAME create-route DOMAIN --port auto-range START-END [--option KEY=VALUE]...\n\nEXAMPLES:\n   CF_NAME create-route example.com                             # example.com\n   CF_NAME create-route example.com --hostname myapp            # myapp.example.com\n   CF_NAME create-route example.com --hostname myapp --path foo # myapp.example.com/foo\n   CF_NAME create-route example.com --port 5000                 # example.com:5000\n   CF_NAME create-route example.com --port auto-range 5000-5099 # first free port from 5000 to 5099\n   CF_NAME create-route example.com --hostname myapp --option loadbalancing=least-connection"`
+	Hostname        string               `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path            flag.V7RoutePath     `long:"path" description:"Path for the HTTP route"`
+	Port            flag.TCPRoutePort    `long:"port" description:"Port for the TCP route (default: random port), or auto-range to use the first free port of START-END"`
+	Options         []flag.RouteOption   `long:"option" description:"Set a route option as KEY=VALUE, such as loadbalancing=round-robin or loadbalancing=least-connection (can be used multiple times)"`
+	relatedCommands interface{}          `related_commands:"check-route, domains, map-route, router-group, routes, unmap-route, update-route"`
 }
 
 func (cmd CreateRouteCommand) Execute(args []string) error {
+	portRange := cmd.RequiredArgs.PortRange
+	if cmd.Port.AutoRange && portRange.StartPort == 0 {
+		return translatableerror.RequiredArgumentError{ArgumentName: "START-END"}
+	}
+	if !cmd.Port.AutoRange && portRange.StartPort != 0 {
+		return translatableerror.TooManyArgumentsError{ExtraArgument: fmt.Sprintf("%d-%d", portRange.StartPort, portRange.EndPort)}
+	}
+	if cmd.Port.AutoRange && (cmd.Hostname != "" || cmd.Path.Path != "") {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--port auto-range", "--hostname", "--path"}}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -32,11 +47,14 @@ func (cmd CreateRouteCommand) Execute(args []string) error {
 	domain := cmd.RequiredArgs.Domain
 	hostname := cmd.Hostname
 	pathName := cmd.Path.Path
-	port := cmd.Port
+	port := cmd.Port.Port
 	spaceName := cmd.Config.TargetedSpace().Name
 	orgName := cmd.Config.TargetedOrganization().Name
 	spaceGUID := cmd.Config.TargetedSpace().GUID
 	url := desiredURL(domain, hostname, pathName, port)
+	if cmd.Port.AutoRange {
+		url = fmt.Sprintf("%s:%d-%d", domain, portRange.StartPort, portRange.EndPort)
+	}
 
 	cmd.UI.DisplayTextWithFlavor("Creating route {{.URL}} for org {{.Organization}} / space {{.Space}} as {{.User}}...",
 		map[string]interface{}{
@@ -46,7 +64,20 @@ func (cmd CreateRouteCommand) Execute(args []string) error {
 			"Organization": orgName,
 		})
 
-	route, warnings, err := cmd.Actor.CreateRoute(spaceGUID, domain, hostname, pathName, port)
+	var (
+		route    resources.Route
+		warnings v7action.Warnings
+	)
+	if cmd.Port.AutoRange {
+		route, warnings, err = cmd.Actor.CreateRouteInPortRange(
+			spaceGUID,
+			domain,
+			v7action.PortRange{Start: portRange.StartPort, End: portRange.EndPort},
+			flag.RouteOptions(cmd.Options),
+		)
+	} else {
+		route, warnings, err = cmd.Actor.CreateRoute(spaceGUID, domain, hostname, pathName, port, flag.RouteOptions(cmd.Options))
+	}
 
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -37,6 +38,9 @@ var _ = Describe("create-route Command", func() {
 		hostname   string
 		path       string
 		port       int
+		autoRange  bool
+		portRange  flag.NetworkPort
+		options    []flag.RouteOption
 	)
 
 	BeforeEach(func() {
@@ -52,6 +56,9 @@ var _ = Describe("create-route Command", func() {
 		hostname = ""
 		path = ""
 		port = 0
+		autoRange = false
+		portRange = flag.NetworkPort{}
+		options = nil
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
@@ -59,12 +66,14 @@ var _ = Describe("create-route Command", func() {
 
 	JustBeforeEach(func() {
 		cmd = CreateRouteCommand{
-			RequiredArgs: flag.Domain{
-				Domain: domainName,
+			RequiredArgs: flag.CreateRouteArgs{
+				Domain:    domainName,
+				PortRange: portRange,
 			},
 			Hostname: hostname,
 			Path:     flag.V7RoutePath{Path: path},
-			Port:     port,
+			Port:     flag.TCPRoutePort{Port: port, AutoRange: autoRange},
+			Options:  options,
 			BaseCommand: BaseCommand{
 				UI:          testUI,
 				Config:      fakeConfig,
@@ -169,7 +178,7 @@ var _ = Describe("create-route Command", func() {
 
 			It("creates the route", func() {
 				Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
-				expectedSpaceGUID, expectedDomainName, expectedHostname, _, _ := fakeActor.CreateRouteArgsForCall(0)
+				expectedSpaceGUID, expectedDomainName, expectedHostname, _, _, _ := fakeActor.CreateRouteArgsForCall(0)
 				Expect(expectedSpaceGUID).To(Equal(spaceGUID))
 				Expect(expectedDomainName).To(Equal(domainName))
 				Expect(expectedHostname).To(Equal(hostname))
@@ -194,7 +203,7 @@ var _ = Describe("create-route Command", func() {
 
 				It("creates the route", func() {
 					Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
-					expectedSpaceGUID, expectedDomainName, expectedHostname, _, _ := fakeActor.CreateRouteArgsForCall(0)
+					expectedSpaceGUID, expectedDomainName, expectedHostname, _, _, _ := fakeActor.CreateRouteArgsForCall(0)
 					Expect(expectedSpaceGUID).To(Equal(spaceGUID))
 					Expect(expectedDomainName).To(Equal(domainName))
 					Expect(expectedHostname).To(Equal(hostname))
@@ -220,7 +229,7 @@ var _ = Describe("create-route Command", func() {
 
 				It("calls the actor with the correct arguments", func() {
 					Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
-					expectedSpaceGUID, expectedDomainName, expectedHostname, _, expectedPort := fakeActor.CreateRouteArgsForCall(0)
+					expectedSpaceGUID, expectedDomainName, expectedHostname, _, expectedPort, _ := fakeActor.CreateRouteArgsForCall(0)
 					Expect(expectedSpaceGUID).To(Equal(spaceGUID))
 					Expect(expectedDomainName).To(Equal(domainName))
 					Expect(expectedHostname).To(Equal(hostname))
@@ -229,6 +238,87 @@ var _ = Describe("create-route Command", func() {
 			})
 		})
 
+		When("passing in options", func() {
+			BeforeEach(func() {
+				options = []flag.RouteOption{{Key: "loadbalancing", Value: "least-connection"}}
+			})
+
+			It("creates the route with the options", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				_, _, _, _, _, options := fakeActor.CreateRouteArgsForCall(0)
+				Expect(options).To(HaveLen(1))
+				Expect(*options["loadbalancing"]).To(Equal("least-connection"))
+			})
+		})
+
+		When("--port auto-range is given", func() {
+			BeforeEach(func() {
+				autoRange = true
+				portRange = flag.NetworkPort{StartPort: 5000, EndPort: 5099}
+				fakeActor.CreateRouteInPortRangeReturns(resources.Route{
+					URL: domainName + ":5003",
+				}, v7action.Warnings{"auto-range-warning"}, nil)
+			})
+
+			It("creates the route with the first free port of the range", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeActor.CreateRouteCallCount()).To(Equal(0))
+				expectedSpaceGUID, expectedDomainName, expectedPortRange, _ := fakeActor.CreateRouteInPortRangeArgsForCall(0)
+				Expect(expectedSpaceGUID).To(Equal(spaceGUID))
+				Expect(expectedDomainName).To(Equal(domainName))
+				Expect(expectedPortRange).To(Equal(v7action.PortRange{Start: 5000, End: 5099}))
+
+				Expect(testUI.Out).To(Say(`Creating route %s:5000-5099 for org %s / space %s as the-user\.\.\.`, domainName, orgName, spaceName))
+				Expect(testUI.Out).To(Say(`Route %s:5003 has been created\.`, domainName))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("auto-range-warning"))
+			})
+
+			When("no range is given", func() {
+				BeforeEach(func() {
+					portRange = flag.NetworkPort{}
+				})
+
+				It("returns a RequiredArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "START-END"}))
+					Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+				})
+			})
+
+			When("a hostname is given", func() {
+				BeforeEach(func() {
+					hostname = "myapp"
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--port auto-range", "--hostname", "--path"}}))
+				})
+			})
+
+			When("no port in the range is free", func() {
+				BeforeEach(func() {
+					fakeActor.CreateRouteInPortRangeReturns(resources.Route{}, v7action.Warnings{"auto-range-warning"}, actionerror.NoFreeRoutePortError{Domain: domainName, StartPort: 5000, EndPort: 5099})
+				})
+
+				It("displays warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.NoFreeRoutePortError{Domain: domainName, StartPort: 5000, EndPort: 5099}))
+					Expect(testUI.Err).To(Say("auto-range-warning"))
+				})
+			})
+		})
+
+		When("a port range is given without --port auto-range", func() {
+			BeforeEach(func() {
+				portRange = flag.NetworkPort{StartPort: 5000, EndPort: 5099}
+			})
+
+			It("returns a TooManyArgumentsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.TooManyArgumentsError{ExtraArgument: "5000-5099"}))
+			})
+		})
+
 		When("the route already exists", func() {
 			BeforeEach(func() {
 				fakeActor.CreateRouteReturns(resources.Route{}, v7action.Warnings{"some-warning"}, actionerror.RouteAlreadyExistsError{Err: errors.New("api error for a route that already exists")})
@@ -33,8 +33,12 @@ func (cmd CreateSecurityGroupCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	warnings, err := cmd.Actor.CreateSecurityGroup(cmd.RequiredArgs.SecurityGroup, string(cmd.RequiredArgs.PathToJSONRules))
+	warnings, err := cmd.Actor.LintSecurityGroupRules(string(cmd.RequiredArgs.PathToJSONRules))
 	cmd.UI.DisplayWarnings(warnings)
+	if err == nil {
+		warnings, err = cmd.Actor.CreateSecurityGroup(cmd.RequiredArgs.SecurityGroup, string(cmd.RequiredArgs.PathToJSONRules))
+		cmd.UI.DisplayWarnings(warnings)
+	}
 
 	_, isSyntaxErr := err.(*json.SyntaxError)
 	_, isUnmarshalErr := err.(*json.UnmarshalTypeError)
@@ -77,6 +77,47 @@ var _ = Describe("create-security-group Command", func() {
 		})
 	})
 
+	When("linting the rules warns", func() {
+		BeforeEach(func() {
+			fakeActor.LintSecurityGroupRulesReturns(v7action.Warnings{"Rules 1 and 2 overlap."}, nil)
+			fakeActor.CreateSecurityGroupReturns(v7action.Warnings{"create-security-group-warning"}, nil)
+		})
+
+		It("displays the lint warnings and creates the security group", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.LintSecurityGroupRulesArgsForCall(0)).To(Equal("some-path"))
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(1))
+			Expect(testUI.Err).To(Say(`Rules 1 and 2 overlap\.`))
+			Expect(testUI.Err).To(Say("create-security-group-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the rules are invalid", func() {
+		var lintErr actionerror.InvalidSecurityGroupRulesError
+
+		BeforeEach(func() {
+			lintErr = actionerror.InvalidSecurityGroupRulesError{Path: "some-path", Problems: []string{"rule 1: destination is required"}}
+			fakeActor.LintSecurityGroupRulesReturns(nil, lintErr)
+		})
+
+		It("returns the error without creating the security group", func() {
+			Expect(executeErr).To(MatchError(lintErr))
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the rules file is not valid JSON", func() {
+		BeforeEach(func() {
+			fakeActor.LintSecurityGroupRulesReturns(nil, &json.SyntaxError{})
+		})
+
+		It("returns a custom error without creating the security group", func() {
+			Expect(executeErr).To(Equal(actionerror.SecurityGroupJsonSyntaxError{Path: "some-path"}))
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(0))
+		})
+	})
+
 	When("the provided JSON is invalid", func() {
 		BeforeEach(func() {
 			fakeActor.CreateSecurityGroupReturns(
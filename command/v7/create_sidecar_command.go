@@ -0,0 +1,64 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type CreateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SidecarArgs `positional-args:"yes"`
+	Command         string           `long:"command" short:"c" required:"true" description:"The command to start the sidecar with"`
+	ProcessTypes    []string         `long:"process-type" required:"true" description:"Process type the sidecar runs alongside; can specify multiple times"`
+	Memory          flag.Megabytes   `long:"memory" short:"m" description:"Memory reserved for the sidecar out of the process's memory limit (e.g. 256M, 1G)"`
+	usage           interface{}      `usage:"CF_NAME create-sidecar APP_NAME SIDECAR_NAME -c COMMAND --process-type PROCESS_TYPE... [-m MEMORY]\n\nEXAMPLES:\n   CF_NAME create-sidecar my-app config-server -c \"./config-server\" --process-type web --process-type worker -m 128M"`
+	relatedCommands interface{}      `related_commands:"restart, sidecars, update-sidecar"`
+}
+
+func (cmd CreateSidecarCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, warnings, err = cmd.Actor.CreateApplicationSidecar(application.GUID, resources.Sidecar{
+		Name:         cmd.RequiredArgs.SidecarName,
+		Command:      types.FilteredString{IsSet: true, Value: cmd.Command},
+		ProcessTypes: cmd.ProcessTypes,
+		MemoryInMB:   cmd.Memory.NullUint64,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use 'cf restart {{.AppName}}' to ensure your sidecar changes take effect.", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
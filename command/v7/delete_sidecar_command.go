@@ -0,0 +1,75 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type DeleteSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SidecarArgs `positional-args:"yes"`
+	Force           bool             `long:"force" short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME delete-sidecar APP_NAME SIDECAR_NAME [-f]"`
+	relatedCommands interface{}      `related_commands:"restart, sidecars"`
+}
+
+func (cmd DeleteSidecarCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		confirmedDelete, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the sidecar {{.SidecarName}} of app {{.AppName}}?", map[string]interface{}{
+			"SidecarName": cmd.RequiredArgs.SidecarName,
+			"AppName":     cmd.RequiredArgs.AppName,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !confirmedDelete {
+			cmd.UI.DisplayText("Sidecar '{{.SidecarName}}' has not been deleted.", map[string]interface{}{
+				"SidecarName": cmd.RequiredArgs.SidecarName,
+			})
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	warnings, err = cmd.Actor.DeleteApplicationSidecar(application.GUID, cmd.RequiredArgs.SidecarName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.SidecarNotFoundError); !ok {
+			return err
+		}
+		cmd.UI.DisplayWarning("Sidecar '{{.SidecarName}}' does not exist.", map[string]interface{}{
+			"SidecarName": cmd.RequiredArgs.SidecarName,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
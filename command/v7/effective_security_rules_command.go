@@ -0,0 +1,88 @@
+package v7
+
+import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type EffectiveSecurityRulesCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.Space                  `positional-args:"yes"`
+	Lifecycle       flag.SecurityGroupLifecycle `long:"lifecycle" choice:"running" choice:"staging" default:"running" description:"Lifecycle phase the rules apply to"`
+	usage           interface{}                 `usage:"CF_NAME effective-security-rules SPACE [--lifecycle (running | staging)]\n\n   Lists the egress rules that apps in the space get from the security groups bound to the\n   space and the security groups bound globally, without duplicates.\n\nEXAMPLES:\n   CF_NAME effective-security-rules dev\n   CF_NAME effective-security-rules dev --lifecycle staging"`
+	relatedCommands interface{}                 `related_commands:"running-security-groups, security-group, security-groups, staging-security-groups"`
+}
+
+func (cmd EffectiveSecurityRulesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting effective {{.Lifecycle}} security rules for space {{.Space}} in org {{.Org}} as {{.Username}}...", map[string]interface{}{
+		"Lifecycle": string(cmd.Lifecycle),
+		"Space":     cmd.RequiredArgs.Space,
+		"Org":       cmd.Config.TargetedOrganization().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	rules, warnings, err := cmd.Actor.GetEffectiveSecurityRules(cmd.RequiredArgs.Space, cmd.Config.TargetedOrganization().GUID, constant.SecurityGroupLifecycle(cmd.Lifecycle))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(rules) == 0 {
+		cmd.UI.DisplayText("No security group rules found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("code"),
+			cmd.UI.TranslateText("security groups"),
+		},
+	}
+	for _, rule := range rules {
+		table = append(table, []string{
+			rule.Rule.Protocol,
+			rule.Rule.Destination,
+			optionalRuleString(rule.Rule.Ports),
+			optionalRuleInt(rule.Rule.Type),
+			optionalRuleInt(rule.Rule.Code),
+			strings.Join(rule.SecurityGroupNames, ", "),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
+
+func optionalRuleString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func optionalRuleInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
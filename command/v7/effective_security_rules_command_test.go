@@ -0,0 +1,128 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("effective-security-rules Command", func() {
+	var (
+		cmd             EffectiveSecurityRulesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = EffectiveSecurityRulesCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.Space{Space: "some-space"},
+			Lifecycle:    flag.SecurityGroupLifecycle(constant.SecurityGroupLifecycleRunning),
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the space has effective rules", func() {
+		BeforeEach(func() {
+			ports := "443"
+			icmpType := 0
+			icmpCode := -1
+			fakeActor.GetEffectiveSecurityRulesReturns([]v7action.EffectiveSecurityRule{
+				{
+					Rule:               resources.Rule{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: &ports},
+					SecurityGroupNames: []string{"backend", "public"},
+				},
+				{
+					Rule:               resources.Rule{Protocol: "icmp", Destination: "10.0.0.0/8", Type: &icmpType, Code: &icmpCode},
+					SecurityGroupNames: []string{"backend"},
+				},
+			}, v7action.Warnings{"rules-warning"}, nil)
+		})
+
+		It("displays the rules", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			spaceName, orgGUID, lifecycle := fakeActor.GetEffectiveSecurityRulesArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(lifecycle).To(Equal(constant.SecurityGroupLifecycleRunning))
+
+			Expect(testUI.Out).To(Say(`Getting effective running security rules for space some-space in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`protocol\s+destination\s+ports\s+type\s+code\s+security groups`))
+			Expect(testUI.Out).To(Say(`tcp\s+0\.0\.0\.0/0\s+443\s+backend, public`))
+			Expect(testUI.Out).To(Say(`icmp\s+10\.0\.0\.0/8\s+0\s+-1\s+backend`))
+			Expect(testUI.Err).To(Say("rules-warning"))
+		})
+	})
+
+	When("the lifecycle is staging", func() {
+		BeforeEach(func() {
+			cmd.Lifecycle = flag.SecurityGroupLifecycle(constant.SecurityGroupLifecycleStaging)
+		})
+
+		It("gets the staging rules", func() {
+			_, _, lifecycle := fakeActor.GetEffectiveSecurityRulesArgsForCall(0)
+			Expect(lifecycle).To(Equal(constant.SecurityGroupLifecycleStaging))
+			Expect(testUI.Out).To(Say("No security group rules found."))
+		})
+	})
+
+	When("getting the rules fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetEffectiveSecurityRulesReturns(nil, v7action.Warnings{"rules-warning"}, errors.New("rules-error"))
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("rules-error"))
+			Expect(testUI.Err).To(Say("rules-warning"))
+		})
+	})
+})
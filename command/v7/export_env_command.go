@@ -0,0 +1,190 @@
+package v7
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const (
+	exportFormatDotenv = "dotenv"
+	exportFormatJSON   = "json"
+	exportFormatShell  = "shell"
+)
+
+type ExportEnvCommand struct {
+	BaseCommand
+
+	RequiredArgs       flag.EnvironmentArgs `positional-args:"yes"`
+	Format             string               `long:"format" choice:"dotenv" choice:"json" choice:"shell" default:"dotenv" description:"Format of the exported environment"`
+	OutputFile         flag.Path            `long:"output" description:"Write the environment to FILE instead of stdout"`
+	IncludeCredentials bool                 `long:"include-credentials" description:"Include service binding credentials in VCAP_SERVICES instead of redacting them"`
+	usage              interface{}          `usage:"CF_NAME export-env APP_NAME [--format dotenv|json|shell] [--output FILE] [--include-credentials]\n\n   Exports the environment a running instance of the app sees: the running environment variable group,\n   user-provided env variables, VCAP_APPLICATION and VCAP_SERVICES.\n\nEXAMPLES:\n   CF_NAME export-env myapp --output .env\n   CF_NAME export-env myapp --format shell --include-credentials > env.sh"`
+	relatedCommands    interface{}          `related_commands:"env, set-env, running-environment-variable-group"`
+}
+
+func (cmd ExportEnvCommand) Execute(_ []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if cmd.OutputFile != "" {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Exporting env variables for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	}
+
+	envGroups, warnings, err := cmd.Actor.GetEnvironmentVariablesByApplicationNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	env, redacted, err := processEnvironment(envGroups, cmd.IncludeCredentials)
+	if err != nil {
+		return err
+	}
+
+	content, err := formatEnvironment(env, cmd.Format)
+	if err != nil {
+		return err
+	}
+
+	if redacted {
+		cmd.UI.DisplayWarning("Service credentials in VCAP_SERVICES have been redacted. Use '--include-credentials' to export them.")
+	}
+
+	if cmd.OutputFile == "" {
+		_, err = cmd.UI.Writer().Write(content)
+		return err
+	}
+
+	err = ioutil.WriteFile(cmd.OutputFile.String(), content, 0600)
+	if err != nil {
+		return translatableerror.FileCreationError{Err: err}
+	}
+
+	cmd.UI.DisplayText("Env variables written to {{.FilePath}}", map[string]interface{}{
+		"FilePath": cmd.OutputFile.String(),
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// processEnvironment flattens the env groups into the variables a running
+// app instance sees. User-provided variables take precedence over the
+// running group, and VCAP_* variables take precedence over both.
+func processEnvironment(envGroups v7action.EnvironmentVariableGroups, includeCredentials bool) (map[string]string, bool, error) {
+	env := map[string]string{}
+	redacted := false
+
+	for _, group := range []map[string]interface{}{envGroups.Running, envGroups.EnvironmentVariables, envGroups.Application, envGroups.System} {
+		for name, value := range group {
+			if name == "VCAP_SERVICES" && !includeCredentials {
+				value, redacted = redactCredentials(value, false)
+			}
+
+			str, err := envValueString(value)
+			if err != nil {
+				return nil, false, err
+			}
+			env[name] = str
+		}
+	}
+
+	return env, redacted, nil
+}
+
+// redactCredentials returns a copy of value in which every scalar under a
+// "credentials" key has been replaced, and whether anything was replaced.
+func redactCredentials(value interface{}, inCredentials bool) (interface{}, bool) {
+	redacted := false
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			var childRedacted bool
+			result[key], childRedacted = redactCredentials(child, inCredentials || key == "credentials")
+			redacted = redacted || childRedacted
+		}
+		return result, redacted
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, child := range v {
+			var childRedacted bool
+			result[i], childRedacted = redactCredentials(child, inCredentials)
+			redacted = redacted || childRedacted
+		}
+		return result, redacted
+	default:
+		if inCredentials {
+			return ui.RedactedValue, true
+		}
+		return value, false
+	}
+}
+
+func envValueString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	default:
+		raw, err := json.Marshal(v)
+		return string(raw), err
+	}
+}
+
+func formatEnvironment(env map[string]string, format string) ([]byte, error) {
+	if format == exportFormatJSON {
+		buffer := new(bytes.Buffer)
+		encoder := json.NewEncoder(buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(env)
+		return buffer.Bytes(), err
+	}
+
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buffer := new(bytes.Buffer)
+	for _, name := range names {
+		switch format {
+		case exportFormatShell:
+			fmt.Fprintf(buffer, "export %s='%s'\n", name, strings.ReplaceAll(env[name], "'", `'\''`))
+		default:
+			fmt.Fprintf(buffer, "%s=\"%s\"\n", name, dotenvEscaper.Replace(env[name]))
+		}
+	}
+
+	return buffer.Bytes(), nil
+}
+
+var dotenvEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`)
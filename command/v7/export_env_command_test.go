@@ -0,0 +1,243 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-env Command", func() {
+	var (
+		cmd             ExportEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		appName         string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		appName = "some-app"
+
+		cmd = ExportEnvCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.EnvironmentArgs{AppName: appName},
+			Format:       "dotenv",
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(
+			v7action.EnvironmentVariableGroups{
+				System: map[string]interface{}{
+					"VCAP_SERVICES": map[string]interface{}{
+						"p-mysql": []interface{}{
+							map[string]interface{}{
+								"name": "mydb",
+								"credentials": map[string]interface{}{
+									"username": "admin",
+									"ports":    []interface{}{float64(3306)},
+								},
+							},
+						},
+					},
+				},
+				Application: map[string]interface{}{
+					"VCAP_APPLICATION": map[string]interface{}{"application_name": "some-app"},
+				},
+				EnvironmentVariables: map[string]interface{}{
+					"GREETING": `say "hi" it's $HOME`,
+					"SHARED":   "from-app",
+				},
+				Running: map[string]interface{}{
+					"SHARED":       "from-running-group",
+					"RUNNING_ONLY": "yes",
+				},
+				Staging: map[string]interface{}{
+					"STAGING_ONLY": "yes",
+				},
+			},
+			v7action.Warnings{"env-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("gets the env of the app", func() {
+		Expect(fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceCallCount()).To(Equal(1))
+		actualAppName, actualSpaceGUID := fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+		Expect(actualAppName).To(Equal(appName))
+		Expect(actualSpaceGUID).To(Equal("some-space-guid"))
+	})
+
+	When("exporting as dotenv to stdout", func() {
+		It("prints only the environment, with credentials redacted", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`^GREETING="say \\"hi\\" it's \\\$HOME"\n`),
+				Say(`RUNNING_ONLY="yes"\n`),
+				Say(`SHARED="from-app"\n`),
+				Say(`VCAP_APPLICATION="{\\"application_name\\":\\"some-app\\"}"\n`),
+				Say(`VCAP_SERVICES="{\\"p-mysql\\":\[{\\"credentials\\":{\\"ports\\":\[\\"\[PRIVATE DATA HIDDEN\]\\"\],\\"username\\":\\"\[PRIVATE DATA HIDDEN\]\\"},\\"name\\":\\"mydb\\"}\]}"\n$`),
+			))
+			Expect(testUI.Out).NotTo(Say("STAGING_ONLY"))
+			Expect(testUI.Err).To(Say("env-warning"))
+			Expect(testUI.Err).To(Say("Service credentials in VCAP_SERVICES have been redacted. Use '--include-credentials' to export them."))
+			Expect(fakeActor.GetCurrentUserCallCount()).To(BeZero())
+		})
+	})
+
+	When("--include-credentials is provided", func() {
+		BeforeEach(func() {
+			cmd.IncludeCredentials = true
+			cmd.Format = "json"
+		})
+
+		It("exports the credentials unredacted", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`"VCAP_SERVICES": "{\\"p-mysql\\":\[{\\"credentials\\":{\\"ports\\":\[3306\],\\"username\\":\\"admin\\"},\\"name\\":\\"mydb\\"}\]}"`))
+			Expect(testUI.Err).NotTo(Say("redacted"))
+		})
+	})
+
+	When("exporting as shell", func() {
+		BeforeEach(func() {
+			cmd.Format = "shell"
+		})
+
+		It("prints export statements with single quotes escaped", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`export GREETING='say "hi" it'\\''s \$HOME'\n`),
+				Say(`export RUNNING_ONLY='yes'\n`),
+				Say(`export SHARED='from-app'\n`),
+			))
+		})
+	})
+
+	When("exporting as json", func() {
+		BeforeEach(func() {
+			cmd.Format = "json"
+		})
+
+		It("prints a JSON object of strings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`\{\n`),
+				Say(`  "GREETING": "say \\"hi\\" it's \$HOME",\n`),
+				Say(`  "RUNNING_ONLY": "yes",\n`),
+			))
+		})
+	})
+
+	When("--output is provided", func() {
+		var outputDir string
+
+		BeforeEach(func() {
+			var err error
+			outputDir, err = ioutil.TempDir("", "export-env-test")
+			Expect(err).ToNot(HaveOccurred())
+			cmd.OutputFile = flag.Path(filepath.Join(outputDir, ".env"))
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(outputDir)).To(Succeed())
+		})
+
+		It("writes the environment to the file with owner-only permissions", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			content, err := ioutil.ReadFile(string(cmd.OutputFile))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(`RUNNING_ONLY="yes"`))
+
+			info, err := os.Stat(string(cmd.OutputFile))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`Exporting env variables for app some-app in org some-org / space some-space as banana\.\.\.`),
+				Say(`Env variables written to %s`, cmd.OutputFile),
+				Say("OK"),
+			))
+		})
+
+		When("the file cannot be written", func() {
+			BeforeEach(func() {
+				cmd.OutputFile = flag.Path(filepath.Join(outputDir, "missing-dir", ".env"))
+			})
+
+			It("returns a file creation error", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.FileCreationError{}))
+			})
+		})
+
+		When("getting the user fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("no user"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("no user"))
+			})
+		})
+	})
+
+	When("getting the env fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(
+				v7action.EnvironmentVariableGroups{},
+				v7action.Warnings{"env-warning"},
+				actionerror.ApplicationNotFoundError{Name: appName},
+			)
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: appName}))
+			Expect(testUI.Err).To(Say("env-warning"))
+		})
+	})
+})
@@ -0,0 +1,167 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . InternalRoutesActor
+
+type InternalRoutesActor interface {
+	NetworkPoliciesToApplications(destApps []resources.Application) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+}
+
+type InternalRoutesCommand struct {
+	BaseCommand
+
+	App             string      `long:"app" description:"Only list the internal routes mapped to this app"`
+	usage           interface{} `usage:"CF_NAME internal-routes [--app APP]\n\n   Lists the routes on internal domains in the targeted space, with the apps they lead to and\n   the apps that network policies allow to connect to them.\n\nEXAMPLES:\n   CF_NAME internal-routes\n   CF_NAME internal-routes --app backend"`
+	relatedCommands interface{} `related_commands:"add-network-policy, check-internal-connectivity, map-route, network-policies"`
+
+	NetworkingActor InternalRoutesActor
+}
+
+func (cmd *InternalRoutesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd InternalRoutesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	template := "Getting internal routes for org {{.Org}} / space {{.Space}} as {{.User}}..."
+	if cmd.App != "" {
+		template = "Getting internal routes of app {{.App}} in org {{.Org}} / space {{.Space}} as {{.User}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
+		"App":   cmd.App,
+		"Org":   cmd.Config.TargetedOrganization().Name,
+		"Space": cmd.Config.TargetedSpace().Name,
+		"User":  user.Name,
+	})
+
+	internalRoutes, warnings, err := cmd.Actor.GetInternalRoutes(cmd.Config.TargetedSpace().GUID, cmd.App)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(internalRoutes) == 0 {
+		cmd.UI.DisplayText("No internal routes found.")
+		return nil
+	}
+
+	var destApps []resources.Application
+	seen := map[string]bool{}
+	for _, internalRoute := range internalRoutes {
+		for _, destination := range internalRoute.Destinations {
+			if !seen[destination.App.GUID] {
+				seen[destination.App.GUID] = true
+				destApps = append(destApps, destination.App)
+			}
+		}
+	}
+
+	var policies []cfnetworkingaction.Policy
+	if len(destApps) > 0 {
+		var policyWarnings cfnetworkingaction.Warnings
+		policies, policyWarnings, err = cmd.NetworkingActor.NetworkPoliciesToApplications(destApps)
+		cmd.UI.DisplayWarnings(policyWarnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("route"),
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("port"),
+			cmd.UI.TranslateText("allowed sources"),
+		},
+	}
+
+	for _, internalRoute := range internalRoutes {
+		if len(internalRoute.Destinations) == 0 {
+			table = append(table, []string{internalRoute.Route.URL, "", "", "", ""})
+			continue
+		}
+
+		for _, destination := range internalRoute.Destinations {
+			table = append(table, []string{
+				internalRoute.Route.URL,
+				destination.App.Name,
+				destination.ProcessType,
+				fmt.Sprint(destination.Port),
+				cmd.allowedSources(policies, destination.App.Name, destination.Port),
+			})
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+// allowedSources describes the sources of the policies that allow traffic to
+// the app on the port. The routes are in the targeted space, so their apps
+// are too.
+func (cmd InternalRoutesCommand) allowedSources(policies []cfnetworkingaction.Policy, appName string, port int) string {
+	var sources []string
+	for _, policy := range policiesAllowing(policies, cmd.Config.TargetedOrganization().Name, cmd.Config.TargetedSpace().Name, appName, port) {
+		source := policy.SourceName
+		if policy.SourceOrgName != cmd.Config.TargetedOrganization().Name || policy.SourceSpaceName != cmd.Config.TargetedSpace().Name {
+			source = fmt.Sprintf("%s/%s/%s", policy.SourceOrgName, policy.SourceSpaceName, policy.SourceName)
+		}
+		sources = append(sources, fmt.Sprintf("%s (%s)", source, policy.Protocol))
+	}
+
+	if len(sources) == 0 {
+		return cmd.UI.TranslateText("none")
+	}
+	return strings.Join(sources, ", ")
+}
+
+// policiesAllowing returns the policies that allow traffic to the app in the
+// given org and space on the port.
+func policiesAllowing(policies []cfnetworkingaction.Policy, orgName string, spaceName string, appName string, port int) []cfnetworkingaction.Policy {
+	var allowing []cfnetworkingaction.Policy
+	for _, policy := range policies {
+		if policy.DestinationName == appName &&
+			policy.DestinationSpaceName == spaceName &&
+			policy.DestinationOrgName == orgName &&
+			policy.StartPort <= port && port <= policy.EndPort {
+			allowing = append(allowing, policy)
+		}
+	}
+	return allowing
+}
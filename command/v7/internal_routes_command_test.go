@@ -0,0 +1,160 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("internal-routes Command", func() {
+	var (
+		cmd                 InternalRoutesCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeInternalRoutesActor
+		binaryName          string
+		executeErr          error
+		backendApp          resources.Application
+		workerApp           resources.Application
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeInternalRoutesActor)
+
+		cmd = InternalRoutesCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkingActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		backendApp = resources.Application{GUID: "backend-guid", Name: "backend"}
+		workerApp = resources.Application{GUID: "worker-guid", Name: "worker"}
+
+		fakeActor.GetInternalRoutesReturns([]v7action.InternalRoute{
+			{
+				Route: resources.Route{URL: "backend.apps.internal"},
+				Destinations: []v7action.InternalRouteDestination{
+					{App: backendApp, ProcessType: "web", Port: 8080},
+					{App: workerApp, ProcessType: "worker", Port: 9090},
+				},
+			},
+			{Route: resources.Route{URL: "unmapped.apps.internal"}},
+		}, v7action.Warnings{"get-routes-warning"}, nil)
+
+		fakeNetworkingActor.NetworkPoliciesToApplicationsReturns([]cfnetworkingaction.Policy{
+			{SourceName: "frontend", SourceSpaceName: "some-space", SourceOrgName: "some-org", DestinationName: "backend", DestinationSpaceName: "some-space", DestinationOrgName: "some-org", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+			{SourceName: "client", SourceSpaceName: "other-space", SourceOrgName: "other-org", DestinationName: "backend", DestinationSpaceName: "some-space", DestinationOrgName: "some-org", Protocol: "udp", StartPort: 8000, EndPort: 9000},
+			{SourceName: "frontend", SourceSpaceName: "some-space", SourceOrgName: "some-org", DestinationName: "worker", DestinationSpaceName: "some-space", DestinationOrgName: "some-org", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+		}, cfnetworkingaction.Warnings{"get-policies-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("lists the internal routes with the sources allowed to connect to them", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		spaceGUID, appName := fakeActor.GetInternalRoutesArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(appName).To(BeEmpty())
+		Expect(fakeNetworkingActor.NetworkPoliciesToApplicationsArgsForCall(0)).To(Equal([]resources.Application{backendApp, workerApp}))
+
+		Expect(testUI.Out).To(Say(`Getting internal routes for org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`route\s+app\s+process\s+port\s+allowed sources`))
+		Expect(testUI.Out).To(Say(`backend\.apps\.internal\s+backend\s+web\s+8080\s+frontend \(tcp\), other-org/other-space/client \(udp\)`))
+		Expect(testUI.Out).To(Say(`backend\.apps\.internal\s+worker\s+worker\s+9090\s+none`))
+		Expect(testUI.Out).To(Say(`unmapped\.apps\.internal`))
+		Expect(testUI.Err).To(Say("get-routes-warning"))
+		Expect(testUI.Err).To(Say("get-policies-warning"))
+	})
+
+	When("--app is given", func() {
+		BeforeEach(func() {
+			cmd.App = "backend"
+		})
+
+		It("lists the app's internal routes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			_, appName := fakeActor.GetInternalRoutesArgsForCall(0)
+			Expect(appName).To(Equal("backend"))
+			Expect(testUI.Out).To(Say(`Getting internal routes of app backend in org some-org / space some-space as steve\.\.\.`))
+		})
+	})
+
+	When("there are no internal routes", func() {
+		BeforeEach(func() {
+			fakeActor.GetInternalRoutesReturns(nil, v7action.Warnings{"get-routes-warning"}, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No internal routes found\.`))
+			Expect(fakeNetworkingActor.NetworkPoliciesToApplicationsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the routes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetInternalRoutesReturns(nil, v7action.Warnings{"get-routes-warning"}, errors.New("routes-error"))
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("routes-error"))
+			Expect(testUI.Err).To(Say("get-routes-warning"))
+		})
+	})
+
+	When("getting the policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.NetworkPoliciesToApplicationsReturns(nil, cfnetworkingaction.Warnings{"get-policies-warning"}, errors.New("policies-error"))
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("policies-error"))
+			Expect(testUI.Err).To(Say("get-policies-warning"))
+		})
+	})
+})
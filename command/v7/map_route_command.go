@@ -80,6 +80,7 @@ func (cmd MapRouteCommand) Execute(args []string) error {
 			cmd.Hostname,
 			path,
 			cmd.Port,
+			nil,
 		)
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
@@ -15,9 +15,11 @@ type MarketplaceCommand struct {
 
 	ServiceOfferingName string      `short:"e" description:"Show plan details for a particular service offering"`
 	ServiceBrokerName   string      `short:"b" description:"Only show details for a particular service broker"`
+	ServicePlanName     string      `short:"p" description:"Plan whose parameter schemas are displayed (requires -e and --show-schema)"`
 	NoPlans             bool        `long:"no-plans" description:"Hide plan information for service offerings"`
+	ShowSchema          bool        `long:"show-schema" description:"Show the JSON schemas that configuration parameters for the plan must satisfy"`
 	ShowUnavailable     bool        `long:"show-unavailable" description:"Show plans that are not available for use"`
-	usage               interface{} `usage:"CF_NAME marketplace [-e SERVICE_OFFERING] [-b SERVICE_BROKER] [--no-plans]"`
+	usage               interface{} `usage:"CF_NAME marketplace [-e SERVICE_OFFERING] [-b SERVICE_BROKER] [--no-plans]\n   CF_NAME marketplace -e SERVICE_OFFERING -p SERVICE_PLAN --show-schema [-b SERVICE_BROKER]"`
 	relatedCommands     interface{} `related_commands:"create-service, services"`
 }
 
@@ -38,6 +40,10 @@ func (cmd MarketplaceCommand) Execute(args []string) error {
 		filter.SpaceGUID = cmd.Config.TargetedSpace().GUID
 	}
 
+	if cmd.ShowSchema {
+		return cmd.displaySchemas(username)
+	}
+
 	cmd.displayMessage(username)
 
 	offerings, warnings, err := cmd.BaseCommand.Actor.Marketplace(filter)
@@ -65,6 +71,15 @@ func (cmd MarketplaceCommand) processFlags() (v7action.MarketplaceFilter, error)
 		return v7action.MarketplaceFilter{}, translatableerror.ArgumentCombinationError{Args: []string{"--no-plans", "-e"}}
 	}
 
+	switch {
+	case cmd.ShowSchema && cmd.ServiceOfferingName == "":
+		return v7action.MarketplaceFilter{}, translatableerror.RequiredFlagsError{Arg1: "--show-schema", Arg2: "-e"}
+	case cmd.ShowSchema && cmd.ServicePlanName == "":
+		return v7action.MarketplaceFilter{}, translatableerror.RequiredFlagsError{Arg1: "--show-schema", Arg2: "-p"}
+	case !cmd.ShowSchema && cmd.ServicePlanName != "":
+		return v7action.MarketplaceFilter{}, translatableerror.RequiredFlagsError{Arg1: "-p", Arg2: "--show-schema"}
+	}
+
 	return v7action.MarketplaceFilter{
 		ServiceOfferingName: cmd.ServiceOfferingName,
 		ServiceBrokerName:   cmd.ServiceBrokerName,
@@ -117,6 +132,60 @@ func (cmd MarketplaceCommand) displayMessage(username string) {
 	})
 }
 
+func (cmd MarketplaceCommand) displaySchemas(username string) error {
+	template := "Getting parameter schemas for plan {{.ServicePlanName}} of service offering {{.ServiceOfferingName}}"
+	if cmd.ServiceBrokerName != "" {
+		template += " from service broker {{.ServiceBrokerName}}"
+	}
+	if username != "" {
+		template += " in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}"
+	}
+
+	cmd.UI.DisplayTextWithFlavor(template+"...", map[string]interface{}{
+		"ServicePlanName":     cmd.ServicePlanName,
+		"ServiceOfferingName": cmd.ServiceOfferingName,
+		"ServiceBrokerName":   cmd.ServiceBrokerName,
+		"OrgName":             cmd.Config.TargetedOrganization().Name,
+		"SpaceName":           cmd.Config.TargetedSpace().Name,
+		"Username":            username,
+	})
+
+	plan, warnings, err := cmd.Actor.GetServicePlanByNameOfferingAndBroker(cmd.ServicePlanName, cmd.ServiceOfferingName, cmd.ServiceBrokerName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	schemas := []struct {
+		name   string
+		schema map[string]interface{}
+	}{
+		{"service instance create parameters", plan.ServiceInstanceCreateSchema},
+		{"service instance update parameters", plan.ServiceInstanceUpdateSchema},
+		{"service binding create parameters", plan.ServiceBindingCreateSchema},
+	}
+
+	cmd.UI.DisplayNewline()
+
+	displayed := false
+	for _, s := range schemas {
+		if len(s.schema) == 0 {
+			continue
+		}
+
+		if err := cmd.UI.DisplayJSON(s.name, s.schema); err != nil {
+			return err
+		}
+		displayed = true
+	}
+
+	if !displayed {
+		cmd.UI.DisplayText("No parameter schemas published for this plan.")
+	}
+
+	return nil
+}
+
 func (cmd MarketplaceCommand) displayPlansTable(offerings []v7action.ServiceOfferingWithPlans) error {
 	for _, o := range offerings {
 		data := cmd.plansTableHeadings()
@@ -1,6 +1,7 @@
 package v7_test
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -124,6 +125,116 @@ var _ = Describe("marketplace command", func() {
 				}))
 			})
 		})
+
+		When("--show-schema is specified without -e", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--show-schema")
+				setFlag(&cmd, "-p", "foo")
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--show-schema", Arg2: "-e"}))
+			})
+		})
+
+		When("--show-schema is specified without -p", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--show-schema")
+				setFlag(&cmd, "-e", "foo")
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--show-schema", Arg2: "-p"}))
+			})
+		})
+
+		When("-p is specified without --show-schema", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-e", "foo")
+				setFlag(&cmd, "-p", "bar")
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-p", Arg2: "--show-schema"}))
+			})
+		})
+	})
+
+	Describe("showing the parameter schemas of a plan", func() {
+		var executeErr error
+
+		BeforeEach(func() {
+			fakeSharedActor.IsLoggedInReturns(true)
+			setFlag(&cmd, "-e", "fake-offering")
+			setFlag(&cmd, "-p", "fake-plan")
+			setFlag(&cmd, "-b", "fake-broker")
+			setFlag(&cmd, "--show-schema")
+
+			fakeActor.GetServicePlanByNameOfferingAndBrokerReturns(
+				resources.ServicePlan{
+					Name: "fake-plan",
+					ServiceInstanceCreateSchema: map[string]interface{}{
+						"type":     "object",
+						"required": []interface{}{"size"},
+					},
+					ServiceBindingCreateSchema: map[string]interface{}{
+						"type": "object",
+					},
+				},
+				v7action.Warnings{"plan warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = cmd.Execute(nil)
+		})
+
+		It("gets the plan", func() {
+			Expect(fakeActor.GetServicePlanByNameOfferingAndBrokerCallCount()).To(Equal(1))
+			planName, offeringName, brokerName := fakeActor.GetServicePlanByNameOfferingAndBrokerArgsForCall(0)
+			Expect(planName).To(Equal("fake-plan"))
+			Expect(offeringName).To(Equal("fake-offering"))
+			Expect(brokerName).To(Equal("fake-broker"))
+			Expect(fakeActor.MarketplaceCallCount()).To(BeZero())
+		})
+
+		It("prints the published schemas and warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting parameter schemas for plan fake-plan of service offering fake-offering from service broker fake-broker in org fake-org-name / space fake-space-name as fake-username\.\.\.`))
+			Expect(testUI.Out).To(Say(`service instance create parameters: \{`))
+			Expect(testUI.Out).To(Say(`"required": \[`))
+			Expect(testUI.Out).To(Say(`"size"`))
+			Expect(testUI.Out).NotTo(Say(`service instance update parameters`))
+			Expect(testUI.Out).To(Say(`service binding create parameters: \{`))
+			Expect(testUI.Err).To(Say("plan warning"))
+		})
+
+		When("the plan publishes no schemas", func() {
+			BeforeEach(func() {
+				fakeActor.GetServicePlanByNameOfferingAndBrokerReturns(resources.ServicePlan{Name: "fake-plan"}, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`No parameter schemas published for this plan\.`))
+			})
+		})
+
+		When("getting the plan fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetServicePlanByNameOfferingAndBrokerReturns(
+					resources.ServicePlan{},
+					v7action.Warnings{"plan warning"},
+					actionerror.ServicePlanNotFoundError{PlanName: "fake-plan", OfferingName: "fake-offering"},
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: "fake-plan", OfferingName: "fake-offering"}))
+				Expect(testUI.Err).To(Say("plan warning"))
+			})
+		})
 	})
 
 	DescribeTable(
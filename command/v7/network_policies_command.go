@@ -1,12 +1,15 @@
 package v7
 
 import (
+	"bytes"
 	"fmt"
-	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/policymanifest"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -15,15 +18,19 @@ import (
 type NetworkPoliciesActor interface {
 	NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
 	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	NetworkPoliciesByOrg(orgGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
 }
 
 type NetworkPoliciesCommand struct {
 	BaseCommand
 
 	SourceApp string `long:"source" required:"false" description:"Source app to filter results by"`
+	AllSpaces bool   `long:"all-spaces" description:"List the policies of apps in every space of the targeted org"`
+	Output    string `long:"output" choice:"yaml" description:"Print the policies as a file for apply-network-policies"`
+	Graph     string `long:"graph" choice:"dot" choice:"mermaid" description:"Print the app connectivity graph in DOT or Mermaid format"`
 
-	usage           interface{} `usage:"CF_NAME network-policies [--source SOURCE_APP]"`
-	relatedCommands interface{} `related_commands:"add-network-policy, apps, remove-network-policy"`
+	usage           interface{} `usage:"CF_NAME network-policies [--source SOURCE_APP | --all-spaces] [--output yaml | --graph dot|mermaid]\n\nEXAMPLES:\n   CF_NAME network-policies --output yaml > policies.yml\n   CF_NAME network-policies --all-spaces --graph dot | dot -Tpng > policies.png"`
+	relatedCommands interface{} `related_commands:"add-network-policy, apply-network-policies, apps, remove-network-policy"`
 
 	NetworkingActor NetworkPoliciesActor
 }
@@ -46,6 +53,13 @@ func (cmd *NetworkPoliciesCommand) Setup(config command.Config, ui command.UI) e
 }
 
 func (cmd NetworkPoliciesCommand) Execute(args []string) error {
+	if cmd.SourceApp != "" && cmd.AllSpaces {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--source", "--all-spaces"}}
+	}
+	if cmd.Output != "" && cmd.Graph != "" {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--output", "--graph"}}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -56,23 +70,40 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 		return err
 	}
 
+	// YAML and graph output is meant to be redirected, so it is not
+	// preceded by the flavor text.
+	machineReadable := cmd.Output != "" || cmd.Graph != ""
+
 	var policies []cfnetworkingaction.Policy
 	var warnings cfnetworkingaction.Warnings
 
-	if cmd.SourceApp != "" {
-		cmd.UI.DisplayTextWithFlavor("Listing network policies of app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-			"SrcAppName": cmd.SourceApp,
-			"Org":        cmd.Config.TargetedOrganization().Name,
-			"Space":      cmd.Config.TargetedSpace().Name,
-			"User":       user.Name,
-		})
+	switch {
+	case cmd.SourceApp != "":
+		if !machineReadable {
+			cmd.UI.DisplayTextWithFlavor("Listing network policies of app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+				"SrcAppName": cmd.SourceApp,
+				"Org":        cmd.Config.TargetedOrganization().Name,
+				"Space":      cmd.Config.TargetedSpace().Name,
+				"User":       user.Name,
+			})
+		}
 		policies, warnings, err = cmd.NetworkingActor.NetworkPoliciesBySpaceAndAppName(cmd.Config.TargetedSpace().GUID, cmd.SourceApp)
-	} else {
-		cmd.UI.DisplayTextWithFlavor("Listing network policies in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-			"Org":   cmd.Config.TargetedOrganization().Name,
-			"Space": cmd.Config.TargetedSpace().Name,
-			"User":  user.Name,
-		})
+	case cmd.AllSpaces:
+		if !machineReadable {
+			cmd.UI.DisplayTextWithFlavor("Listing network policies in org {{.Org}} as {{.User}}...", map[string]interface{}{
+				"Org":  cmd.Config.TargetedOrganization().Name,
+				"User": user.Name,
+			})
+		}
+		policies, warnings, err = cmd.NetworkingActor.NetworkPoliciesByOrg(cmd.Config.TargetedOrganization().GUID)
+	default:
+		if !machineReadable {
+			cmd.UI.DisplayTextWithFlavor("Listing network policies in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+				"Org":   cmd.Config.TargetedOrganization().Name,
+				"Space": cmd.Config.TargetedSpace().Name,
+				"User":  user.Name,
+			})
+		}
 		policies, warnings, err = cmd.NetworkingActor.NetworkPoliciesBySpace(cmd.Config.TargetedSpace().GUID)
 	}
 
@@ -81,37 +112,123 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 		return err
 	}
 
+	switch {
+	case cmd.Output != "":
+		content, err := policymanifest.Marshal(networkPolicyManifest(policies))
+		if err != nil {
+			return err
+		}
+		_, err = cmd.UI.Writer().Write(content)
+		return err
+	case cmd.Graph != "":
+		_, err = cmd.UI.Writer().Write(renderNetworkPolicyGraph(policies, cmd.Graph))
+		return err
+	}
+
 	cmd.UI.DisplayNewline()
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("source"),
-			cmd.UI.TranslateText("destination"),
-			cmd.UI.TranslateText("protocol"),
-			cmd.UI.TranslateText("ports"),
-			cmd.UI.TranslateText("destination space"),
-			cmd.UI.TranslateText("destination org"),
-		},
+	header := []string{cmd.UI.TranslateText("source")}
+	if cmd.AllSpaces {
+		header = append(header, cmd.UI.TranslateText("source space"))
 	}
+	header = append(header,
+		cmd.UI.TranslateText("destination"),
+		cmd.UI.TranslateText("protocol"),
+		cmd.UI.TranslateText("ports"),
+		cmd.UI.TranslateText("destination space"),
+		cmd.UI.TranslateText("destination org"),
+	)
+	table := [][]string{header}
 
 	for _, policy := range policies {
-		var portEntry string
-		if policy.StartPort == policy.EndPort {
-			portEntry = strconv.Itoa(policy.StartPort)
-		} else {
-			portEntry = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+		row := []string{policy.SourceName}
+		if cmd.AllSpaces {
+			row = append(row, policy.SourceSpaceName)
 		}
-		table = append(table, []string{
-			policy.SourceName,
+		row = append(row,
 			policy.DestinationName,
 			policy.Protocol,
-			portEntry,
+			policymanifest.FormatPorts(policy.StartPort, policy.EndPort),
 			policy.DestinationSpaceName,
 			policy.DestinationOrgName,
-		})
+		)
+		table = append(table, row)
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 
 	return nil
 }
+
+// networkPolicyManifest names the apps of the policies by org, space and app
+// name, so that the policies can be applied to another foundation.
+func networkPolicyManifest(policies []cfnetworkingaction.Policy) policymanifest.Manifest {
+	manifest := policymanifest.Manifest{Policies: []policymanifest.Policy{}}
+	for _, policy := range policies {
+		manifest.Policies = append(manifest.Policies, policymanifest.Policy{
+			Source: policymanifest.App{
+				Org:   policy.SourceOrgName,
+				Space: policy.SourceSpaceName,
+				Name:  policy.SourceName,
+			},
+			Destination: policymanifest.App{
+				Org:   policy.DestinationOrgName,
+				Space: policy.DestinationSpaceName,
+				Name:  policy.DestinationName,
+			},
+			Protocol: policy.Protocol,
+			Ports:    policymanifest.FormatPorts(policy.StartPort, policy.EndPort),
+		})
+	}
+	return manifest
+}
+
+// renderNetworkPolicyGraph renders the policies as a directed graph from
+// source to destination app, in the DOT or Mermaid language. Apps are
+// labelled ORG/SPACE/APP.
+func renderNetworkPolicyGraph(policies []cfnetworkingaction.Policy, format string) []byte {
+	var nodes []string
+	nodeIDs := map[string]string{}
+	nodeID := func(org, space, app string) string {
+		label := fmt.Sprintf("%s/%s/%s", org, space, app)
+		if id, ok := nodeIDs[label]; ok {
+			return id
+		}
+		id := fmt.Sprintf("app%d", len(nodes))
+		nodeIDs[label] = id
+		nodes = append(nodes, label)
+		return id
+	}
+
+	type edge struct{ from, to, label string }
+	var edges []edge
+	for _, policy := range policies {
+		edges = append(edges, edge{
+			from:  nodeID(policy.SourceOrgName, policy.SourceSpaceName, policy.SourceName),
+			to:    nodeID(policy.DestinationOrgName, policy.DestinationSpaceName, policy.DestinationName),
+			label: fmt.Sprintf("%s %s", policy.Protocol, policymanifest.FormatPorts(policy.StartPort, policy.EndPort)),
+		})
+	}
+
+	buffer := new(bytes.Buffer)
+	if format == "mermaid" {
+		buffer.WriteString("graph LR\n")
+		for i, label := range nodes {
+			fmt.Fprintf(buffer, "  app%d[\"%s\"]\n", i, strings.ReplaceAll(label, `"`, "#quot;"))
+		}
+		for _, e := range edges {
+			fmt.Fprintf(buffer, "  %s -->|%s| %s\n", e.from, e.label, e.to)
+		}
+		return buffer.Bytes()
+	}
+
+	buffer.WriteString("digraph network_policies {\n")
+	for i, label := range nodes {
+		fmt.Fprintf(buffer, "  app%d [label=%q];\n", i, label)
+	}
+	for _, e := range edges {
+		fmt.Fprintf(buffer, "  %s -> %s [label=%q];\n", e.from, e.to, e.label)
+	}
+	buffer.WriteString("}\n")
+	return buffer.Bytes()
+}
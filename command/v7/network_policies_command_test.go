@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -56,6 +57,30 @@ var _ = Describe("network-policies Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("--source and --all-spaces are both passed", func() {
+		BeforeEach(func() {
+			cmd.SourceApp = "some-app"
+			cmd.AllSpaces = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--source", "--all-spaces"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--output and --graph are both passed", func() {
+		BeforeEach(func() {
+			cmd.Output = "yaml"
+			cmd.Graph = "dot"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--output", "--graph"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	When("checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -174,6 +199,108 @@ var _ = Describe("network-policies Command", func() {
 			})
 		})
 
+		When("exporting or graphing policies", func() {
+			BeforeEach(func() {
+				fakeNetworkPoliciesActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+					{
+						SourceName:           "app1",
+						SourceSpaceName:      "some-space",
+						SourceOrgName:        "some-org",
+						DestinationName:      "app2",
+						Protocol:             "tcp",
+						StartPort:            8080,
+						EndPort:              8080,
+						DestinationSpaceName: "other-space",
+						DestinationOrgName:   "some-org",
+					},
+				}, cfnetworkingaction.Warnings{"some-warning"}, nil)
+			})
+
+			When("--output yaml is passed", func() {
+				BeforeEach(func() {
+					cmd.Output = "yaml"
+				})
+
+				It("prints only the policies file, naming apps by org and space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).NotTo(Say("Listing network policies"))
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`network_policies:
+- source:
+    app: app1
+    space: some-space
+    org: some-org
+  destination:
+    app: app2
+    space: other-space
+    org: some-org
+  protocol: tcp
+  ports: "8080"
+`))
+					Expect(testUI.Err).To(Say("some-warning"))
+				})
+			})
+
+			When("--graph dot is passed", func() {
+				BeforeEach(func() {
+					cmd.Graph = "dot"
+				})
+
+				It("prints the graph in DOT", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`digraph network_policies {
+  app0 [label="some-org/some-space/app1"];
+  app1 [label="some-org/other-space/app2"];
+  app0 -> app1 [label="tcp 8080"];
+}
+`))
+				})
+			})
+
+			When("--graph mermaid is passed", func() {
+				BeforeEach(func() {
+					cmd.Graph = "mermaid"
+				})
+
+				It("prints the graph in Mermaid", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`graph LR
+  app0["some-org/some-space/app1"]
+  app1["some-org/other-space/app2"]
+  app0 -->|tcp 8080| app1
+`))
+				})
+			})
+		})
+
+		When("--all-spaces is passed", func() {
+			BeforeEach(func() {
+				cmd.AllSpaces = true
+				fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+				fakeNetworkPoliciesActor.NetworkPoliciesByOrgReturns([]cfnetworkingaction.Policy{
+					{
+						SourceName:           "app1",
+						SourceSpaceName:      "space-a",
+						DestinationName:      "app2",
+						Protocol:             "tcp",
+						StartPort:            8080,
+						EndPort:              8080,
+						DestinationSpaceName: "space-b",
+						DestinationOrgName:   "some-org",
+					},
+				}, cfnetworkingaction.Warnings{"some-warning"}, nil)
+			})
+
+			It("lists the policies of the org with their source space", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeNetworkPoliciesActor.NetworkPoliciesByOrgArgsForCall(0)).To(Equal("some-org-guid"))
+
+				Expect(testUI.Out).To(Say(`Listing network policies in org some-org as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`source\s+source space\s+destination\s+protocol\s+ports\s+destination space\s+destination org`))
+				Expect(testUI.Out).To(Say(`app1\s+space-a\s+app2\s+tcp\s+8080\s+space-b\s+some-org`))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
 		When("listing the policies is not successful", func() {
 			BeforeEach(func() {
 				fakeNetworkPoliciesActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{}, cfnetworkingaction.Warnings{"some-warning-1", "some-warning-2"}, actionerror.ApplicationNotFoundError{Name: srcApp})
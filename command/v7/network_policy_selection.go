@@ -0,0 +1,116 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/policymanifest"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// networkPolicySelection is the source and destination of the policies of
+// add-network-policy and remove-network-policy. Each end is either an app
+// name or a label selector.
+type networkPolicySelection struct {
+	SourceApp         string
+	DestinationApp    string
+	SourceLabels      string
+	DestinationLabels string
+}
+
+// newNetworkPolicySelection assigns the app name arguments, in order, to
+// the ends that are not selected by labels.
+func newNetworkPolicySelection(appNames []string, sourceLabels string, destinationLabels string) (networkPolicySelection, error) {
+	selection := networkPolicySelection{
+		SourceLabels:      sourceLabels,
+		DestinationLabels: destinationLabels,
+	}
+
+	var names []string
+	for _, name := range appNames {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	var ends []*string
+	var endArgs []string
+	if sourceLabels == "" {
+		ends = append(ends, &selection.SourceApp)
+		endArgs = append(endArgs, "SOURCE_APP")
+	}
+	if destinationLabels == "" {
+		ends = append(ends, &selection.DestinationApp)
+		endArgs = append(endArgs, "DESTINATION_APP")
+	}
+
+	if len(names) < len(ends) {
+		return networkPolicySelection{}, translatableerror.RequiredArgumentError{ArgumentName: endArgs[len(names)]}
+	}
+	if len(names) > len(ends) {
+		return networkPolicySelection{}, translatableerror.TooManyArgumentsError{ExtraArgument: names[len(ends)]}
+	}
+
+	for i, end := range ends {
+		*end = names[i]
+	}
+	return selection, nil
+}
+
+func (selection networkPolicySelection) usesLabels() bool {
+	return selection.SourceLabels != "" || selection.DestinationLabels != ""
+}
+
+func (selection networkPolicySelection) sourceSelector(spaceGUID string) cfnetworkingaction.PolicyAppSelector {
+	return cfnetworkingaction.PolicyAppSelector{
+		SpaceGUID:     spaceGUID,
+		AppName:       selection.SourceApp,
+		LabelSelector: selection.SourceLabels,
+	}
+}
+
+func (selection networkPolicySelection) destinationSelector(spaceGUID string) cfnetworkingaction.PolicyAppSelector {
+	return cfnetworkingaction.PolicyAppSelector{
+		SpaceGUID:     spaceGUID,
+		AppName:       selection.DestinationApp,
+		LabelSelector: selection.DestinationLabels,
+	}
+}
+
+func describePolicyEnd(appName string, labelSelector string) string {
+	if labelSelector != "" {
+		return "apps matching '" + labelSelector + "'"
+	}
+	return "app " + appName
+}
+
+// displayNetworkPolicyPairs shows every source and destination app pair
+// the policies will connect, and asks for confirmation unless force is set.
+func displayNetworkPolicyPairs(commandUI command.UI, srcApps []resources.Application, destApps []resources.Application, protocol string, startPort int, endPort int, force bool, prompt string) (bool, error) {
+	table := [][]string{
+		{
+			commandUI.TranslateText("source"),
+			commandUI.TranslateText("destination"),
+			commandUI.TranslateText("protocol"),
+			commandUI.TranslateText("ports"),
+		},
+	}
+	for _, srcApp := range srcApps {
+		for _, destApp := range destApps {
+			table = append(table, []string{srcApp.Name, destApp.Name, protocol, policymanifest.FormatPorts(startPort, endPort)})
+		}
+	}
+
+	commandUI.DisplayNewline()
+	commandUI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	commandUI.DisplayNewline()
+
+	if force {
+		return true, nil
+	}
+
+	return commandUI.DisplayBoolPrompt(false, prompt, map[string]interface{}{
+		"Count": len(srcApps) * len(destApps),
+	})
+}
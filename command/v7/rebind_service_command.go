@@ -0,0 +1,235 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type RebindServiceCommand struct {
+	BaseCommand
+
+	RequiredArgs        flag.BindServiceArgs          `positional-args:"yes"`
+	BindingName         flag.BindingName              `long:"binding-name" description:"Name to expose service instance to app process with (Default: service instance name)"`
+	ParametersAsJSON    flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Strategy            flag.DeploymentStrategy       `long:"strategy" default:"rolling" description:"Deployment strategy used to restage the app, either rolling or null."`
+	usage               interface{}                   `usage:"CF_NAME rebind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--binding-name BINDING_NAME] [--strategy rolling]\n\n   Creates a new binding between the app and the service instance, restages the app, then removes the previous binding.\n   The rolling restage keeps existing instances running until new instances with the new binding are healthy.\n   Both bindings exist until the restage is done, so they must have different names. Without --binding-name, a name is chosen that keeps the service instance name in VCAP_SERVICES.\n   If Cloud Controller does not allow a second binding, or the names are the same, the previous binding is removed before the new one is created, which can cause downtime.\n\nEXAMPLES:\n   CF_NAME rebind-service myapp mydb -c '{\"permissions\":\"read-only\"}'\n   CF_NAME rebind-service myapp mydb --binding-name primary-db"`
+	relatedCommands     interface{}                   `related_commands:"bind-service, restage, unbind-service"`
+	envCFStagingTimeout interface{}                   `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}                   `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	Stager shared.AppStager
+}
+
+func (cmd *RebindServiceCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	logCacheClient, err := logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return err
+	}
+
+	cmd.Stager = shared.NewAppStager(cmd.Actor, cmd.UI, cmd.Config, logCacheClient)
+
+	return nil
+}
+
+func (cmd RebindServiceCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Strategy.Name != constant.DeploymentStrategyRolling {
+		cmd.UI.DisplayWarning("This action will cause app downtime.")
+	}
+
+	names := cmd.names()
+	names["User"] = user.Name
+	cmd.UI.DisplayTextWithFlavor("Rebinding service instance {{.ServiceInstanceName}} to app {{.AppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", names)
+
+	oldBinding, found, err := cmd.existingBinding()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		if err := cmd.bind(cmd.BindingName.Value); err != nil {
+			return err
+		}
+		return cmd.restage()
+	}
+
+	if bindingName, ok := cmd.newBindingName(oldBinding); ok {
+		err := cmd.bind(bindingName)
+		switch err.(type) {
+		case nil:
+			return cmd.restageAndUnbind(oldBinding)
+		case actionerror.ResourceAlreadyExistsError:
+		default:
+			return err
+		}
+	}
+
+	return cmd.replaceBinding(oldBinding)
+}
+
+// restageAndUnbind restages the app with both bindings in place and then
+// removes the previous one.
+func (cmd RebindServiceCommand) restageAndUnbind(oldBinding resources.ServiceCredentialBinding) error {
+	if err := cmd.restage(); err != nil {
+		cmd.UI.DisplayWarning("The previous binding between {{.ServiceInstanceName}} and {{.AppName}} was kept because the app could not be restaged.", cmd.names())
+		return err
+	}
+
+	return cmd.unbind(oldBinding)
+}
+
+// replaceBinding removes the previous binding before creating the new one.
+// It is used when the two bindings cannot exist at the same time, either
+// because Cloud Controller only allows one binding between an app and a
+// service instance or because they would have the same name.
+func (cmd RebindServiceCommand) replaceBinding(oldBinding resources.ServiceCredentialBinding) error {
+	cmd.UI.DisplayWarning("The new binding cannot exist alongside the previous one, so the previous binding is removed first. The app may lose access to {{.ServiceInstanceName}} until it has been restaged.", cmd.names())
+
+	if err := cmd.unbind(oldBinding); err != nil {
+		return err
+	}
+
+	if err := cmd.bind(cmd.BindingName.Value); err != nil {
+		return err
+	}
+
+	return cmd.restage()
+}
+
+func (cmd RebindServiceCommand) existingBinding() (resources.ServiceCredentialBinding, bool, error) {
+	binding, warnings, err := cmd.Actor.GetServiceAppBinding(v7action.GetServiceAppBindingParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+		AppName:             cmd.RequiredArgs.AppName,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+		return binding, true, nil
+	case actionerror.ServiceBindingNotFoundError:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Binding between {{.ServiceInstanceName}} and {{.AppName}} did not exist", cmd.names())
+		return resources.ServiceCredentialBinding{}, false, nil
+	default:
+		return resources.ServiceCredentialBinding{}, false, err
+	}
+}
+
+// newBindingName returns the name for a new binding that exists alongside
+// the old one until the app has been restaged, which Cloud Controller only
+// allows when their names differ. Without --binding-name both an unnamed
+// binding and one named after the service instance expose the service under
+// the instance's name, so whichever the old binding is not is used. It
+// returns false when the requested name is the old binding's name.
+func (cmd RebindServiceCommand) newBindingName(oldBinding resources.ServiceCredentialBinding) (string, bool) {
+	name := cmd.BindingName.Value
+	if name == "" && oldBinding.Name == "" {
+		return cmd.RequiredArgs.ServiceInstanceName, true
+	}
+
+	return name, name != oldBinding.Name
+}
+
+func (cmd RebindServiceCommand) bind(bindingName string) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Creating the new binding...")
+
+	stream, warnings, err := cmd.Actor.CreateServiceAppBinding(v7action.CreateServiceAppBindingParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+		AppName:             cmd.RequiredArgs.AppName,
+		BindingName:         bindingName,
+		Parameters:          types.OptionalObject(cmd.ParametersAsJSON),
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if _, err := shared.WaitForResult(stream, cmd.UI, true); err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd RebindServiceCommand) unbind(oldBinding resources.ServiceCredentialBinding) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Removing the previous binding...")
+
+	stream, warnings, err := cmd.Actor.DeleteServiceAppBindingByGUID(oldBinding.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if _, err := shared.WaitForResult(stream, cmd.UI, true); err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd RebindServiceCommand) restage() error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Restaging app {{.AppName}}...", cmd.names())
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	pkg, warnings, err := cmd.Actor.GetNewestReadyPackageForApplication(app)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return mapErr(cmd.Config, cmd.RequiredArgs.AppName, err)
+	}
+
+	err = cmd.Stager.StageAndStart(
+		app,
+		cmd.Config.TargetedSpace(),
+		cmd.Config.TargetedOrganization(),
+		pkg.GUID,
+		cmd.Strategy.Name,
+		false,
+		constant.ApplicationRestarting,
+	)
+	if err != nil {
+		return mapErr(cmd.Config, cmd.RequiredArgs.AppName, err)
+	}
+
+	return nil
+}
+
+func (cmd RebindServiceCommand) names() map[string]interface{} {
+	return map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstanceName,
+		"AppName":             cmd.RequiredArgs.AppName,
+		"Org":                 cmd.Config.TargetedOrganization().Name,
+		"Space":               cmd.Config.TargetedSpace().Name,
+	}
+}
@@ -0,0 +1,357 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rebind-service Command", func() {
+	var (
+		cmd             v7.RebindServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeAppStager   *sharedfakes.FakeAppStager
+		executeErr      error
+	)
+
+	const (
+		fakeUserName            = "fake-user-name"
+		fakeServiceInstanceName = "fake-service-instance-name"
+		fakeAppName             = "fake-app-name"
+		fakeOrgName             = "fake-org-name"
+		fakeSpaceName           = "fake-space-name"
+		fakeSpaceGUID           = "fake-space-guid"
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeAppStager = new(sharedfakes.FakeAppStager)
+
+		cmd = v7.RebindServiceCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Stager: fakeAppStager,
+		}
+
+		fakeConfig.BinaryNameReturns("some-binary-name")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: fakeOrgName})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: fakeSpaceGUID, Name: fakeSpaceName})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: fakeUserName}, nil)
+
+		fakeActor.GetServiceAppBindingReturns(
+			resources.ServiceCredentialBinding{GUID: "old-binding-guid"},
+			v7action.Warnings{"get binding warning"},
+			nil,
+		)
+		fakeActor.DeleteServiceAppBindingByGUIDReturns(nil, v7action.Warnings{"delete warning"}, nil)
+		fakeActor.CreateServiceAppBindingReturns(nil, v7action.Warnings{"create warning"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			resources.Application{Name: fakeAppName, GUID: "fake-app-guid"},
+			v7action.Warnings{"get app warning"},
+			nil,
+		)
+		fakeActor.GetNewestReadyPackageForApplicationReturns(
+			resources.Package{GUID: "fake-package-guid"},
+			v7action.Warnings{"get package warning"},
+			nil,
+		)
+
+		setPositionalFlags(&cmd, fakeAppName, fakeServiceInstanceName)
+		setFlag(&cmd, "--strategy", "rolling")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in, and targeting an org and space", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		actualOrg, actualSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(actualOrg).To(BeTrue())
+		Expect(actualSpace).To(BeTrue())
+	})
+
+	It("looks up the existing binding", func() {
+		Expect(fakeActor.GetServiceAppBindingCallCount()).To(Equal(1))
+		Expect(fakeActor.GetServiceAppBindingArgsForCall(0)).To(Equal(v7action.GetServiceAppBindingParams{
+			SpaceGUID:           fakeSpaceGUID,
+			ServiceInstanceName: fakeServiceInstanceName,
+			AppName:             fakeAppName,
+		}))
+	})
+
+	It("creates the new binding, named after the service instance so it can exist alongside the unnamed old one", func() {
+		Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+		Expect(fakeActor.CreateServiceAppBindingArgsForCall(0)).To(Equal(v7action.CreateServiceAppBindingParams{
+			SpaceGUID:           fakeSpaceGUID,
+			ServiceInstanceName: fakeServiceInstanceName,
+			AppName:             fakeAppName,
+			BindingName:         fakeServiceInstanceName,
+		}))
+	})
+
+	Describe("removing the old binding", func() {
+		var deletesBeforeRestage int
+
+		BeforeEach(func() {
+			deletesBeforeRestage = -1
+			fakeAppStager.StageAndStartStub = func(resources.Application, configv3.Space, configv3.Organization, string, constant.DeploymentStrategy, bool, constant.ApplicationAction) error {
+				deletesBeforeRestage = fakeActor.DeleteServiceAppBindingByGUIDCallCount()
+				return nil
+			}
+		})
+
+		It("happens only after restaging", func() {
+			Expect(deletesBeforeRestage).To(Equal(0))
+			Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteServiceAppBindingByGUIDArgsForCall(0)).To(Equal("old-binding-guid"))
+			Expect(fakeActor.DeleteServiceAppBindingCallCount()).To(BeZero())
+		})
+	})
+
+	It("restages the app with a rolling deployment", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal(fakeAppName))
+		Expect(spaceGUID).To(Equal(fakeSpaceGUID))
+
+		Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+		app, space, org, pkgGUID, strategy, noWait, appAction := fakeAppStager.StageAndStartArgsForCall(0)
+		Expect(app.GUID).To(Equal("fake-app-guid"))
+		Expect(space.Name).To(Equal(fakeSpaceName))
+		Expect(org.Name).To(Equal(fakeOrgName))
+		Expect(pkgGUID).To(Equal("fake-package-guid"))
+		Expect(strategy).To(Equal(constant.DeploymentStrategyRolling))
+		Expect(noWait).To(BeFalse())
+		Expect(appAction).To(Equal(constant.ApplicationRestarting))
+	})
+
+	It("displays messages and warnings", func() {
+		Expect(testUI.Out).To(SatisfyAll(
+			Say(`Rebinding service instance %s to app %s in org %s / space %s as %s\.\.\.\n`, fakeServiceInstanceName, fakeAppName, fakeOrgName, fakeSpaceName, fakeUserName),
+			Say(`Creating the new binding\.\.\.\n`),
+			Say(`OK\n`),
+			Say(`Restaging app %s\.\.\.\n`, fakeAppName),
+			Say(`Removing the previous binding\.\.\.\n`),
+			Say(`OK\n`),
+		))
+		Expect(testUI.Err).NotTo(Say("This action will cause app downtime"))
+		Expect(testUI.Err).To(SatisfyAll(
+			Say("get binding warning"),
+			Say("create warning"),
+			Say("get app warning"),
+			Say("get package warning"),
+			Say("delete warning"),
+		))
+	})
+
+	When("a binding name and parameters are provided", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--binding-name", "new-name")
+			setFlag(&cmd, "-c", `{"foo": "bar"}`)
+		})
+
+		It("uses them for the new binding", func() {
+			params := fakeActor.CreateServiceAppBindingArgsForCall(0)
+			Expect(params.BindingName).To(Equal("new-name"))
+			Expect(params.Parameters).To(Equal(types.NewOptionalObject(map[string]interface{}{"foo": "bar"})))
+		})
+
+		When("the existing binding already has that name", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceAppBindingReturns(resources.ServiceCredentialBinding{GUID: "old-binding-guid", Name: "new-name"}, nil, nil)
+			})
+
+			It("removes the existing binding before creating the new one", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say(`The new binding cannot exist alongside the previous one, so the previous binding is removed first\.`))
+				Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(Equal(1))
+				Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+				Expect(fakeActor.CreateServiceAppBindingArgsForCall(0).BindingName).To(Equal("new-name"))
+				Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	When("Cloud Controller does not allow a second binding", func() {
+		var deletesBeforeSecondCreate int
+
+		BeforeEach(func() {
+			deletesBeforeSecondCreate = -1
+			fakeActor.CreateServiceAppBindingReturnsOnCall(0, nil, v7action.Warnings{"create warning"}, actionerror.ResourceAlreadyExistsError{
+				Message: "The app is already bound to the service instance",
+			})
+			fakeActor.DeleteServiceAppBindingByGUIDStub = func(string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+				deletesBeforeSecondCreate = fakeActor.CreateServiceAppBindingCallCount()
+				return nil, v7action.Warnings{"delete warning"}, nil
+			}
+		})
+
+		It("removes the existing binding, creates the new one and restages, warning about downtime", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Err).To(Say(`The new binding cannot exist alongside the previous one, so the previous binding is removed first\. The app may lose access to %s until it has been restaged\.`, fakeServiceInstanceName))
+
+			Expect(deletesBeforeSecondCreate).To(Equal(1))
+			Expect(fakeActor.DeleteServiceAppBindingByGUIDArgsForCall(0)).To(Equal("old-binding-guid"))
+			Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(2))
+			Expect(fakeActor.CreateServiceAppBindingArgsForCall(1).BindingName).To(BeEmpty())
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+		})
+
+		When("removing the existing binding fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceAppBindingByGUIDStub = nil
+				fakeActor.DeleteServiceAppBindingByGUIDReturns(nil, nil, errors.New("delete boom"))
+			})
+
+			It("returns the error without creating the new binding", func() {
+				Expect(executeErr).To(MatchError("delete boom"))
+				Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+				Expect(fakeAppStager.StageAndStartCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	When("the existing binding is named after the service instance", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceAppBindingReturns(resources.ServiceCredentialBinding{GUID: "old-binding-guid", Name: fakeServiceInstanceName}, nil, nil)
+		})
+
+		It("leaves the new binding unnamed", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.CreateServiceAppBindingArgsForCall(0).BindingName).To(BeEmpty())
+		})
+	})
+
+	When("the strategy is not rolling", func() {
+		BeforeEach(func() {
+			cmd.Strategy.Name = constant.DeploymentStrategyDefault
+		})
+
+		It("warns about downtime", func() {
+			Expect(testUI.Err).To(Say("This action will cause app downtime."))
+			_, _, _, _, strategy, _, _ := fakeAppStager.StageAndStartArgsForCall(0)
+			Expect(strategy).To(Equal(constant.DeploymentStrategyDefault))
+		})
+	})
+
+	Describe("waiting for the binding operations", func() {
+		BeforeEach(func() {
+			deleteStream := make(chan v7action.PollJobEvent)
+			fakeActor.DeleteServiceAppBindingByGUIDReturns(deleteStream, nil, nil)
+			go func() {
+				deleteStream <- v7action.PollJobEvent{State: v7action.JobPolling, Warnings: v7action.Warnings{"delete poll warning"}}
+				deleteStream <- v7action.PollJobEvent{State: v7action.JobComplete}
+				close(deleteStream)
+			}()
+
+			createStream := make(chan v7action.PollJobEvent)
+			fakeActor.CreateServiceAppBindingReturns(createStream, nil, nil)
+			go func() {
+				createStream <- v7action.PollJobEvent{State: v7action.JobPolling, Warnings: v7action.Warnings{"create poll warning"}}
+				createStream <- v7action.PollJobEvent{State: v7action.JobComplete}
+				close(createStream)
+			}()
+		})
+
+		It("waits for each to complete before moving on", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Err).To(SatisfyAll(
+				Say("create poll warning"),
+				Say("delete poll warning"),
+			))
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+		})
+	})
+
+	When("the app is not bound to the service instance", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceAppBindingReturns(resources.ServiceCredentialBinding{}, v7action.Warnings{"get binding warning"}, actionerror.ServiceBindingNotFoundError{})
+		})
+
+		It("says so, binds it with the given name and restages", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Binding between %s and %s did not exist`, fakeServiceInstanceName, fakeAppName))
+			Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+			Expect(fakeActor.CreateServiceAppBindingArgsForCall(0).BindingName).To(BeEmpty())
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(BeZero())
+		})
+	})
+
+	When("looking up the existing binding fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceAppBindingReturns(resources.ServiceCredentialBinding{}, v7action.Warnings{"get binding warning"}, errors.New("get boom"))
+		})
+
+		It("returns the error without binding", func() {
+			Expect(executeErr).To(MatchError("get boom"))
+			Expect(fakeActor.CreateServiceAppBindingCallCount()).To(BeZero())
+			Expect(fakeAppStager.StageAndStartCallCount()).To(BeZero())
+		})
+	})
+
+	When("creating the new binding fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceAppBindingReturns(nil, v7action.Warnings{"create warning"}, errors.New("create boom"))
+		})
+
+		It("returns the error and keeps the existing binding", func() {
+			Expect(executeErr).To(MatchError("create boom"))
+			Expect(fakeAppStager.StageAndStartCallCount()).To(BeZero())
+			Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(BeZero())
+		})
+	})
+
+	When("restaging fails", func() {
+		BeforeEach(func() {
+			fakeAppStager.StageAndStartReturns(actionerror.AllInstancesCrashedError{})
+		})
+
+		It("maps the error and keeps the existing binding", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationUnableToStartError{
+				AppName:    fakeAppName,
+				BinaryName: "some-binary-name",
+			}))
+			Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(BeZero())
+			Expect(testUI.Err).To(Say(`The previous binding between %s and %s was kept because the app could not be restaged\.`, fakeServiceInstanceName, fakeAppName))
+		})
+	})
+
+	When("removing the previous binding fails", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteServiceAppBindingByGUIDReturns(nil, v7action.Warnings{"delete warning"}, errors.New("delete boom"))
+		})
+
+		It("returns the error after restaging", func() {
+			Expect(executeErr).To(MatchError("delete boom"))
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+		})
+	})
+})
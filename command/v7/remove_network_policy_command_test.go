@@ -261,5 +261,71 @@ var _ = Describe("remove-network-policy Command", func() {
 				Expect(testUI.Out).ToNot(Say("OK"))
 			})
 		})
+
+		When("the destination apps are selected by labels", func() {
+			var input *Buffer
+
+			BeforeEach(func() {
+				input = NewBuffer()
+				testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+				cmd.UI = testUI
+
+				cmd.DestinationLabels = "tier=api"
+				cmd.RequiredArgs = flag.RemoveNetworkPolicyArgsV7{SourceApp: "frontend"}
+
+				fakeNetworkPolicyActor.GetPolicyAppsReturnsOnCall(0, []resources.Application{{Name: "frontend"}}, nil, nil)
+				fakeNetworkPolicyActor.GetPolicyAppsReturnsOnCall(1, []resources.Application{{Name: "api-1"}, {Name: "api-2"}}, cfnetworkingaction.Warnings{"destination-apps-warning"}, nil)
+
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("removes the policies for every pair after confirmation", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeNetworkPolicyActor.GetPolicyAppsArgsForCall(0)).To(Equal(cfnetworkingaction.PolicyAppSelector{SpaceGUID: "some-space-guid", AppName: "frontend"}))
+				Expect(fakeNetworkPolicyActor.GetPolicyAppsArgsForCall(1)).To(Equal(cfnetworkingaction.PolicyAppSelector{SpaceGUID: "some-space-guid", LabelSelector: "tier=api"}))
+
+				Expect(testUI.Out).To(Say(`Removing network policies from app frontend in org some-org / space some-space to apps matching 'tier=api' in org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`frontend\s+api-1\s+tcp\s+8080-8081`))
+				Expect(testUI.Out).To(Say(`frontend\s+api-2\s+tcp\s+8080-8081`))
+				Expect(testUI.Out).To(Say(`Remove 2 network policies\?`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("destination-apps-warning"))
+
+				Expect(fakeNetworkPolicyActor.RemoveNetworkPoliciesCallCount()).To(Equal(1))
+				_, destApps, _, startPort, endPort := fakeNetworkPolicyActor.RemoveNetworkPoliciesArgsForCall(0)
+				Expect(destApps).To(HaveLen(2))
+				Expect(startPort).To(Equal(8080))
+				Expect(endPort).To(Equal(8081))
+				Expect(fakeNetworkPolicyActor.RemoveNetworkPolicyCallCount()).To(Equal(0))
+			})
+
+			When("none of the policies exist", func() {
+				BeforeEach(func() {
+					fakeNetworkPolicyActor.RemoveNetworkPoliciesReturns(actionerror.PolicyDoesNotExistError{})
+				})
+
+				It("says so and displays OK", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Policies do not exist."))
+					Expect(testUI.Out).To(Say("OK"))
+				})
+			})
+
+			When("the user declines", func() {
+				BeforeEach(func() {
+					input.Clear()
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("does not remove the policies", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Network policies have not been removed."))
+					Expect(fakeNetworkPolicyActor.RemoveNetworkPoliciesCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
@@ -0,0 +1,149 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type RotateServiceKeyCommand struct {
+	BaseCommand
+
+	RequiredArgs     flag.ServiceInstanceKey       `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters for the new key, provided either in-line or in a file."`
+	KeepOld          flag.Duration                 `long:"keep-old" description:"Keep the previous key for this long before deleting it, e.g. 30s, 15m or 1h"`
+	Wait             bool                          `short:"w" long:"wait" description:"Wait for the deletion of the previous key to complete"`
+	usage            interface{}                   `usage:"CF_NAME rotate-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON] [--keep-old DURATION] [--wait]\n\n   The new key is named SERVICE_KEY-vN, where N is one more than the latest existing version.\n   Every previous version of the key is then deleted, including ones left behind by earlier interrupted rotations.\n\nEXAMPLES:\n   CF_NAME rotate-service-key mydb mykey\n   CF_NAME rotate-service-key mydb mykey --keep-old 1h"`
+	relatedCommands  interface{}                   `related_commands:"create-service-key, delete-service-key, service-key, service-keys"`
+
+	Sleep func(time.Duration)
+}
+
+func (cmd *RotateServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Sleep = time.Sleep
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (cmd RotateServiceKeyCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rotating service key {{.ServiceKey}} for service instance {{.ServiceInstance}} as {{.User}}...", map[string]interface{}{
+		"ServiceKey":      cmd.RequiredArgs.ServiceKey,
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"User":            user.Name,
+	})
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	rotation, warnings, err := cmd.Actor.GetServiceKeyRotation(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.createKey(rotation.NewKeyName, spaceGUID); err != nil {
+		return err
+	}
+
+	if cmd.KeepOld.Value > 0 {
+		cmd.keepOldKeys(rotation.PreviousKeyNames)
+	}
+
+	for _, keyName := range rotation.PreviousKeyNames {
+		if err := cmd.deleteKey(keyName, spaceGUID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// keepOldKeys waits for the --keep-old grace period. The wait happens in
+// this process, so it says how to delete the previous keys by hand should
+// the command be interrupted.
+func (cmd RotateServiceKeyCommand) keepOldKeys(keyNames []string) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Keeping previous service keys {{.ServiceKeys}} for {{.Duration}}, until {{.Time}}, then deleting them...", map[string]interface{}{
+		"ServiceKeys": strings.Join(keyNames, ", "),
+		"Duration":    cmd.KeepOld.Value.String(),
+		"Time":        time.Now().Add(cmd.KeepOld.Value).Format(time.RFC3339),
+	})
+	cmd.UI.DisplayText("If this command is interrupted, delete them with:")
+	for _, keyName := range keyNames {
+		cmd.UI.DisplayText("   {{.Command}}", map[string]interface{}{
+			"Command": fmt.Sprintf("%s delete-service-key %s %s -f", cmd.Config.BinaryName(), cmd.RequiredArgs.ServiceInstance, keyName),
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	cmd.Sleep(cmd.KeepOld.Value)
+}
+
+func (cmd RotateServiceKeyCommand) createKey(keyName, spaceGUID string) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Creating service key {{.ServiceKey}}...", map[string]interface{}{
+		"ServiceKey": keyName,
+	})
+
+	stream, warnings, err := cmd.Actor.CreateServiceKey(v7action.CreateServiceKeyParams{
+		SpaceGUID:           spaceGUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstance,
+		ServiceKeyName:      keyName,
+		Parameters:          types.OptionalObject(cmd.ParametersAsJSON),
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if _, err := shared.WaitForResult(stream, cmd.UI, true); err != nil {
+		return err
+	}
+
+	details, warnings, err := cmd.Actor.GetServiceKeyDetailsByServiceInstanceAndName(cmd.RequiredArgs.ServiceInstance, keyName, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return cmd.UI.DisplayJSON("", details)
+}
+
+func (cmd RotateServiceKeyCommand) deleteKey(keyName, spaceGUID string) error {
+	cmd.UI.DisplayTextWithFlavor("Deleting previous service key {{.ServiceKey}}...", map[string]interface{}{
+		"ServiceKey": keyName,
+	})
+
+	stream, warnings, err := cmd.Actor.DeleteServiceKeyByServiceInstanceAndName(cmd.RequiredArgs.ServiceInstance, keyName, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	completed, err := shared.WaitForResult(stream, cmd.UI, cmd.Wait)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	if !completed {
+		cmd.UI.DisplayText("Delete in progress.")
+	}
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rotate-service-key Command", func() {
+	var (
+		cmd             v7.RotateServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		executeErr      error
+		fakeActor       *v7fakes.FakeActor
+		sleptFor        []time.Duration
+	)
+
+	const (
+		fakeUserName            = "fake-user-name"
+		fakeServiceInstanceName = "fake-service-instance-name"
+		fakeServiceKeyName      = "fake-key"
+		fakeSpaceGUID           = "fake-space-guid"
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		sleptFor = nil
+
+		cmd = v7.RotateServiceKeyCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Sleep: func(d time.Duration) { sleptFor = append(sleptFor, d) },
+		}
+
+		fakeConfig.BinaryNameReturns("some-binary-name")
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: fakeSpaceGUID})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: fakeUserName}, nil)
+
+		fakeActor.GetServiceKeyRotationReturns(
+			v7action.ServiceKeyRotation{PreviousKeyNames: []string{"fake-key-v2"}, NewKeyName: "fake-key-v3"},
+			v7action.Warnings{"rotation warning"},
+			nil,
+		)
+		fakeActor.CreateServiceKeyReturns(nil, v7action.Warnings{"create warning"}, nil)
+		fakeActor.GetServiceKeyDetailsByServiceInstanceAndNameReturns(
+			resources.ServiceCredentialBindingDetails{
+				Credentials: map[string]interface{}{"password": "new-secret"},
+			},
+			v7action.Warnings{"details warning"},
+			nil,
+		)
+		fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns(nil, v7action.Warnings{"delete warning"}, nil)
+
+		setPositionalFlags(&cmd, fakeServiceInstanceName, fakeServiceKeyName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in, and targeting an org and space", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		actualOrg, actualSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(actualOrg).To(BeTrue())
+		Expect(actualSpace).To(BeTrue())
+	})
+
+	It("works out the key names", func() {
+		Expect(fakeActor.GetServiceKeyRotationCallCount()).To(Equal(1))
+		instanceName, keyName, spaceGUID := fakeActor.GetServiceKeyRotationArgsForCall(0)
+		Expect(instanceName).To(Equal(fakeServiceInstanceName))
+		Expect(keyName).To(Equal(fakeServiceKeyName))
+		Expect(spaceGUID).To(Equal(fakeSpaceGUID))
+	})
+
+	It("creates the new key, shows its credentials and deletes the previous key immediately", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.CreateServiceKeyCallCount()).To(Equal(1))
+		Expect(fakeActor.CreateServiceKeyArgsForCall(0)).To(Equal(v7action.CreateServiceKeyParams{
+			SpaceGUID:           fakeSpaceGUID,
+			ServiceInstanceName: fakeServiceInstanceName,
+			ServiceKeyName:      "fake-key-v3",
+		}))
+
+		Expect(fakeActor.GetServiceKeyDetailsByServiceInstanceAndNameCallCount()).To(Equal(1))
+		instanceName, keyName, spaceGUID := fakeActor.GetServiceKeyDetailsByServiceInstanceAndNameArgsForCall(0)
+		Expect(instanceName).To(Equal(fakeServiceInstanceName))
+		Expect(keyName).To(Equal("fake-key-v3"))
+		Expect(spaceGUID).To(Equal(fakeSpaceGUID))
+
+		Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(1))
+		instanceName, keyName, spaceGUID = fakeActor.DeleteServiceKeyByServiceInstanceAndNameArgsForCall(0)
+		Expect(instanceName).To(Equal(fakeServiceInstanceName))
+		Expect(keyName).To(Equal("fake-key-v2"))
+		Expect(spaceGUID).To(Equal(fakeSpaceGUID))
+
+		Expect(sleptFor).To(BeEmpty())
+	})
+
+	It("displays messages and warnings", func() {
+		Expect(testUI.Out).To(SatisfyAll(
+			Say(`Rotating service key fake-key for service instance %s as %s\.\.\.\n`, fakeServiceInstanceName, fakeUserName),
+			Say(`Creating service key fake-key-v3\.\.\.\n`),
+			Say(`OK\n`),
+			Say(`"password": "new-secret"`),
+			Say(`Deleting previous service key fake-key-v2\.\.\.\n`),
+			Say(`OK\n`),
+		))
+
+		Expect(testUI.Err).To(SatisfyAll(
+			Say("rotation warning"),
+			Say("create warning"),
+			Say("details warning"),
+			Say("delete warning"),
+		))
+	})
+
+	When("parameters are provided", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "-c", `{"foo": "bar"}`)
+		})
+
+		It("passes them to the new key", func() {
+			Expect(fakeActor.CreateServiceKeyArgsForCall(0).Parameters).To(Equal(types.NewOptionalObject(map[string]interface{}{"foo": "bar"})))
+		})
+	})
+
+	When("--keep-old is provided", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--keep-old", "90m")
+		})
+
+		It("waits for the grace period before deleting the previous key, saying how to delete it if interrupted", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(sleptFor).To(Equal([]time.Duration{90 * time.Minute}))
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`Keeping previous service keys fake-key-v2 for 1h30m0s, until \d{4}-\d\d-\d\dT\d\d:\d\d:\d\d.*, then deleting them\.\.\.\n`),
+				Say(`If this command is interrupted, delete them with:\n`),
+				Say(`   some-binary-name delete-service-key %s fake-key-v2 -f\n`, fakeServiceInstanceName),
+				Say(`Deleting previous service key fake-key-v2\.\.\.\n`),
+			))
+			Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(1))
+		})
+	})
+
+	When("older versions of the key were left behind", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeyRotationReturns(
+				v7action.ServiceKeyRotation{PreviousKeyNames: []string{"fake-key", "fake-key-v2"}, NewKeyName: "fake-key-v3"},
+				nil,
+				nil,
+			)
+		})
+
+		It("deletes every previous version", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(2))
+			_, keyName, _ := fakeActor.DeleteServiceKeyByServiceInstanceAndNameArgsForCall(0)
+			Expect(keyName).To(Equal("fake-key"))
+			_, keyName, _ = fakeActor.DeleteServiceKeyByServiceInstanceAndNameArgsForCall(1)
+			Expect(keyName).To(Equal("fake-key-v2"))
+		})
+
+		When("deleting one of them fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturnsOnCall(0, nil, nil, errors.New("delete failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("delete failed"))
+				Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("waiting for the new key", func() {
+		var fakeStream chan v7action.PollJobEvent
+
+		BeforeEach(func() {
+			fakeStream = make(chan v7action.PollJobEvent)
+			fakeActor.CreateServiceKeyReturns(fakeStream, nil, nil)
+
+			go func() {
+				fakeStream <- v7action.PollJobEvent{State: v7action.JobPolling, Warnings: v7action.Warnings{"poll warning"}}
+				fakeStream <- v7action.PollJobEvent{State: v7action.JobComplete}
+				close(fakeStream)
+			}()
+		})
+
+		It("waits for creation to complete even without --wait", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Waiting for the operation to complete\.\.`))
+			Expect(testUI.Err).To(Say("poll warning"))
+			Expect(fakeActor.GetServiceKeyDetailsByServiceInstanceAndNameCallCount()).To(Equal(1))
+		})
+	})
+
+	When("the delete is still in progress", func() {
+		BeforeEach(func() {
+			fakeStream := make(chan v7action.PollJobEvent)
+			fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns(fakeStream, nil, nil)
+
+			go func() {
+				fakeStream <- v7action.PollJobEvent{State: v7action.JobPolling}
+			}()
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`OK\n`),
+				Say(`Delete in progress\.\n`),
+			))
+		})
+	})
+
+	When("no version of the key exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeyRotationReturns(
+				v7action.ServiceKeyRotation{},
+				v7action.Warnings{"rotation warning"},
+				actionerror.ServiceKeyNotFoundError{KeyName: fakeServiceKeyName, ServiceInstanceName: fakeServiceInstanceName},
+			)
+		})
+
+		It("returns the error without creating anything", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceKeyNotFoundError{KeyName: fakeServiceKeyName, ServiceInstanceName: fakeServiceInstanceName}))
+			Expect(testUI.Err).To(Say("rotation warning"))
+			Expect(fakeActor.CreateServiceKeyCallCount()).To(BeZero())
+		})
+	})
+
+	When("creating the new key fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceKeyReturns(nil, v7action.Warnings{"create warning"}, errors.New("create boom"))
+		})
+
+		It("returns the error and keeps the previous key", func() {
+			Expect(executeErr).To(MatchError("create boom"))
+			Expect(testUI.Err).To(Say("create warning"))
+			Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(BeZero())
+		})
+	})
+
+	When("getting the new credentials fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeyDetailsByServiceInstanceAndNameReturns(
+				resources.ServiceCredentialBindingDetails{},
+				v7action.Warnings{"details warning"},
+				errors.New("details boom"),
+			)
+		})
+
+		It("returns the error and keeps the previous key", func() {
+			Expect(executeErr).To(MatchError("details boom"))
+			Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(BeZero())
+		})
+	})
+
+	When("deleting the previous key fails", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns(nil, v7action.Warnings{"delete warning"}, errors.New("delete boom"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("delete boom"))
+			Expect(testUI.Err).To(Say("delete warning"))
+		})
+	})
+
+	When("getting the user fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("no user"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("no user"))
+			Expect(fakeActor.GetServiceKeyRotationCallCount()).To(BeZero())
+		})
+	})
+})
@@ -1,10 +1,13 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 
+	"sort"
 	"strconv"
+	"strings"
 )
 
 type RouteCommand struct {
@@ -97,6 +100,7 @@ func (cmd RouteCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("port:"), port},
 		{cmd.UI.TranslateText("path:"), route.Path},
 		{cmd.UI.TranslateText("protocol:"), route.Protocol},
+		{cmd.UI.TranslateText("options:"), formatRouteOptions(route.Options)},
 	}
 
 	cmd.UI.DisplayKeyValueTable("", table, 3)
@@ -117,10 +121,12 @@ func (cmd RouteCommand) displayDestinations(route resources.Route, appMap map[st
 				cmd.UI.TranslateText("process"),
 				cmd.UI.TranslateText("port"),
 				cmd.UI.TranslateText("app-protocol"),
+				cmd.UI.TranslateText("traffic"),
 			},
 		}
 
-		for _, destination := range destinations {
+		shares := v7action.RouteTrafficShares(destinations)
+		for i, destination := range destinations {
 			port := ""
 			if destination.Port != 0 {
 				port = strconv.Itoa(destination.Port)
@@ -130,9 +136,23 @@ func (cmd RouteCommand) displayDestinations(route resources.Route, appMap map[st
 				destination.App.Process.Type,
 				port,
 				destination.Protocol,
+				strconv.Itoa(shares[i]) + "%",
 			})
 		}
 
 		cmd.UI.DisplayKeyValueTable("\t", keyValueTable, 3)
 	}
 }
+
+// formatRouteOptions lists the route options as KEY=VALUE pairs, sorted by
+// key.
+func formatRouteOptions(options map[string]*string) string {
+	var pairs []string
+	for key, value := range options {
+		if value != nil {
+			pairs = append(pairs, key+"="+*value)
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
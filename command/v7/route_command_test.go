@@ -192,7 +192,8 @@ var _ = Describe("route Command", func() {
 			destinationB := resources.RouteDestination{App: destAppB, Port: 1337, Protocol: "http2"}
 
 			destinations := []resources.RouteDestination{destinationA, destinationB}
-			route := resources.Route{GUID: "route-guid", Host: cmd.Hostname, Path: cmd.Path.Path, Protocol: "http", Destinations: destinations}
+			roundRobin := "round-robin"
+			route := resources.Route{GUID: "route-guid", Host: cmd.Hostname, Path: cmd.Path.Path, Protocol: "http", Destinations: destinations, Options: map[string]*string{"loadbalancing": &roundRobin}}
 
 			fakeActor.GetRouteByAttributesReturns(
 				route,
@@ -218,11 +219,12 @@ var _ = Describe("route Command", func() {
 			Expect(testUI.Out).To(Say(`host:\s+%s`, cmd.Hostname))
 			Expect(testUI.Out).To(Say(`path:\s+%s`, cmd.Path.Path))
 			Expect(testUI.Out).To(Say(`protocol:\s+http`))
+			Expect(testUI.Out).To(Say(`options:\s+loadbalancing=round-robin`))
 			Expect(testUI.Out).To(Say(`\n`))
 			Expect(testUI.Out).To(Say(`Destinations:`))
-			Expect(testUI.Out).To(Say(`\s+app\s+process\s+port\s+app-protocol`))
-			Expect(testUI.Out).To(Say(`\s+app-name\s+web\s+8080\s+http1`))
-			Expect(testUI.Out).To(Say(`\s+other-app-name\s+web\s+1337\s+http2`))
+			Expect(testUI.Out).To(Say(`\s+app\s+process\s+port\s+app-protocol\s+traffic`))
+			Expect(testUI.Out).To(Say(`\s+app-name\s+web\s+8080\s+http1\s+50%`))
+			Expect(testUI.Out).To(Say(`\s+other-app-name\s+web\s+1337\s+http2\s+50%`))
 
 			Expect(fakeActor.GetRouteByAttributesCallCount()).To(Equal(1))
 			givenDomain, givenHostname, givenPath, givenPort := fakeActor.GetRouteByAttributesArgsForCall(0)
@@ -231,6 +233,29 @@ var _ = Describe("route Command", func() {
 			Expect(givenPath).To(Equal("/some-path"))
 			Expect(givenPort).To(Equal(0))
 		})
+
+		When("the route is weighted", func() {
+			BeforeEach(func() {
+				ninety, ten := 90, 10
+				fakeActor.GetRouteByAttributesReturns(
+					resources.Route{
+						GUID: "route-guid",
+						Destinations: []resources.RouteDestination{
+							{App: resources.RouteDestinationApp{GUID: "abc", Process: struct{ Type string }{"web"}}, Weight: &ninety},
+							{App: resources.RouteDestinationApp{GUID: "123", Process: struct{ Type string }{"web"}}, Weight: &ten},
+						},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("displays the traffic split", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\s+app-name\s+web\s+90%`))
+				Expect(testUI.Out).To(Say(`\s+other-app-name\s+web\s+10%`))
+			})
+		})
 	})
 	Describe("RouteRetrieval display logic", func() {
 		When("passing in just a domain", func() {
@@ -0,0 +1,102 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type RouterGroupCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.RouterGroup `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME router-group ROUTER_GROUP\n\n   Shows the reservable ports of the router group, the TCP routes using them and how many\n   are still free. Only routes you can see are listed."`
+	relatedCommands interface{}      `related_commands:"create-route, router-groups, routes"`
+}
+
+func (cmd RouterGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	currentUser, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting router group {{.RouterGroup}} as {{.CurrentUser}}...", map[string]interface{}{
+		"RouterGroup": cmd.RequiredArgs.RouterGroup,
+		"CurrentUser": currentUser.Name,
+	})
+
+	details, warnings, err := cmd.Actor.GetRouterGroupDetails(cmd.RequiredArgs.RouterGroup)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	reservablePorts := 0
+	var portRanges []string
+	for _, portRange := range details.ReservablePorts {
+		reservablePorts += portRange.End - portRange.Start + 1
+		portRanges = append(portRanges, formatPortRange(portRange))
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), details.RouterGroup.Name},
+		{cmd.UI.TranslateText("type:"), details.RouterGroup.Type},
+		{cmd.UI.TranslateText("reservable ports:"), strings.Join(portRanges, ", ")},
+		{cmd.UI.TranslateText("ports in use:"), fmt.Sprint(portsInUse(details))},
+		{cmd.UI.TranslateText("free ports:"), cmd.UI.TranslateText("{{.Free}} of {{.Reservable}}", map[string]interface{}{
+			"Free":       details.FreePorts,
+			"Reservable": reservablePorts,
+		})},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	if len(details.Routes) == 0 {
+		cmd.UI.DisplayText("No ports are in use.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("port"),
+			cmd.UI.TranslateText("route"),
+		},
+	}
+	for _, route := range details.Routes {
+		table = append(table, []string{fmt.Sprint(route.Port), route.URL})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func formatPortRange(portRange v7action.PortRange) string {
+	if portRange.Start == portRange.End {
+		return fmt.Sprint(portRange.Start)
+	}
+	return fmt.Sprintf("%d-%d", portRange.Start, portRange.End)
+}
+
+// portsInUse returns the number of reservable ports used by at least one of
+// the routes, so that together with the free ports it adds up to the number
+// of reservable ports.
+func portsInUse(details v7action.RouterGroupDetails) int {
+	usedPorts := map[int]bool{}
+	for _, route := range details.Routes {
+		for _, portRange := range details.ReservablePorts {
+			if portRange.Contains(route.Port) {
+				usedPorts[route.Port] = true
+			}
+		}
+	}
+	return len(usedPorts)
+}
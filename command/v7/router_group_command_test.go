@@ -0,0 +1,144 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("router-group Command", func() {
+	var (
+		cmd             RouterGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		binaryName      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		cmd = RouterGroupCommand{
+			RequiredArgs: flag.RouterGroup{RouterGroup: "default-tcp"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeActor.GetRouterGroupDetailsReturns(v7action.RouterGroupDetails{
+			RouterGroup:     v7action.RouterGroup{Name: "default-tcp", Type: "tcp"},
+			ReservablePorts: []v7action.PortRange{{Start: 1024, End: 1033}, {Start: 2000, End: 2000}},
+			Routes: []resources.Route{
+				{URL: "tcp.example.com:1024", Port: 1024},
+				{URL: "tcp.example.com:1025", Port: 1025},
+			},
+			FreePorts: 9,
+		}, v7action.Warnings{"get-router-group-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("displays the router group with its port use", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(fakeActor.GetRouterGroupDetailsArgsForCall(0)).To(Equal("default-tcp"))
+
+		Expect(testUI.Out).To(Say(`Getting router group default-tcp as banana\.\.\.`))
+		Expect(testUI.Out).To(Say(`name:\s+default-tcp`))
+		Expect(testUI.Out).To(Say(`type:\s+tcp`))
+		Expect(testUI.Out).To(Say(`reservable ports:\s+1024-1033, 2000`))
+		Expect(testUI.Out).To(Say(`ports in use:\s+2`))
+		Expect(testUI.Out).To(Say(`free ports:\s+9 of 11`))
+		Expect(testUI.Out).To(Say(`port\s+route`))
+		Expect(testUI.Out).To(Say(`1024\s+tcp\.example\.com:1024`))
+		Expect(testUI.Out).To(Say(`1025\s+tcp\.example\.com:1025`))
+		Expect(testUI.Err).To(Say("get-router-group-warning"))
+	})
+
+	When("routes share a port or use a port that is not reservable", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouterGroupDetailsReturns(v7action.RouterGroupDetails{
+				RouterGroup:     v7action.RouterGroup{Name: "default-tcp", Type: "tcp"},
+				ReservablePorts: []v7action.PortRange{{Start: 1024, End: 1033}},
+				Routes: []resources.Route{
+					{URL: "tcp.example.com:1024", Port: 1024},
+					{URL: "tcp2.example.com:1024", Port: 1024},
+					{URL: "tcp.example.com:5000", Port: 5000},
+				},
+				FreePorts: 9,
+			}, nil, nil)
+		})
+
+		It("counts each reservable port in use once", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`ports in use:\s+1`))
+			Expect(testUI.Out).To(Say(`free ports:\s+9 of 10`))
+		})
+	})
+
+	When("no ports are in use", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouterGroupDetailsReturns(v7action.RouterGroupDetails{
+				RouterGroup:     v7action.RouterGroup{Name: "default-tcp", Type: "tcp"},
+				ReservablePorts: []v7action.PortRange{{Start: 1024, End: 1033}},
+				FreePorts:       10,
+			}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`free ports:\s+10 of 10`))
+			Expect(testUI.Out).To(Say(`No ports are in use\.`))
+		})
+	})
+
+	When("getting the router group fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouterGroupDetailsReturns(v7action.RouterGroupDetails{}, v7action.Warnings{"get-router-group-warning"}, errors.New("router-group-error"))
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("router-group-error"))
+			Expect(testUI.Err).To(Say("get-router-group-warning"))
+		})
+	})
+})
@@ -9,7 +9,7 @@ type RouterGroupsCommand struct {
 	BaseCommand
 
 	usage           interface{} `usage:"CF_NAME router-groups"`
-	relatedCommands interface{} `related_commands:"create-domain, domains"`
+	relatedCommands interface{} `related_commands:"create-domain, domains, router-group"`
 }
 
 func (cmd RouterGroupsCommand) Execute(args []string) error {
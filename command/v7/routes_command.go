@@ -85,6 +85,7 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 			cmd.UI.TranslateText("app-protocol"),
 			cmd.UI.TranslateText("apps"),
 			cmd.UI.TranslateText("service instance"),
+			cmd.UI.TranslateText("options"),
 		},
 	}
 
@@ -103,6 +104,7 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 			strings.Join(routeSummary.AppProtocols, ", "),
 			strings.Join(routeSummary.AppNames, ", "),
 			routeSummary.ServiceInstanceName,
+			formatRouteOptions(routeSummary.Options),
 		})
 	}
 
@@ -29,7 +29,7 @@ var _ = Describe("routes Command", func() {
 		binaryName      string
 	)
 
-	const tableHeaders = `space\s+host\s+domain\s+port\s+path\s+protocol\s+app-protocol\s+apps\s+service instance`
+	const tableHeaders = `space\s+host\s+domain\s+port\s+path\s+protocol\s+app-protocol\s+apps\s+service instance\s+options`
 
 	BeforeEach(func() {
 		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
@@ -131,6 +131,7 @@ var _ = Describe("routes Command", func() {
 				)
 
 				BeforeEach(func() {
+					leastConnection := "least-connection"
 					routeSummaries = []v7action.RouteSummary{
 						{
 							DomainName:          "domain1",
@@ -141,7 +142,7 @@ var _ = Describe("routes Command", func() {
 						{
 							DomainName: "domain2",
 							SpaceName:  "space-2",
-							Route:      resources.Route{GUID: "route-guid-2", Host: "host-3", Path: "/path/2"},
+							Route:      resources.Route{GUID: "route-guid-2", Host: "host-3", Path: "/path/2", Options: map[string]*string{"loadbalancing": &leastConnection}},
 						},
 						{
 							DomainName: "domain3",
@@ -191,7 +192,7 @@ var _ = Describe("routes Command", func() {
 
 					Expect(testUI.Out).To(Say(tableHeaders))
 					Expect(testUI.Out).To(Say(`space-1\s+domain1\s+si-1\s+`))
-					Expect(testUI.Out).To(Say(`space-2\s+host-3\s+domain2\s+\/path\/2`))
+					Expect(testUI.Out).To(Say(`space-2\s+host-3\s+domain2\s+\/path\/2\s+loadbalancing=least-connection`))
 					Expect(testUI.Out).To(Say(`space-3\s+host-1\s+domain3\s+http1, http2\s+app1, app2\s+si-3`))
 					Expect(testUI.Out).To(Say(`space-3\s+tcp\.domain\s+1024\s+app1, app2`))
 					Expect(testUI.Out).To(Say(`space-3\s+domain4\s+1024\s+http1\s+app1, app2`))
@@ -2,28 +2,72 @@ package v7
 
 import (
 	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 )
 
 type RunTaskCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.RunTaskArgsV7      `positional-args:"yes"`
-	Command         string                  `long:"command" short:"c" description:"The command to execute"`
-	Disk            flag.Megabytes          `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	LogRateLimit    flag.BytesWithUnlimited `short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
-	Memory          flag.Megabytes          `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	Name            string                  `long:"name" description:"Name to give the task (generated if omitted)"`
-	Process         string                  `long:"process" description:"Process type to use as a template for command, memory, and disk for the created task."`
-	Wait            bool                    `long:"wait" short:"w" description:"Wait for the task to complete before exiting"`
-	usage           interface{}             `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--name TASK_NAME] [--process PROCESS_TYPE]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app"`
-	relatedCommands interface{}             `related_commands:"logs, tasks, terminate-task"`
+	RequiredArgs     flag.RunTaskArgsV7                  `positional-args:"yes"`
+	Command          string                              `long:"command" short:"c" description:"The command to execute"`
+	Disk             flag.Megabytes                      `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Follow           bool                                `long:"follow" description:"Stream the task's logs and wait for it to complete. Exits with an error if the task fails"`
+	LogRateLimit     flag.BytesWithUnlimited             `short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
+	Memory           flag.Megabytes                      `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	Name             string                              `long:"name" description:"Name to give the task (generated if omitted)"`
+	Process          string                              `long:"process" description:"Process type to use as a template for command, memory, and disk for the created task."`
+	Template         string                              `long:"template" description:"Name of a task defined in the 'tasks' section of the app's manifest to use for command, memory, disk, log rate limit and environment variables"`
+	PathToManifest   flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Path to the manifest containing the task template (defaults to the manifest in the current directory)"`
+	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution in the manifest, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for the manifest; can specify multiple times"`
+	Wait             bool                                `long:"wait" short:"w" description:"Wait for the task to complete before exiting"`
+	usage            interface{}                         `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--name TASK_NAME] [--process PROCESS_TYPE] [--wait | --follow]\n\n   CF_NAME run-task APP_NAME --template TASK_TEMPLATE [-f MANIFEST_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--name TASK_NAME] [--wait | --follow]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs. Use --follow to stream only the logs of this task until it completes.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate --follow\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app --template migrate\n\n   CF_NAME run-task my-app"`
+	relatedCommands  interface{}                         `related_commands:"logs, task, tasks, terminate-task"`
+
+	LogCacheClient  sharedaction.LogCacheClient
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+	CWD             string
+}
+
+func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestParser = manifestparser.ManifestParser{}
+
+	cmd.CWD, err = os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
 }
 
 func (cmd RunTaskCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -52,6 +96,13 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		Command: cmd.Command,
 	}
 
+	if cmd.Template != "" {
+		inputTask, err = cmd.taskFromTemplate()
+		if err != nil {
+			return err
+		}
+	}
+
 	if cmd.Name != "" {
 		inputTask.Name = cmd.Name
 	}
@@ -64,7 +115,7 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 	if cmd.LogRateLimit.IsSet {
 		inputTask.LogRateLimitInBPS = cmd.LogRateLimit.Value
 	}
-	if cmd.Command == "" && cmd.Process == "" {
+	if cmd.Command == "" && cmd.Process == "" && cmd.Template == "" {
 		cmd.Process = "task"
 	}
 	if cmd.Process != "" {
@@ -95,6 +146,10 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
 	}, 3)
 
+	if cmd.Follow {
+		return cmd.followTask(application.GUID, task)
+	}
+
 	if cmd.Wait {
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("Waiting for task to complete execution...")
@@ -113,3 +168,186 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd RunTaskCommand) validateFlags() error {
+	if cmd.Follow && cmd.Wait {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--follow", "--wait"}}
+	}
+
+	if cmd.Template != "" {
+		switch {
+		case cmd.Command != "":
+			return translatableerror.ArgumentCombinationError{Args: []string{"--template", "--command"}}
+		case cmd.Process != "":
+			return translatableerror.ArgumentCombinationError{Args: []string{"--template", "--process"}}
+		}
+	} else if cmd.PathToManifest != "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--manifest", Arg2: "--template"}
+	}
+
+	return nil
+}
+
+// taskFromTemplate builds the task from the named template in the app's
+// manifest. Values given on the command line are applied on top of it.
+func (cmd RunTaskCommand) taskFromTemplate() (resources.Task, error) {
+	readPath := cmd.CWD
+	if cmd.PathToManifest != "" {
+		readPath = string(cmd.PathToManifest)
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return resources.Task{}, err
+	}
+
+	if !exists {
+		return resources.Task{}, translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	var pathsToVarsFiles []string
+	for _, varFilePath := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	}
+
+	rawManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return resources.Task{}, err
+	}
+
+	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, rawManifest)
+	if err != nil {
+		return resources.Task{}, err
+	}
+
+	taskTemplate, err := manifest.GetTaskTemplate(cmd.RequiredArgs.AppName, cmd.Template)
+	if err != nil {
+		return resources.Task{}, err
+	}
+
+	if len(taskTemplate.Env) > 0 {
+		cmd.UI.DisplayWarning("The environment variables of task template '{{.Template}}' are set in the task's command, which is visible to anyone who can view the app's tasks.", map[string]interface{}{
+			"Template": taskTemplate.Name,
+		})
+	}
+
+	task := resources.Task{
+		Name:    taskTemplate.Name,
+		Command: templateCommand(taskTemplate),
+	}
+
+	if taskTemplate.Memory != "" {
+		task.MemoryInMB, err = flag.ConvertToMb(taskTemplate.Memory)
+		if err != nil {
+			return resources.Task{}, err
+		}
+	}
+	if taskTemplate.DiskQuota != "" {
+		task.DiskInMB, err = flag.ConvertToMb(taskTemplate.DiskQuota)
+		if err != nil {
+			return resources.Task{}, err
+		}
+	}
+	if taskTemplate.LogRateLimit != "" {
+		var logRateLimit flag.BytesWithUnlimited
+		err = logRateLimit.UnmarshalFlag(taskTemplate.LogRateLimit)
+		if err != nil {
+			return resources.Task{}, err
+		}
+		task.LogRateLimitInBPS = logRateLimit.Value
+	}
+
+	return task, nil
+}
+
+// templateCommand prefixes the template's command with exports for its
+// environment variables, since tasks cannot be given their own environment.
+// The names have been validated by the manifest parser; the values are
+// single-quoted so they are never interpreted by the shell.
+func templateCommand(taskTemplate manifestparser.Task) string {
+	if len(taskTemplate.Env) == 0 {
+		return taskTemplate.Command
+	}
+
+	var names []string
+	for name := range taskTemplate.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var exports []string
+	for _, name := range names {
+		value := strings.ReplaceAll(taskTemplate.Env[name], "'", `'\''`)
+		exports = append(exports, fmt.Sprintf("export %s='%s'", name, value))
+	}
+
+	return strings.Join(exports, "; ") + "; " + taskTemplate.Command
+}
+
+func (cmd RunTaskCommand) followTask(appGUID string, task resources.Task) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Streaming logs for task {{.TaskName}} until it completes...", map[string]interface{}{
+		"TaskName": task.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	messages, logErrs, stopStreaming := cmd.Actor.GetStreamingLogsForTask(appGUID, task, cmd.LogCacheClient)
+	defer stopStreaming()
+
+	type pollResult struct {
+		warnings v7action.Warnings
+		err      error
+	}
+	polled := make(chan pollResult, 1)
+	go func() {
+		_, warnings, err := cmd.Actor.PollTask(task)
+		polled <- pollResult{warnings: warnings, err: err}
+	}()
+
+	var (
+		result   pollResult
+		finished bool
+		flush    <-chan time.Time
+	)
+	for messages != nil || logErrs != nil {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				continue
+			}
+			cmd.UI.DisplayLogMessage(message, true)
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				continue
+			}
+			cmd.UI.DisplayWarning("Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
+				"Error": logErr,
+			})
+		case result = <-polled:
+			// Log lines reach log cache after a delay, so keep streaming for
+			// another polling interval before stopping.
+			finished = true
+			flush = time.After(cmd.Config.PollingInterval())
+		case <-flush:
+			stopStreaming()
+			flush = nil
+		}
+	}
+
+	if !finished {
+		result = <-polled
+	}
+
+	cmd.UI.DisplayWarnings(result.warnings)
+	if result.err != nil {
+		return result.err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Task has completed successfully.")
+	cmd.UI.DisplayOK()
+
+	return nil
+}
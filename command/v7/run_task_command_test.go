@@ -1,19 +1,27 @@
 package v7_test
 
 import (
+	"context"
 	"errors"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
@@ -56,6 +64,46 @@ var _ = Describe("run-task Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("--template is combined with --command", func() {
+		BeforeEach(func() {
+			cmd.Template = "migrate"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--template", "--command"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--follow is combined with --wait", func() {
+		BeforeEach(func() {
+			cmd.Follow = true
+			cmd.Wait = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--follow", "--wait"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--manifest is given without --template", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = "some/manifest.yml"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--manifest",
+				Arg2: "--template",
+			}))
+		})
+	})
+
 	When("checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -343,6 +391,93 @@ var _ = Describe("run-task Command", func() {
 					})
 				})
 
+				When("a task template is provided", func() {
+					var (
+						fakeManifestLocator *v7fakes.FakeManifestLocator
+						fakeManifestParser  *v7fakes.FakeManifestParser
+					)
+
+					BeforeEach(func() {
+						fakeManifestLocator = new(v7fakes.FakeManifestLocator)
+						fakeManifestParser = new(v7fakes.FakeManifestParser)
+						cmd.ManifestLocator = fakeManifestLocator
+						cmd.ManifestParser = fakeManifestParser
+						cmd.CWD = "/some/dir"
+
+						cmd.Command = ""
+						cmd.Template = "migrate"
+						cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{Value: 512, IsSet: true}}
+						cmd.Vars = []template.VarKV{{Name: "env", Value: "prod"}}
+
+						fakeManifestLocator.PathReturns("/some/dir/manifest.yml", true, nil)
+						fakeManifestParser.InterpolateManifestReturns([]byte("raw-manifest"), nil)
+						fakeManifestParser.ParseManifestReturns(manifestparser.Manifest{
+							Applications: []manifestparser.Application{
+								{
+									Name: "some-app-name",
+									Tasks: []manifestparser.Task{
+										{
+											Name:         "migrate",
+											Command:      "rake db:migrate",
+											Memory:       "256M",
+											DiskQuota:    "1G",
+											LogRateLimit: "1K",
+											Env:          map[string]string{"RAILS_ENV": "prod", "GREETING": "it's"},
+										},
+									},
+								},
+							},
+						}, nil)
+						fakeActor.RunTaskReturns(resources.Task{Name: "migrate", SequenceID: 3}, nil, nil)
+					})
+
+					It("creates the task from the template, overridden by the given flags", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeManifestLocator.PathArgsForCall(0)).To(Equal("/some/dir"))
+						path, varsFiles, vars := fakeManifestParser.InterpolateManifestArgsForCall(0)
+						Expect(path).To(Equal("/some/dir/manifest.yml"))
+						Expect(varsFiles).To(BeEmpty())
+						Expect(vars).To(Equal([]template.VarKV{{Name: "env", Value: "prod"}}))
+
+						Expect(fakeActor.GetProcessByTypeAndApplicationCallCount()).To(Equal(0))
+
+						_, task := fakeActor.RunTaskArgsForCall(0)
+						Expect(task).To(Equal(resources.Task{
+							Name:              "migrate",
+							Command:           `export GREETING='it'\''s'; export RAILS_ENV='prod'; rake db:migrate`,
+							MemoryInMB:        512,
+							DiskInMB:          1024,
+							LogRateLimitInBPS: 1024,
+						}))
+
+						Expect(testUI.Out).To(Say(`task name:\s+migrate`))
+						Expect(testUI.Err).To(Say(`The environment variables of task template 'migrate' are set in the task's command`))
+					})
+
+					When("no manifest can be found", func() {
+						BeforeEach(func() {
+							fakeManifestLocator.PathReturns("", false, nil)
+						})
+
+						It("returns an error", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "/some/dir"}))
+							Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+						})
+					})
+
+					When("the template is not defined in the manifest", func() {
+						BeforeEach(func() {
+							cmd.Template = "seed"
+						})
+
+						It("returns an error", func() {
+							Expect(executeErr).To(MatchError(manifestparser.TaskNotInManifestError{AppName: "some-app-name", TaskName: "seed"}))
+							Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				When("wait is provided", func() {
 					BeforeEach(func() {
 						cmd.Name = "some-task-name"
@@ -385,6 +520,87 @@ var _ = Describe("run-task Command", func() {
 
 					})
 				})
+
+				When("follow is provided", func() {
+					var (
+						stopStreamingCallCount int
+						pollErr                error
+					)
+
+					BeforeEach(func() {
+						cmd.Name = "some-task-name"
+						cmd.Command = "echo hello"
+						cmd.Follow = true
+						cmd.LogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+						stopStreamingCallCount = 0
+						pollErr = nil
+
+						fakeActor.RunTaskReturns(
+							resources.Task{
+								GUID:       "some-task-guid",
+								Name:       "some-task-name",
+								SequenceID: 3,
+							},
+							v7action.Warnings{"get-application-warning-3"},
+							nil)
+
+						fakeActor.GetStreamingLogsForTaskStub = func(_ string, _ resources.Task, _ sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc) {
+							messages := make(chan sharedaction.LogMessage, 10)
+							logErrs := make(chan error, 10)
+							messages <- *sharedaction.NewLogMessage("hello", "OUT", time.Now(), "APP/TASK/some-task-name", "0")
+							logErrs <- errors.New("log-cache-error")
+
+							var once sync.Once
+							return messages, logErrs, func() {
+								stopStreamingCallCount++
+								once.Do(func() {
+									close(messages)
+									close(logErrs)
+								})
+							}
+						}
+
+						fakeActor.PollTaskStub = func(task resources.Task) (resources.Task, v7action.Warnings, error) {
+							return task, v7action.Warnings{"poll-warnings"}, pollErr
+						}
+					})
+
+					It("streams the task's logs until it completes", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetStreamingLogsForTaskCallCount()).To(Equal(1))
+						appGUID, task, logCacheClient := fakeActor.GetStreamingLogsForTaskArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(task.GUID).To(Equal("some-task-guid"))
+						Expect(logCacheClient).To(Equal(cmd.LogCacheClient))
+
+						Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+						Expect(fakeActor.PollTaskArgsForCall(0).GUID).To(Equal("some-task-guid"))
+						Expect(stopStreamingCallCount).To(BeNumerically(">=", 1))
+
+						Expect(testUI.Out).To(Say("Task has been submitted successfully for execution."))
+						Expect(testUI.Out).To(Say(`Streaming logs for task some-task-name until it completes\.\.\.`))
+						Expect(testUI.Out).To(Say(`\[APP/TASK/some-task-name/0\] OUT hello`))
+						Expect(testUI.Out).To(Say(`Task has completed successfully.`))
+						Expect(testUI.Out).To(Say("OK"))
+
+						Expect(testUI.Err).To(Say("Failed to retrieve logs from Log Cache: log-cache-error"))
+						Expect(testUI.Err).To(Say("poll-warnings"))
+					})
+
+					When("the task fails", func() {
+						BeforeEach(func() {
+							pollErr = actionerror.TaskFailedError{Reason: "Exited with status 1"}
+						})
+
+						It("returns the failure reason", func() {
+							Expect(executeErr).To(MatchError(actionerror.TaskFailedError{Reason: "Exited with status 1"}))
+							Expect(testUI.Out).To(Say(`\[APP/TASK/some-task-name/0\] OUT hello`))
+							Expect(testUI.Out).NotTo(Say(`Task has completed successfully.`))
+							Expect(testUI.Err).To(Say("poll-warnings"))
+						})
+					})
+				})
 			})
 
 			When("there are errors", func() {
@@ -0,0 +1,103 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"gopkg.in/yaml.v2"
+)
+
+type ServiceBrokerCatalogCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ServiceBroker `positional-args:"yes"`
+	usage           interface{}        `usage:"CF_NAME service-broker-catalog SERVICE_BROKER\n\nEXAMPLES:\n   CF_NAME service-broker-catalog my-broker\n   CF_NAME service-broker-catalog my-broker > catalog.yml"`
+	relatedCommands interface{}        `related_commands:"marketplace, service-access, service-brokers, update-service-broker"`
+}
+
+type catalogDump struct {
+	Offerings []catalogDumpOffering `yaml:"offerings"`
+}
+
+type catalogDumpOffering struct {
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description,omitempty"`
+	Tags        []string          `yaml:"tags,omitempty"`
+	Shareable   bool              `yaml:"shareable"`
+	Plans       []catalogDumpPlan `yaml:"plans"`
+}
+
+type catalogDumpPlan struct {
+	Name                   string             `yaml:"name"`
+	Description            string             `yaml:"description,omitempty"`
+	Free                   bool               `yaml:"free"`
+	MaintenanceInfoVersion string             `yaml:"maintenance_info_version,omitempty"`
+	Visibility             string             `yaml:"visibility"`
+	VisibleTo              []string           `yaml:"visible_to,omitempty"`
+	Schemas                *catalogDumpSchema `yaml:"schemas,omitempty"`
+}
+
+type catalogDumpSchema struct {
+	ServiceInstanceCreate map[string]interface{} `yaml:"service_instance_create,omitempty"`
+	ServiceInstanceUpdate map[string]interface{} `yaml:"service_instance_update,omitempty"`
+	ServiceBindingCreate  map[string]interface{} `yaml:"service_binding_create,omitempty"`
+}
+
+func (cmd ServiceBrokerCatalogCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(false, false); err != nil {
+		return err
+	}
+
+	// The YAML is meant to be redirected to a file, so it is not preceded by
+	// the flavor text.
+	catalog, warnings, err := cmd.Actor.GetServiceBrokerCatalog(cmd.RequiredArgs.ServiceBroker)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	content, err := yaml.Marshal(newCatalogDump(catalog))
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.UI.Writer().Write(content)
+	return err
+}
+
+func newCatalogDump(catalog v7action.ServiceBrokerCatalog) catalogDump {
+	dump := catalogDump{Offerings: []catalogDumpOffering{}}
+	for _, offering := range catalog.Offerings {
+		dumpOffering := catalogDumpOffering{
+			Name:        offering.Name,
+			Description: offering.Description,
+			Tags:        offering.Tags,
+			Shareable:   offering.Shareable,
+			Plans:       []catalogDumpPlan{},
+		}
+
+		for _, plan := range offering.Plans {
+			dumpPlan := catalogDumpPlan{
+				Name:                   plan.Name,
+				Description:            plan.Description,
+				Free:                   plan.Free,
+				MaintenanceInfoVersion: plan.MaintenanceInfoVersion,
+				Visibility:             string(plan.VisibilityType),
+				VisibleTo:              plan.VisibilityDetails,
+			}
+
+			if len(plan.ServiceInstanceCreateSchema) > 0 || len(plan.ServiceInstanceUpdateSchema) > 0 || len(plan.ServiceBindingCreateSchema) > 0 {
+				dumpPlan.Schemas = &catalogDumpSchema{
+					ServiceInstanceCreate: plan.ServiceInstanceCreateSchema,
+					ServiceInstanceUpdate: plan.ServiceInstanceUpdateSchema,
+					ServiceBindingCreate:  plan.ServiceBindingCreateSchema,
+				}
+			}
+
+			dumpOffering.Plans = append(dumpOffering.Plans, dumpPlan)
+		}
+
+		dump.Offerings = append(dump.Offerings, dumpOffering)
+	}
+
+	return dump
+}
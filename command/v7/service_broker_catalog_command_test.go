@@ -0,0 +1,124 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-broker-catalog Command", func() {
+	var (
+		cmd             v7.ServiceBrokerCatalogCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.ServiceBrokerCatalogCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		setPositionalFlags(&cmd, "my-broker")
+
+		fakeActor.GetServiceBrokerCatalogReturns(
+			v7action.ServiceBrokerCatalog{
+				Offerings: []v7action.ServiceBrokerCatalogOffering{
+					{
+						Name:        "mysql",
+						Description: "MySQL databases",
+						Tags:        []string{"sql"},
+						Shareable:   true,
+						Plans: []v7action.ServiceBrokerCatalogPlan{
+							{
+								Name:                        "small",
+								Free:                        true,
+								MaintenanceInfoVersion:      "1.2.0",
+								VisibilityType:              resources.ServicePlanVisibilityOrganization,
+								VisibilityDetails:           []string{"org-1"},
+								ServiceInstanceCreateSchema: map[string]interface{}{"type": "object"},
+							},
+							{
+								Name:           "large",
+								VisibilityType: resources.ServicePlanVisibilityAdmin,
+							},
+						},
+					},
+				},
+			},
+			v7action.Warnings{"catalog warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		org, space := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(org).To(BeFalse())
+		Expect(space).To(BeFalse())
+	})
+
+	It("gets the catalog of the broker", func() {
+		Expect(fakeActor.GetServiceBrokerCatalogCallCount()).To(Equal(1))
+		Expect(fakeActor.GetServiceBrokerCatalogArgsForCall(0)).To(Equal("my-broker"))
+	})
+
+	It("displays the catalog as YAML", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(string(testUI.Out.(*Buffer).Contents())).To(HavePrefix("offerings:\n"))
+		Expect(testUI.Out).To(Say(`offerings:
+- name: mysql
+  description: MySQL databases
+  tags:
+  - sql
+  shareable: true
+  plans:
+  - name: small
+    free: true
+    maintenance_info_version: 1.2.0
+    visibility: organization
+    visible_to:
+    - org-1
+    schemas:
+      service_instance_create:
+        type: object
+  - name: large
+    free: false
+    visibility: admin
+`))
+		Expect(testUI.Err).To(Say("catalog warning"))
+	})
+
+	When("getting the catalog fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceBrokerCatalogReturns(v7action.ServiceBrokerCatalog{}, v7action.Warnings{"catalog warning"}, actionerror.ServiceBrokerNotFoundError{Name: "my-broker"})
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceBrokerNotFoundError{Name: "my-broker"}))
+			Expect(testUI.Err).To(Say("catalog warning"))
+		})
+	})
+})
@@ -0,0 +1,261 @@
+package v7
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/upgradeschedule"
+)
+
+type ServiceUpgradesCommand struct {
+	BaseCommand
+
+	Organization    string                      `short:"o" long:"org" description:"List instances in this org (Default: targeted space)"`
+	AllOrgs         bool                        `long:"all" description:"List instances in all orgs"`
+	Schedule        flag.PathWithExistenceCheck `long:"schedule" description:"Upgrade the listed instances during the maintenance windows declared in this file"`
+	usage           interface{}                 `usage:"CF_NAME service-upgrades [-o ORG | --all] [--schedule FILE]\n\nEXAMPLES:\n   CF_NAME service-upgrades --all\n   CF_NAME service-upgrades -o my-org --schedule maintenance.yml\n\nSCHEDULE FILE:\n   timezone: Europe/Berlin          # Default: local time zone\n   windows:\n   - days: [sat, sun]               # Default: every day\n     start: \"22:00\"\n     end: \"04:00\"\n   retries: 2                       # Attempts after a failed upgrade (Default: 0)\n   retry_interval: 10m              # Default: 5m\n   upgrade_time: 30m                # Time that must be left in a window to start an upgrade or retry (Default: 15m)\n   journal: maintenance.json        # Default: <schedule file>.journal.json\n\n   Progress is recorded in the journal, so an interrupted run can be resumed by running the same command again. Instances recorded as upgraded or failed are skipped."`
+	relatedCommands interface{}                 `related_commands:"services, upgrade-service, upgrade-services"`
+
+	Now   func() time.Time
+	Sleep func(time.Duration)
+}
+
+func (cmd *ServiceUpgradesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Now = time.Now
+	cmd.Sleep = time.Sleep
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (cmd ServiceUpgradesCommand) Execute(args []string) error {
+	if cmd.Organization != "" && cmd.AllOrgs {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--org", "--all"}}
+	}
+
+	useTargetedSpace := cmd.Organization == "" && !cmd.AllOrgs
+	if err := cmd.SharedActor.CheckTarget(useTargetedSpace, useTargetedSpace); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var params v7action.PendingUpgradesParams
+	switch {
+	case cmd.AllOrgs:
+		cmd.UI.DisplayTextWithFlavor("Getting service instances with pending upgrades in all orgs as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+	case cmd.Organization != "":
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		params.OrganizationGUIDs = []string{org.GUID}
+		cmd.UI.DisplayTextWithFlavor("Getting service instances with pending upgrades in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  org.Name,
+			"Username": user.Name,
+		})
+	default:
+		params.SpaceGUID = cmd.Config.TargetedSpace().GUID
+		cmd.UI.DisplayTextWithFlavor("Getting service instances with pending upgrades in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	instances, warnings, err := cmd.Actor.GetServiceInstancesWithPendingUpgrades(params)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(instances) == 0 {
+		cmd.UI.DisplayText("No service instances have pending upgrades.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("offering"),
+		cmd.UI.TranslateText("plan"),
+		cmd.UI.TranslateText("current version"),
+		cmd.UI.TranslateText("available version"),
+		cmd.UI.TranslateText("upgrade notes"),
+	}}
+	for _, instance := range instances {
+		table = append(table, []string{
+			instance.Name,
+			instance.OrganizationName,
+			instance.SpaceName,
+			instance.ServiceOfferingName,
+			instance.ServicePlanName,
+			instance.CurrentVersion,
+			instance.AvailableVersion,
+			instance.UpgradeNotes,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if cmd.Schedule == "" {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	return cmd.runScheduledUpgrades(instances)
+}
+
+// runScheduledUpgrades upgrades the instances one at a time, only while a
+// maintenance window of the schedule is open, and records the outcome of every
+// attempt in the journal.
+func (cmd ServiceUpgradesCommand) runScheduledUpgrades(instances []v7action.ServiceInstanceWithPendingUpgrade) error {
+	schedule, err := upgradeschedule.Read(string(cmd.Schedule))
+	if err != nil {
+		return err
+	}
+
+	journal, err := upgradeschedule.OpenJournal(schedule.JournalPath)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Upgrading {{.Count}} service instances during the maintenance windows of {{.Schedule}}, recording progress in {{.Journal}}...", map[string]interface{}{
+		"Count":    len(instances),
+		"Schedule": cmd.Schedule,
+		"Journal":  schedule.JournalPath,
+	})
+
+	var upgraded, failed int
+	for _, instance := range instances {
+		key := fmt.Sprintf("%s/%s/%s", instance.OrganizationName, instance.SpaceName, instance.Name)
+
+		entry := journal.Entry(key)
+		switch entry.Status {
+		case upgradeschedule.JournalUpgraded:
+			upgraded++
+			cmd.UI.DisplayText("   {{.Key}}: already upgraded", map[string]interface{}{"Key": key})
+			continue
+		case upgradeschedule.JournalFailed:
+			failed++
+			cmd.UI.DisplayText("   {{.Key}}: failed in a previous run: {{.Error}}", map[string]interface{}{"Key": key, "Error": entry.LastError})
+			continue
+		}
+
+		for {
+			cmd.waitForWindow(schedule)
+
+			entry.Attempts++
+			warnings, err := cmd.upgradeInstance(instance)
+			cmd.UI.DisplayWarnings(warnings)
+			entry.UpdatedAt = cmd.Now()
+
+			if err == nil {
+				entry.Status = upgradeschedule.JournalUpgraded
+				entry.LastError = ""
+				if err := journal.Record(key, entry); err != nil {
+					return err
+				}
+				upgraded++
+				cmd.UI.DisplayText("   {{.Key}}: upgraded", map[string]interface{}{"Key": key})
+				break
+			}
+
+			entry.LastError = err.Error()
+			if entry.Attempts > schedule.Retries {
+				entry.Status = upgradeschedule.JournalFailed
+				if err := journal.Record(key, entry); err != nil {
+					return err
+				}
+				failed++
+				cmd.UI.DisplayText("   {{.Key}}: failed: {{.Error}}", map[string]interface{}{"Key": key, "Error": entry.LastError})
+				break
+			}
+
+			entry.Status = upgradeschedule.JournalRetrying
+			if err := journal.Record(key, entry); err != nil {
+				return err
+			}
+			cmd.UI.DisplayText("   {{.Key}}: attempt {{.Attempt}} failed, retrying in {{.Interval}}: {{.Error}}", map[string]interface{}{
+				"Key":      key,
+				"Attempt":  entry.Attempts,
+				"Interval": schedule.RetryInterval,
+				"Error":    entry.LastError,
+			})
+			cmd.Sleep(schedule.RetryInterval)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Upgraded}} upgraded, {{.Failed}} failed", map[string]interface{}{
+		"Upgraded": upgraded,
+		"Failed":   failed,
+	})
+
+	if failed > 0 {
+		return translatableerror.ScheduledServiceInstanceUpgradesFailedError{
+			Failed:      failed,
+			Total:       len(instances),
+			JournalPath: schedule.JournalPath,
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// waitForWindow waits until a maintenance window is open with enough of it
+// left to run an upgrade. When the current window is about to close, it waits
+// for the next one instead.
+func (cmd ServiceUpgradesCommand) waitForWindow(schedule upgradeschedule.Schedule) {
+	now := cmd.Now()
+	start := schedule.NextUpgradeStart(now)
+	if !start.After(now) {
+		return
+	}
+
+	if windowStart, _ := schedule.NextWindow(now); !windowStart.After(now) {
+		cmd.UI.DisplayText("Less than {{.UpgradeTime}} of the maintenance window remains. Waiting for the maintenance window starting {{.Start}}...", map[string]interface{}{
+			"UpgradeTime": schedule.UpgradeTime,
+			"Start":       cmd.UI.UserFriendlyDate(start.In(schedule.Location)),
+		})
+	} else {
+		cmd.UI.DisplayText("Waiting for the maintenance window starting {{.Start}}...", map[string]interface{}{
+			"Start": cmd.UI.UserFriendlyDate(start.In(schedule.Location)),
+		})
+	}
+	cmd.Sleep(start.Sub(now))
+}
+
+func (cmd ServiceUpgradesCommand) upgradeInstance(instance v7action.ServiceInstanceWithPendingUpgrade) (v7action.Warnings, error) {
+	stream, warnings, err := cmd.Actor.UpgradeManagedServiceInstance(instance.Name, instance.SpaceGUID)
+	switch err.(type) {
+	case nil:
+	case actionerror.ServiceInstanceUpgradeNotAvailableError:
+		return warnings, nil
+	default:
+		return warnings, err
+	}
+
+	if stream == nil {
+		return warnings, nil
+	}
+
+	for event := range stream {
+		warnings = append(warnings, event.Warnings...)
+		if event.Err != nil {
+			err = event.Err
+		}
+	}
+	return warnings, err
+}
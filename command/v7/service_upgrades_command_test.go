@@ -0,0 +1,325 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/upgradeschedule"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-upgrades Command", func() {
+	var (
+		cmd             v7.ServiceUpgradesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.ServiceUpgradesCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "org-guid", Name: "org-1"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "space-guid", Name: "dev"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetServiceInstancesWithPendingUpgradesReturns(
+			[]v7action.ServiceInstanceWithPendingUpgrade{
+				{
+					Name:                "db-1",
+					SpaceGUID:           "space-guid",
+					SpaceName:           "dev",
+					OrganizationName:    "org-1",
+					ServiceOfferingName: "mysql",
+					ServicePlanName:     "small",
+					CurrentVersion:      "1.0.0",
+					AvailableVersion:    "1.1.0",
+					UpgradeNotes:        "Security patches",
+				},
+				{
+					Name:                "db-2",
+					SpaceGUID:           "space-guid",
+					SpaceName:           "dev",
+					OrganizationName:    "org-1",
+					ServiceOfferingName: "mysql",
+					ServicePlanName:     "large",
+					CurrentVersion:      "1.0.0",
+					AvailableVersion:    "1.1.0",
+				},
+			},
+			v7action.Warnings{"list warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the target space", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("lists the instances with pending upgrades in the targeted space", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.GetServiceInstancesWithPendingUpgradesCallCount()).To(Equal(1))
+		Expect(fakeActor.GetServiceInstancesWithPendingUpgradesArgsForCall(0)).To(Equal(v7action.PendingUpgradesParams{SpaceGUID: "space-guid"}))
+
+		Expect(testUI.Out).To(Say(`Getting service instances with pending upgrades in org org-1 / space dev as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`name\s+org\s+space\s+offering\s+plan\s+current version\s+available version\s+upgrade notes`))
+		Expect(testUI.Out).To(Say(`db-1\s+org-1\s+dev\s+mysql\s+small\s+1\.0\.0\s+1\.1\.0\s+Security patches`))
+		Expect(testUI.Out).To(Say(`db-2\s+org-1\s+dev\s+mysql\s+large\s+1\.0\.0\s+1\.1\.0`))
+		Expect(testUI.Err).To(Say("list warning"))
+
+		Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(BeZero())
+	})
+
+	When("--org and --all are both given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--org", "org-2")
+			setFlag(&cmd, "--all")
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--org", "--all"}}))
+		})
+	})
+
+	When("--org is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--org", "org-2")
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{GUID: "org-2-guid", Name: "org-2"}, v7action.Warnings{"org warning"}, nil)
+		})
+
+		It("lists the instances in that org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeFalse())
+			Expect(checkSpace).To(BeFalse())
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("org-2"))
+			Expect(fakeActor.GetServiceInstancesWithPendingUpgradesArgsForCall(0)).To(Equal(v7action.PendingUpgradesParams{OrganizationGUIDs: []string{"org-2-guid"}}))
+			Expect(testUI.Out).To(Say(`Getting service instances with pending upgrades in org org-2 as steve\.\.\.`))
+			Expect(testUI.Err).To(Say("org warning"))
+		})
+	})
+
+	When("--all is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--all")
+		})
+
+		It("lists the instances in every org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetServiceInstancesWithPendingUpgradesArgsForCall(0)).To(Equal(v7action.PendingUpgradesParams{}))
+			Expect(testUI.Out).To(Say(`Getting service instances with pending upgrades in all orgs as steve\.\.\.`))
+		})
+	})
+
+	When("no instances have pending upgrades", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesWithPendingUpgradesReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No service instances have pending upgrades."))
+		})
+	})
+
+	When("getting the instances fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesWithPendingUpgradesReturns(nil, v7action.Warnings{"list warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("list warning"))
+		})
+	})
+
+	When("--schedule is given", func() {
+		var (
+			dir         string
+			journalPath string
+			now         time.Time
+			sleeps      []time.Duration
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "service-upgrades")
+			Expect(err).NotTo(HaveOccurred())
+
+			schedulePath := filepath.Join(dir, "schedule.yml")
+			journalPath = schedulePath + ".journal.json"
+			Expect(ioutil.WriteFile(schedulePath, []byte(`
+timezone: UTC
+windows:
+- start: "22:00"
+  end: "02:00"
+retries: 1
+retry_interval: 1m
+`), 0600)).To(Succeed())
+			setFlag(&cmd, "--schedule", schedulePath)
+
+			now = time.Date(2026, time.October, 17, 21, 0, 0, 0, time.UTC)
+			sleeps = nil
+			cmd.Now = func() time.Time { return now }
+			cmd.Sleep = func(d time.Duration) {
+				sleeps = append(sleeps, d)
+				now = now.Add(d)
+			}
+
+			fakeActor.UpgradeManagedServiceInstanceStub = func(name, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+				stream := make(chan v7action.PollJobEvent, 1)
+				stream <- v7action.PollJobEvent{State: v7action.JobComplete, Warnings: v7action.Warnings{name + " poll warning"}}
+				close(stream)
+				return stream, v7action.Warnings{name + " warning"}, nil
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("waits for the maintenance window and upgrades every instance", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(sleeps).To(Equal([]time.Duration{time.Hour}))
+			Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(2))
+			name, spaceGUID := fakeActor.UpgradeManagedServiceInstanceArgsForCall(0)
+			Expect(name).To(Equal("db-1"))
+			Expect(spaceGUID).To(Equal("space-guid"))
+
+			Expect(testUI.Out).To(Say(`Upgrading 2 service instances during the maintenance windows of .*schedule\.yml, recording progress in .*schedule\.yml\.journal\.json\.\.\.`))
+			Expect(testUI.Out).To(Say(`Waiting for the maintenance window starting`))
+			Expect(testUI.Out).To(Say(`org-1/dev/db-1: upgraded`))
+			Expect(testUI.Out).To(Say(`org-1/dev/db-2: upgraded`))
+			Expect(testUI.Out).To(Say(`2 upgraded, 0 failed`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("db-1 warning"))
+			Expect(testUI.Err).To(Say("db-1 poll warning"))
+
+			journal, err := upgradeschedule.OpenJournal(journalPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(journal.Entry("org-1/dev/db-1").Status).To(Equal(upgradeschedule.JournalUpgraded))
+			Expect(journal.Entry("org-1/dev/db-2").Attempts).To(Equal(1))
+		})
+
+		When("an upgrade keeps failing", func() {
+			BeforeEach(func() {
+				fakeActor.UpgradeManagedServiceInstanceStub = func(name, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+					if name == "db-1" {
+						return nil, nil, errors.New("broker unavailable")
+					}
+					return nil, nil, nil
+				}
+			})
+
+			It("retries after the retry interval and records the failure", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ScheduledServiceInstanceUpgradesFailedError{Failed: 1, Total: 2, JournalPath: journalPath}))
+
+				Expect(sleeps).To(Equal([]time.Duration{time.Hour, time.Minute}))
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(3))
+
+				Expect(testUI.Out).To(Say(`org-1/dev/db-1: attempt 1 failed, retrying in 1m0s: broker unavailable`))
+				Expect(testUI.Out).To(Say(`org-1/dev/db-1: failed: broker unavailable`))
+				Expect(testUI.Out).To(Say(`org-1/dev/db-2: upgraded`))
+				Expect(testUI.Out).To(Say(`1 upgraded, 1 failed`))
+
+				journal, err := upgradeschedule.OpenJournal(journalPath)
+				Expect(err).NotTo(HaveOccurred())
+				entry := journal.Entry("org-1/dev/db-1")
+				Expect(entry.Status).To(Equal(upgradeschedule.JournalFailed))
+				Expect(entry.Attempts).To(Equal(2))
+				Expect(entry.LastError).To(Equal("broker unavailable"))
+			})
+		})
+
+		When("too little of the current window remains", func() {
+			BeforeEach(func() {
+				now = time.Date(2026, time.October, 18, 1, 50, 0, 0, time.UTC)
+			})
+
+			It("waits for the next window before upgrading", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(sleeps).To(Equal([]time.Duration{20*time.Hour + 10*time.Minute}))
+				Expect(testUI.Out).To(Say(`Less than 15m0s of the maintenance window remains\. Waiting for the maintenance window starting`))
+				Expect(testUI.Out).To(Say(`org-1/dev/db-1: upgraded`))
+			})
+		})
+
+		When("a retry would start too late in the window", func() {
+			BeforeEach(func() {
+				now = time.Date(2026, time.October, 18, 1, 45, 0, 0, time.UTC)
+				fakeActor.UpgradeManagedServiceInstanceStub = func(name, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+					if name == "db-1" && fakeActor.UpgradeManagedServiceInstanceCallCount() == 1 {
+						return nil, nil, errors.New("broker unavailable")
+					}
+					return nil, nil, nil
+				}
+			})
+
+			It("defers the retry to the next window", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(sleeps).To(Equal([]time.Duration{time.Minute, 20*time.Hour + 14*time.Minute}))
+				Expect(testUI.Out).To(Say(`org-1/dev/db-1: attempt 1 failed, retrying in 1m0s: broker unavailable`))
+				Expect(testUI.Out).To(Say(`Less than 15m0s of the maintenance window remains`))
+				Expect(testUI.Out).To(Say(`org-1/dev/db-1: upgraded`))
+			})
+		})
+
+		When("a previous run was interrupted", func() {
+			BeforeEach(func() {
+				journal, err := upgradeschedule.OpenJournal(journalPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(journal.Record("org-1/dev/db-1", upgradeschedule.JournalEntry{Status: upgradeschedule.JournalUpgraded, Attempts: 1})).To(Succeed())
+			})
+
+			It("skips the instances the journal records as done", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(1))
+				name, _ := fakeActor.UpgradeManagedServiceInstanceArgsForCall(0)
+				Expect(name).To(Equal("db-2"))
+				Expect(testUI.Out).To(Say(`org-1/dev/db-1: already upgraded`))
+				Expect(testUI.Out).To(Say(`2 upgraded, 0 failed`))
+			})
+		})
+	})
+})
@@ -0,0 +1,412 @@
+package v7
+
+import (
+	"reflect"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/servicemanifest"
+	"gopkg.in/yaml.v2"
+)
+
+type ServicesDiffCommand struct {
+	BaseCommand
+
+	PathToFile      flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a file declaring the service instances of the space"`
+	Apply           bool                        `long:"apply" description:"Create, update and share service instances so that they match the file"`
+	usage           interface{}                 `usage:"CF_NAME services-diff -f SERVICES_FILE [--apply]\n\n   Compares the service instances declared in the file with the ones in the targeted space.\n   With --apply, missing instances are created, plans, tags and parameters are updated, and\n   instances are shared to the declared spaces. Nothing is deleted or unshared.\n   The command fails if an instance cannot be changed to match the file, such as when its offering differs.\n\nEXAMPLES:\n   CF_NAME services-diff -f services.yml\n   CF_NAME services-diff -f services.yml --apply"`
+	relatedCommands interface{}                 `related_commands:"create-service, services, share-service, update-service"`
+}
+
+type serviceInstanceChange struct {
+	field   string
+	current interface{}
+	desired interface{}
+}
+
+type serviceInstanceDrift struct {
+	desired servicemanifest.ServiceInstance
+	missing bool
+	// blocked is why the instance cannot be changed to match the file
+	blocked   string
+	changes   []serviceInstanceChange
+	newShares []servicemanifest.SharedSpace
+	warnings  []string
+}
+
+func (drift serviceInstanceDrift) hasDifferences() bool {
+	return drift.missing || drift.blocked != "" || len(drift.changes) > 0 || len(drift.newShares) > 0
+}
+
+func (drift serviceInstanceDrift) change(field string) (serviceInstanceChange, bool) {
+	for _, change := range drift.changes {
+		if change.field == field {
+			return change, true
+		}
+	}
+	return serviceInstanceChange{}, false
+}
+
+func (cmd ServicesDiffCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	manifest, err := servicemanifest.Read(string(cmd.PathToFile))
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing service instances in {{.Path}} with org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Path":      cmd.PathToFile,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	instances, warnings, err := cmd.Actor.GetServiceInstancesForSpace(cmd.Config.TargetedSpace().GUID, true)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	drifts, undeclared, err := cmd.compare(manifest.ServiceInstances, instances)
+	if err != nil {
+		return err
+	}
+
+	differences := len(undeclared) > 0
+	cmd.UI.DisplayDiffUnchanged("services:", 0, false)
+	for _, drift := range drifts {
+		cmd.displayDrift(drift)
+		differences = differences || drift.hasDifferences()
+	}
+	for _, instance := range undeclared {
+		cmd.displayUndeclared(instance)
+	}
+	cmd.UI.DisplayNewline()
+
+	for _, drift := range drifts {
+		for _, warning := range drift.warnings {
+			cmd.UI.DisplayWarning(warning)
+		}
+	}
+
+	if !differences {
+		cmd.UI.DisplayText("No differences found.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if len(undeclared) > 0 {
+		cmd.UI.DisplayText("Service instances that are not declared in the file are never deleted.")
+	}
+
+	if !cmd.Apply {
+		cmd.UI.DisplayText("TIP: Use '{{.Command}}' to create, update and share service instances so that they match the file.", map[string]interface{}{
+			"Command": cmd.Config.BinaryName() + " services-diff -f " + string(cmd.PathToFile) + " --apply",
+		})
+		return nil
+	}
+
+	var blocked []string
+	for _, drift := range drifts {
+		if drift.blocked != "" {
+			blocked = append(blocked, drift.desired.Name)
+			continue
+		}
+		if err := cmd.apply(drift); err != nil {
+			return err
+		}
+	}
+
+	if len(blocked) > 0 {
+		return translatableerror.ServiceInstancesNotAppliedError{Names: blocked}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ServicesDiffCommand) compare(declared []servicemanifest.ServiceInstance, instances []v7action.ServiceInstance) ([]serviceInstanceDrift, []v7action.ServiceInstance, error) {
+	existing := make(map[string]v7action.ServiceInstance)
+	for _, instance := range instances {
+		existing[instance.Name] = instance
+	}
+
+	drifts := make([]serviceInstanceDrift, len(declared))
+	declaredNames := make(map[string]bool)
+	for i, desired := range declared {
+		declaredNames[desired.Name] = true
+
+		current, ok := existing[desired.Name]
+		if !ok {
+			drifts[i] = serviceInstanceDrift{desired: desired, missing: true}
+			continue
+		}
+
+		drift, err := cmd.compareInstance(desired, current)
+		if err != nil {
+			return nil, nil, err
+		}
+		drifts[i] = drift
+	}
+
+	var undeclared []v7action.ServiceInstance
+	for _, instance := range instances {
+		if !declaredNames[instance.Name] {
+			undeclared = append(undeclared, instance)
+		}
+	}
+
+	return drifts, undeclared, nil
+}
+
+func (cmd ServicesDiffCommand) compareInstance(desired servicemanifest.ServiceInstance, current v7action.ServiceInstance) (serviceInstanceDrift, error) {
+	drift := serviceInstanceDrift{desired: desired}
+
+	if current.Type != resources.ManagedServiceInstance {
+		drift.blocked = "it is a user-provided service instance"
+		return drift, nil
+	}
+
+	if current.ServiceOfferingName != desired.Offering {
+		drift.changes = append(drift.changes, serviceInstanceChange{field: "offering", current: current.ServiceOfferingName, desired: desired.Offering})
+		drift.blocked = "the service offering of an instance cannot be changed"
+	}
+	if desired.Broker != "" && current.ServiceBrokerName != desired.Broker {
+		drift.changes = append(drift.changes, serviceInstanceChange{field: "broker", current: current.ServiceBrokerName, desired: desired.Broker})
+		drift.blocked = "the service broker of an instance cannot be changed"
+	}
+	if current.ServicePlanName != desired.Plan {
+		drift.changes = append(drift.changes, serviceInstanceChange{field: "plan", current: current.ServicePlanName, desired: desired.Plan})
+	}
+
+	if desired.Tags != nil || len(desired.SharedTo) > 0 {
+		details, warnings, err := cmd.Actor.GetServiceInstanceDetails(desired.Name, cmd.Config.TargetedSpace().GUID, true)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return serviceInstanceDrift{}, err
+		}
+
+		currentTags := details.Tags.Value
+		if desired.Tags != nil && !(len(currentTags) == 0 && len(desired.Tags) == 0) && !reflect.DeepEqual(currentTags, desired.Tags) {
+			drift.changes = append(drift.changes, serviceInstanceChange{field: "tags", current: currentTags, desired: desired.Tags})
+		}
+
+		for _, space := range desired.SharedTo {
+			if !cmd.isSharedTo(details.SharedStatus, space) {
+				drift.newShares = append(drift.newShares, space)
+			}
+		}
+	}
+
+	if desired.Parameters != nil {
+		parameters, warnings, err := cmd.Actor.GetServiceInstanceParameters(desired.Name, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		switch err.(type) {
+		case nil:
+			if !reflect.DeepEqual(map[string]interface{}(parameters), desired.Parameters) {
+				drift.changes = append(drift.changes, serviceInstanceChange{field: "parameters", current: map[string]interface{}(parameters), desired: desired.Parameters})
+			}
+		case actionerror.ServiceInstanceParamsFetchingNotSupportedError:
+			drift.warnings = append(drift.warnings, "The parameters of service instance "+desired.Name+" cannot be retrieved, so they were not compared.")
+		default:
+			return serviceInstanceDrift{}, err
+		}
+	}
+
+	return drift, nil
+}
+
+func (cmd ServicesDiffCommand) isSharedTo(status v7action.SharedStatus, space servicemanifest.SharedSpace) bool {
+	orgName := space.Org
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	for _, usage := range status.UsageSummary {
+		if usage.SpaceName == space.Space && usage.OrganizationName == orgName {
+			return true
+		}
+	}
+	return false
+}
+
+func (cmd ServicesDiffCommand) displayDrift(drift serviceInstanceDrift) {
+	if drift.missing {
+		cmd.UI.DisplayDiffAddition(formatServiceField("name", drift.desired.Name), 1, true)
+		for _, field := range declaredServiceFields(drift.desired) {
+			cmd.UI.DisplayDiffAddition(field, 1, false)
+		}
+		return
+	}
+
+	cmd.UI.DisplayDiffUnchanged(formatServiceField("name", drift.desired.Name), 1, true)
+	for _, change := range drift.changes {
+		cmd.UI.DisplayDiffRemoval(formatServiceField(change.field, change.current), 1, false)
+		cmd.UI.DisplayDiffAddition(formatServiceField(change.field, change.desired), 1, false)
+	}
+	if len(drift.newShares) > 0 {
+		cmd.UI.DisplayDiffAddition(formatServiceField("shared_to", drift.newShares), 1, false)
+	}
+	if drift.blocked != "" {
+		cmd.UI.DisplayWarning("Service instance {{.ServiceInstanceName}} cannot be updated to match the file: {{.Reason}}. Delete and recreate it to apply this change.", map[string]interface{}{
+			"ServiceInstanceName": drift.desired.Name,
+			"Reason":              drift.blocked,
+		})
+	}
+}
+
+func (cmd ServicesDiffCommand) displayUndeclared(instance v7action.ServiceInstance) {
+	cmd.UI.DisplayDiffRemoval(formatServiceField("name", instance.Name), 1, true)
+	if instance.Type == resources.ManagedServiceInstance {
+		cmd.UI.DisplayDiffRemoval(formatServiceField("offering", instance.ServiceOfferingName), 1, false)
+		cmd.UI.DisplayDiffRemoval(formatServiceField("plan", instance.ServicePlanName), 1, false)
+	}
+}
+
+func (cmd ServicesDiffCommand) apply(drift serviceInstanceDrift) error {
+	names := map[string]interface{}{"ServiceInstanceName": drift.desired.Name}
+
+	switch {
+	case drift.missing:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Creating service instance {{.ServiceInstanceName}}...", names)
+
+		params := v7action.CreateManagedServiceInstanceParams{
+			ServiceOfferingName: drift.desired.Offering,
+			ServicePlanName:     drift.desired.Plan,
+			ServiceInstanceName: drift.desired.Name,
+			ServiceBrokerName:   drift.desired.Broker,
+			SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		}
+		if drift.desired.Tags != nil {
+			params.Tags = types.NewOptionalStringSlice(drift.desired.Tags...)
+		}
+		if drift.desired.Parameters != nil {
+			params.Parameters = types.NewOptionalObject(drift.desired.Parameters)
+		}
+
+		stream, warnings, err := cmd.Actor.CreateManagedServiceInstance(params)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		if _, err := shared.WaitForResult(stream, cmd.UI, true); err != nil {
+			return err
+		}
+		cmd.UI.DisplayOK()
+
+		drift.newShares = drift.desired.SharedTo
+	case len(drift.changes) > 0:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Updating service instance {{.ServiceInstanceName}}...", names)
+
+		params := v7action.UpdateManagedServiceInstanceParams{
+			ServiceInstanceName: drift.desired.Name,
+			SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		}
+		if _, ok := drift.change("plan"); ok {
+			params.ServicePlanName = drift.desired.Plan
+		}
+		if _, ok := drift.change("tags"); ok {
+			params.Tags = types.NewOptionalStringSlice(drift.desired.Tags...)
+		}
+		if _, ok := drift.change("parameters"); ok {
+			params.Parameters = types.NewOptionalObject(drift.desired.Parameters)
+		}
+
+		stream, warnings, err := cmd.Actor.UpdateManagedServiceInstance(params)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		if _, err := shared.WaitForResult(stream, cmd.UI, true); err != nil {
+			return err
+		}
+		cmd.UI.DisplayOK()
+	}
+
+	for _, share := range drift.newShares {
+		sharingParams := v7action.ServiceInstanceSharingParams{SpaceName: share.Space}
+		orgName := cmd.Config.TargetedOrganization().Name
+		if share.Org != "" {
+			sharingParams.OrgName = types.NewOptionalString(share.Org)
+			orgName = share.Org
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Sharing service instance {{.ServiceInstanceName}} into org {{.OrgName}} / space {{.SpaceName}}...", map[string]interface{}{
+			"ServiceInstanceName": drift.desired.Name,
+			"OrgName":             orgName,
+			"SpaceName":           share.Space,
+		})
+
+		warnings, err := cmd.Actor.ShareServiceInstanceToSpaceAndOrg(
+			drift.desired.Name,
+			cmd.Config.TargetedSpace().GUID,
+			cmd.Config.TargetedOrganization().GUID,
+			sharingParams,
+		)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayOK()
+	}
+
+	return nil
+}
+
+// declaredServiceFields returns the fields of a declared instance, other than
+// its name, in the order they are usually written.
+func declaredServiceFields(instance servicemanifest.ServiceInstance) []string {
+	fields := []string{formatServiceField("offering", instance.Offering)}
+	if instance.Broker != "" {
+		fields = append(fields, formatServiceField("broker", instance.Broker))
+	}
+	fields = append(fields, formatServiceField("plan", instance.Plan))
+	if instance.Tags != nil {
+		fields = append(fields, formatServiceField("tags", instance.Tags))
+	}
+	if instance.Parameters != nil {
+		fields = append(fields, formatServiceField("parameters", instance.Parameters))
+	}
+	if len(instance.SharedTo) > 0 {
+		fields = append(fields, formatServiceField("shared_to", instance.SharedTo))
+	}
+	return fields
+}
+
+func formatServiceField(key string, value interface{}) string {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Slice:
+		if reflect.ValueOf(value).Len() == 0 {
+			return key + ": []"
+		}
+		return key + ":\n" + marshalServiceField(value)
+	case reflect.Map:
+		return key + ":\n  " + strings.ReplaceAll(marshalServiceField(value), "\n", "\n  ")
+	default:
+		return key + ": " + marshalServiceField(value)
+	}
+}
+
+func marshalServiceField(value interface{}) string {
+	raw, _ := yaml.Marshal(value)
+	return strings.TrimSpace(string(raw))
+}
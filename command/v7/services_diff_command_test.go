@@ -0,0 +1,318 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/servicemanifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("services-diff Command", func() {
+	var (
+		cmd             v7.ServicesDiffCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		dir             string
+		path            string
+		contents        string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		var err error
+		dir, err = ioutil.TempDir("", "services-diff-test")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "services.yml")
+
+		cmd = v7.ServicesDiffCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			PathToFile: flag.PathWithExistenceCheck(path),
+		}
+
+		fakeConfig.BinaryNameReturns("cf")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		contents = `---
+services:
+- name: new-db
+  offering: p-mysql
+  plan: small
+  tags: [sql]
+  shared_to:
+  - space: other-space
+- name: cache
+  offering: redis
+  plan: large
+  tags: [kv]
+  parameters:
+    nodes: 3
+  shared_to:
+  - space: prod
+    org: other-org
+`
+
+		fakeActor.GetServiceInstancesForSpaceReturns(
+			[]v7action.ServiceInstance{
+				{Name: "cache", Type: resources.ManagedServiceInstance, ServiceOfferingName: "redis", ServicePlanName: "small"},
+				{Name: "old-queue", Type: resources.ManagedServiceInstance, ServiceOfferingName: "rabbit", ServicePlanName: "standard"},
+			},
+			v7action.Warnings{"instances warning"},
+			nil,
+		)
+		fakeActor.GetServiceInstanceDetailsReturns(
+			v7action.ServiceInstanceDetails{
+				ServiceInstance: resources.ServiceInstance{Tags: types.NewOptionalStringSlice("kv")},
+				SharedStatus:    v7action.SharedStatus{},
+			},
+			v7action.Warnings{"details warning"},
+			nil,
+		)
+		fakeActor.GetServiceInstanceParametersReturns(
+			v7action.ServiceInstanceParameters{"nodes": float64(1)},
+			v7action.Warnings{"parameters warning"},
+			nil,
+		)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in, and targeting an org and space", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		org, space := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(org).To(BeTrue())
+		Expect(space).To(BeTrue())
+	})
+
+	It("compares the declared instances with the ones in the space", func() {
+		Expect(fakeActor.GetServiceInstancesForSpaceCallCount()).To(Equal(1))
+		spaceGUID, omitApps := fakeActor.GetServiceInstancesForSpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(omitApps).To(BeTrue())
+
+		Expect(fakeActor.GetServiceInstanceDetailsCallCount()).To(Equal(1))
+		name, spaceGUID, _ := fakeActor.GetServiceInstanceDetailsArgsForCall(0)
+		Expect(name).To(Equal("cache"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.GetServiceInstanceParametersCallCount()).To(Equal(1))
+		name, spaceGUID = fakeActor.GetServiceInstanceParametersArgsForCall(0)
+		Expect(name).To(Equal("cache"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+	})
+
+	It("displays the differences and warnings without changing anything", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(testUI.Out).To(SatisfyAll(
+			Say(`Comparing service instances in .*services.yml with org some-org / space some-space as steve\.\.\.\n\n`),
+			Say(`  services:\n`),
+			Say(`\+ - name: new-db\n`),
+			Say(`\+   offering: p-mysql\n`),
+			Say(`\+   plan: small\n`),
+			Say(`\+   tags:\n\+   - sql\n`),
+			Say(`\+   shared_to:\n\+   - space: other-space\n`),
+			Say(`  - name: cache\n`),
+			Say(`-   plan: small\n`),
+			Say(`\+   plan: large\n`),
+			Say(`-   parameters:\n-     nodes: 1\n`),
+			Say(`\+   parameters:\n\+     nodes: 3\n`),
+			Say(`\+   shared_to:\n\+   - space: prod\n\+     org: other-org\n`),
+			Say(`- - name: old-queue\n`),
+			Say(`-   offering: rabbit\n`),
+			Say(`Service instances that are not declared in the file are never deleted\.\n`),
+			Say(`TIP: Use 'cf services-diff -f .*services.yml --apply' to create, update and share service instances so that they match the file\.`),
+		))
+		Expect(testUI.Out).NotTo(Say(`tags:\n\+   - kv`))
+		Expect(testUI.Err).To(SatisfyAll(
+			Say("instances warning"),
+			Say("details warning"),
+			Say("parameters warning"),
+		))
+
+		Expect(fakeActor.CreateManagedServiceInstanceCallCount()).To(BeZero())
+		Expect(fakeActor.UpdateManagedServiceInstanceCallCount()).To(BeZero())
+		Expect(fakeActor.ShareServiceInstanceToSpaceAndOrgCallCount()).To(BeZero())
+	})
+
+	When("--apply is provided", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--apply")
+		})
+
+		It("creates, updates and shares the instances", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.CreateManagedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeActor.CreateManagedServiceInstanceArgsForCall(0)).To(Equal(v7action.CreateManagedServiceInstanceParams{
+				ServiceOfferingName: "p-mysql",
+				ServicePlanName:     "small",
+				ServiceInstanceName: "new-db",
+				SpaceGUID:           "some-space-guid",
+				Tags:                types.NewOptionalStringSlice("sql"),
+			}))
+
+			Expect(fakeActor.UpdateManagedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeActor.UpdateManagedServiceInstanceArgsForCall(0)).To(Equal(v7action.UpdateManagedServiceInstanceParams{
+				ServiceInstanceName: "cache",
+				ServicePlanName:     "large",
+				SpaceGUID:           "some-space-guid",
+				Parameters:          types.NewOptionalObject(map[string]interface{}{"nodes": float64(3)}),
+			}))
+
+			Expect(fakeActor.ShareServiceInstanceToSpaceAndOrgCallCount()).To(Equal(2))
+			name, spaceGUID, orgGUID, params := fakeActor.ShareServiceInstanceToSpaceAndOrgArgsForCall(0)
+			Expect(name).To(Equal("new-db"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(params).To(Equal(v7action.ServiceInstanceSharingParams{SpaceName: "other-space"}))
+
+			name, _, _, params = fakeActor.ShareServiceInstanceToSpaceAndOrgArgsForCall(1)
+			Expect(name).To(Equal("cache"))
+			Expect(params).To(Equal(v7action.ServiceInstanceSharingParams{SpaceName: "prod", OrgName: types.NewOptionalString("other-org")}))
+
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`Creating service instance new-db\.\.\.\n`),
+				Say(`OK\n`),
+				Say(`Sharing service instance new-db into org some-org / space other-space\.\.\.\n`),
+				Say(`OK\n`),
+				Say(`Updating service instance cache\.\.\.\n`),
+				Say(`OK\n`),
+				Say(`Sharing service instance cache into org other-org / space prod\.\.\.\n`),
+				Say(`OK\n`),
+			))
+		})
+
+		When("creating an instance fails", func() {
+			BeforeEach(func() {
+				fakeActor.CreateManagedServiceInstanceReturns(nil, v7action.Warnings{"create warning"}, errors.New("create failed"))
+			})
+
+			It("stops and returns the error", func() {
+				Expect(executeErr).To(MatchError("create failed"))
+				Expect(testUI.Err).To(Say("create warning"))
+				Expect(fakeActor.UpdateManagedServiceInstanceCallCount()).To(BeZero())
+				Expect(fakeActor.ShareServiceInstanceToSpaceAndOrgCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	When("the offering of an existing instance differs", func() {
+		BeforeEach(func() {
+			contents = "services:\n- {name: cache, offering: memcached, plan: small}\n- {name: db, offering: mysql, plan: small}\n"
+			setFlag(&cmd, "--apply")
+			fakeActor.GetServiceInstancesForSpaceReturns(
+				[]v7action.ServiceInstance{{Name: "cache", Type: resources.ManagedServiceInstance, ServiceOfferingName: "redis", ServicePlanName: "small"}},
+				nil,
+				nil,
+			)
+		})
+
+		It("shows the difference, applies the other changes and fails without updating the instance", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ServiceInstancesNotAppliedError{Names: []string{"cache"}}))
+			Expect(fakeActor.CreateManagedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeActor.CreateManagedServiceInstanceArgsForCall(0).ServiceInstanceName).To(Equal("db"))
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`-   offering: redis\n`),
+				Say(`\+   offering: memcached\n`),
+			))
+			Expect(testUI.Err).To(Say(`Service instance cache cannot be updated to match the file: the service offering of an instance cannot be changed\.`))
+			Expect(fakeActor.UpdateManagedServiceInstanceCallCount()).To(BeZero())
+		})
+	})
+
+	When("the parameters of an instance cannot be retrieved", func() {
+		BeforeEach(func() {
+			contents = "services:\n- {name: cache, offering: redis, plan: small, parameters: {nodes: 3}}\n"
+			fakeActor.GetServiceInstancesForSpaceReturns(
+				[]v7action.ServiceInstance{{Name: "cache", Type: resources.ManagedServiceInstance, ServiceOfferingName: "redis", ServicePlanName: "small"}},
+				nil,
+				nil,
+			)
+			fakeActor.GetServiceInstanceParametersReturns(nil, nil, actionerror.ServiceInstanceParamsFetchingNotSupportedError{})
+		})
+
+		It("warns that they were not compared", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Err).To(Say(`The parameters of service instance cache cannot be retrieved, so they were not compared\.`))
+			Expect(testUI.Out).To(Say(`No differences found\.`))
+		})
+	})
+
+	When("the space matches the file", func() {
+		BeforeEach(func() {
+			contents = "services:\n- {name: cache, offering: redis, plan: small, tags: [kv]}\n"
+			fakeActor.GetServiceInstancesForSpaceReturns(
+				[]v7action.ServiceInstance{{Name: "cache", Type: resources.ManagedServiceInstance, ServiceOfferingName: "redis", ServicePlanName: "small"}},
+				nil,
+				nil,
+			)
+		})
+
+		It("says there are no differences", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`  - name: cache\n`),
+				Say(`No differences found\.\n`),
+				Say(`OK`),
+			))
+		})
+	})
+
+	When("the file is invalid", func() {
+		BeforeEach(func() {
+			contents = "services: []\n"
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(servicemanifest.InvalidManifestError{Path: path, Reason: "no services are declared"}))
+			Expect(fakeActor.GetServiceInstancesForSpaceCallCount()).To(BeZero())
+		})
+	})
+
+	When("getting the service instances fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesForSpaceReturns(nil, v7action.Warnings{"instances warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("instances warning"))
+		})
+	})
+})
@@ -0,0 +1,99 @@
+package v7
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type SetRouteWeightsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.RouteURL    `positional-args:"yes"`
+	Apps            []flag.AppWeight `long:"app" required:"true" description:"Percentage of the route's traffic an app receives, as APP=WEIGHT (can be used multiple times; weights must add up to 100)"`
+	usage           interface{}      `usage:"CF_NAME set-route-weights ROUTE --app APP=WEIGHT [--app APP=WEIGHT]...\n\n   Replaces all destinations of the route with one weighted destination per app.\n\nEXAMPLES:\n   CF_NAME set-route-weights myapp.example.com --app blue=90 --app green=10"`
+	relatedCommands interface{}      `related_commands:"map-route, route, shift-traffic, unmap-route"`
+}
+
+func (cmd SetRouteWeightsCommand) Execute(args []string) error {
+	if err := cmd.validateWeights(); err != nil {
+		return err
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting weights of route {{.URL}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
+		"URL":       cmd.RequiredArgs.Route,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"User":      user.Name,
+	})
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	route, warnings, err := cmd.Actor.GetRoute(cmd.RequiredArgs.Route, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var weights []v7action.RouteWeight
+	appNames := map[string]string{}
+	for _, appWeight := range cmd.Apps {
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(appWeight.AppName, spaceGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		weights = append(weights, v7action.RouteWeight{AppGUID: app.GUID, Weight: appWeight.Weight})
+		appNames[app.GUID] = appWeight.AppName
+	}
+
+	_, warnings, err = cmd.Actor.SetRouteWeights(route, weights)
+	cmd.UI.DisplayWarnings(warnings)
+	if e, ok := err.(actionerror.RouteWeightTooSmallError); ok {
+		return translatableerror.RouteWeightTooSmallError{AppName: appNames[e.AppGUID], Weight: e.Weight, Destinations: e.Destinations}
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	table := [][]string{{cmd.UI.TranslateText("app"), cmd.UI.TranslateText("traffic")}}
+	for _, appWeight := range cmd.Apps {
+		table = append(table, []string{appWeight.AppName, strconv.Itoa(appWeight.Weight) + "%"})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd SetRouteWeightsCommand) validateWeights() error {
+	seen := map[string]bool{}
+	total := 0
+	for _, appWeight := range cmd.Apps {
+		if seen[appWeight.AppName] {
+			return translatableerror.IncorrectUsageError{Message: "app " + appWeight.AppName + " is given more than once"}
+		}
+		seen[appWeight.AppName] = true
+		total += appWeight.Weight
+	}
+
+	if total != 100 {
+		return translatableerror.IncorrectUsageError{Message: "app weights must add up to 100, got " + strconv.Itoa(total)}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-route-weights Command", func() {
+	var (
+		cmd             v7.SetRouteWeightsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.SetRouteWeightsCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.RouteURL{Route: "myapp.example.com"},
+		}
+		setFlag(&cmd, "--app", []flag.AppWeight{{AppName: "blue", Weight: 90}, {AppName: "green", Weight: 10}})
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetRouteReturns(resources.Route{GUID: "route-guid"}, v7action.Warnings{"route-warning"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(0, resources.Application{GUID: "blue-guid", Name: "blue"}, v7action.Warnings{"app-warning"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(1, resources.Application{GUID: "green-guid", Name: "green"}, nil, nil)
+		fakeActor.SetRouteWeightsReturns(nil, v7action.Warnings{"weights-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the target", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("replaces the destinations of the route with weighted ones", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		routePath, spaceGUID := fakeActor.GetRouteArgsForCall(0)
+		Expect(routePath).To(Equal("myapp.example.com"))
+		Expect(spaceGUID).To(Equal("space-guid"))
+
+		Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(2))
+		appName, _ := fakeActor.GetApplicationByNameAndSpaceArgsForCall(1)
+		Expect(appName).To(Equal("green"))
+
+		Expect(fakeActor.SetRouteWeightsCallCount()).To(Equal(1))
+		route, weights := fakeActor.SetRouteWeightsArgsForCall(0)
+		Expect(route.GUID).To(Equal("route-guid"))
+		Expect(weights).To(Equal([]v7action.RouteWeight{
+			{AppGUID: "blue-guid", Weight: 90},
+			{AppGUID: "green-guid", Weight: 10},
+		}))
+
+		Expect(testUI.Out).To(Say(`Setting weights of route myapp\.example\.com in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`app\s+traffic`))
+		Expect(testUI.Out).To(Say(`blue\s+90%`))
+		Expect(testUI.Out).To(Say(`green\s+10%`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("route-warning"))
+		Expect(testUI.Err).To(Say("app-warning"))
+		Expect(testUI.Err).To(Say("weights-warning"))
+	})
+
+	When("the weights do not add up to 100", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--app", []flag.AppWeight{{AppName: "blue", Weight: 90}, {AppName: "green", Weight: 20}})
+		})
+
+		It("returns a usage error without changing the route", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "app weights must add up to 100, got 110"}))
+			Expect(fakeActor.SetRouteWeightsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("an app is given more than once", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--app", []flag.AppWeight{{AppName: "blue", Weight: 50}, {AppName: "blue", Weight: 50}})
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "app blue is given more than once"}))
+		})
+	})
+
+	When("an app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(1, resources.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "green"})
+		})
+
+		It("returns the error without changing the route", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "green"}))
+			Expect(fakeActor.SetRouteWeightsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("setting the weights fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetRouteWeightsReturns(nil, v7action.Warnings{"weights-warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("weights-warning"))
+		})
+	})
+
+	When("an app's weight is too small for its destinations on the route", func() {
+		BeforeEach(func() {
+			fakeActor.SetRouteWeightsReturns(nil, nil, actionerror.RouteWeightTooSmallError{AppGUID: "green-guid", Weight: 10, Destinations: 12})
+		})
+
+		It("returns an error naming the app", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RouteWeightTooSmallError{AppName: "green", Weight: 10, Destinations: 12}))
+		})
+	})
+})
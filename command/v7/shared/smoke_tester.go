@@ -0,0 +1,73 @@
+package shared
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SmokeTester
+
+// SmokeTester checks a newly pushed app before it receives production
+// traffic.
+type SmokeTester interface {
+	// RunCommand runs a shell command with the app's URL in the APP_URL
+	// environment variable. It fails when the command exits non-zero.
+	RunCommand(command string, appURL string) error
+	// CheckURL requests the URL and fails unless the response status is 2xx.
+	CheckURL(url string) error
+}
+
+type smokeTester struct {
+	UI         command.UI
+	HTTPClient *http.Client
+}
+
+func NewSmokeTester(ui command.UI, skipSSLValidation bool) SmokeTester {
+	return &smokeTester{
+		UI: ui,
+		HTTPClient: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: skipSSLValidation},
+			},
+		},
+	}
+}
+
+func (tester *smokeTester) RunCommand(command string, appURL string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	cmd.Env = append(os.Environ(), "APP_URL="+appURL)
+	cmd.Stdout = tester.UI.GetOut()
+	cmd.Stderr = tester.UI.GetErr()
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("smoke test command failed: %s", err)
+	}
+	return nil
+}
+
+func (tester *smokeTester) CheckURL(url string) error {
+	response, err := tester.HTTPClient.Get(url)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %s", url, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("request to %s returned status %d", url, response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package shared_test
+
+import (
+	"net/http"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("smoke tester", func() {
+	var (
+		tester shared.SmokeTester
+		testUI *ui.UI
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		tester = shared.NewSmokeTester(testUI, false)
+	})
+
+	Describe("RunCommand", func() {
+		BeforeEach(func() {
+			if runtime.GOOS == "windows" {
+				Skip("uses a POSIX shell")
+			}
+		})
+
+		It("runs the command with the app URL in the environment", func() {
+			Expect(tester.RunCommand(`echo "testing $APP_URL"`, "https://myapp.example.com")).To(Succeed())
+			Expect(testUI.Out).To(Say("testing https://myapp.example.com"))
+		})
+
+		It("fails when the command exits non-zero", func() {
+			Expect(tester.RunCommand("exit 3", "https://myapp.example.com")).To(MatchError("smoke test command failed: exit status 3"))
+		})
+	})
+
+	Describe("CheckURL", func() {
+		var server *Server
+
+		BeforeEach(func() {
+			server = NewServer()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("succeeds on a 2xx response", func() {
+			server.AppendHandlers(CombineHandlers(
+				VerifyRequest(http.MethodGet, "/health"),
+				RespondWith(http.StatusNoContent, nil),
+			))
+
+			Expect(tester.CheckURL(server.URL() + "/health")).To(Succeed())
+		})
+
+		It("fails on any other response", func() {
+			server.AppendHandlers(RespondWith(http.StatusServiceUnavailable, nil))
+
+			Expect(tester.CheckURL(server.URL() + "/health")).To(MatchError("request to " + server.URL() + "/health returned status 503"))
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package v7
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type SharedServicesCommand struct {
+	BaseCommand
+
+	Organization    string      `short:"o" long:"org" description:"Org whose spaces to inspect (Default: targeted org)"`
+	usage           interface{} `usage:"CF_NAME shared-services [-o ORG]\n\nEXAMPLES:\n   CF_NAME shared-services\n   CF_NAME shared-services -o my-org"`
+	relatedCommands interface{} `related_commands:"service, services, share-service, unshare-service"`
+}
+
+func (cmd SharedServicesCommand) Execute(args []string) error {
+	useTargetedOrg := cmd.Organization == ""
+	if err := cmd.SharedActor.CheckTarget(useTargetedOrg, false); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgGUID, orgName := cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedOrganization().Name
+	if !useTargetedOrg {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		orgGUID, orgName = org.GUID, org.Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting shared service instances in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  orgName,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	shares, warnings, err := cmd.Actor.GetServiceInstanceSharesForOrganization(orgGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(shares) == 0 {
+		cmd.UI.DisplayText("No shared service instances found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("sharing"),
+		cmd.UI.TranslateText("other space"),
+		cmd.UI.TranslateText("bound apps"),
+	}}
+	for _, share := range shares {
+		sharing := cmd.UI.TranslateText("shared to")
+		if share.SharedIn {
+			sharing = cmd.UI.TranslateText("shared from")
+		}
+
+		table = append(table, []string{
+			share.ServiceInstanceName,
+			share.SpaceName,
+			sharing,
+			fmt.Sprintf("%s/%s", share.OtherOrganizationName, share.OtherSpaceName),
+			strconv.Itoa(share.BoundAppCount),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("shared-services Command", func() {
+	var (
+		cmd             v7.SharedServicesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.SharedServicesCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "org-guid", Name: "org-1"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetServiceInstanceSharesForOrganizationReturns(
+			[]v7action.ServiceInstanceShare{
+				{ServiceInstanceName: "db", SpaceName: "dev", OtherOrganizationName: "org-2", OtherSpaceName: "qa", BoundAppCount: 2},
+				{ServiceInstanceName: "queue", SharedIn: true, SpaceName: "prod", OtherOrganizationName: "org-3", OtherSpaceName: "platform", BoundAppCount: 1},
+			},
+			v7action.Warnings{"shares warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the targeted org", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("lists the shared instances of the targeted org", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(fakeActor.GetServiceInstanceSharesForOrganizationArgsForCall(0)).To(Equal("org-guid"))
+
+		Expect(testUI.Out).To(Say(`Getting shared service instances in org org-1 as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`name\s+space\s+sharing\s+other space\s+bound apps`))
+		Expect(testUI.Out).To(Say(`db\s+dev\s+shared to\s+org-2/qa\s+2`))
+		Expect(testUI.Out).To(Say(`queue\s+prod\s+shared from\s+org-3/platform\s+1`))
+		Expect(testUI.Err).To(Say("shares warning"))
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+			Expect(fakeActor.GetServiceInstanceSharesForOrganizationCallCount()).To(BeZero())
+		})
+	})
+
+	When("--org is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--org", "org-2")
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{GUID: "org-2-guid", Name: "org-2"}, v7action.Warnings{"org warning"}, nil)
+		})
+
+		It("lists the shared instances of that org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			checkOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeFalse())
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("org-2"))
+			Expect(fakeActor.GetServiceInstanceSharesForOrganizationArgsForCall(0)).To(Equal("org-2-guid"))
+			Expect(testUI.Out).To(Say(`Getting shared service instances in org org-2 as steve\.\.\.`))
+			Expect(testUI.Err).To(Say("org warning"))
+		})
+
+		When("the org cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(resources.Organization{}, nil, errors.New("org not found"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("org not found"))
+			})
+		})
+	})
+
+	When("nothing is shared", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceSharesForOrganizationReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No shared service instances found."))
+		})
+	})
+
+	When("getting the shares fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceSharesForOrganizationReturns(nil, v7action.Warnings{"shares warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("shares warning"))
+		})
+	})
+})
@@ -0,0 +1,194 @@
+package v7
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+type ShiftTrafficCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.RouteURL `positional-args:"yes"`
+	From            string        `long:"from" required:"true" description:"App to move the traffic away from"`
+	To              string        `long:"to" required:"true" description:"App to move the traffic to"`
+	Step            int           `long:"step" default:"10" description:"Percentage of the route's traffic to move at each step"`
+	Interval        flag.Duration `long:"interval" default:"1m" description:"Time to wait after each step before checking the target app for crashes, e.g. 30s or 5m"`
+	usage           interface{}   `usage:"CF_NAME shift-traffic ROUTE --from APP --to APP [--step PERCENT] [--interval DURATION]\n\n   Gradually moves the route's traffic from one app to another. If the app receiving the traffic crashes, the shift is aborted and the route's original destinations are restored.\n\nEXAMPLES:\n   CF_NAME shift-traffic myapp.example.com --from blue --to green --step 10 --interval 1m"`
+	relatedCommands interface{}   `related_commands:"app-crashes, route, set-route-weights"`
+
+	Now   func() time.Time
+	Sleep func(time.Duration)
+}
+
+func (cmd *ShiftTrafficCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Now = time.Now
+	cmd.Sleep = time.Sleep
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (cmd ShiftTrafficCommand) Execute(args []string) error {
+	if cmd.Step < 1 || cmd.Step > 100 {
+		return translatableerror.IncorrectUsageError{Message: "--step must be between 1 and 100"}
+	}
+	if cmd.From == cmd.To {
+		return translatableerror.IncorrectUsageError{Message: "--from and --to must be different apps"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Shifting traffic of route {{.URL}} from {{.From}} to {{.To}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
+		"URL":       cmd.RequiredArgs.Route,
+		"From":      cmd.From,
+		"To":        cmd.To,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"User":      user.Name,
+	})
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	route, warnings, err := cmd.Actor.GetRoute(cmd.RequiredArgs.Route, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	fromApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.From, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	toApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.To, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	appGUIDs, shares := trafficSharesByApp(route.Destinations)
+	if shares[fromApp.GUID] == 0 {
+		return translatableerror.IncorrectUsageError{Message: "app " + cmd.From + " does not receive traffic from route " + cmd.RequiredArgs.Route}
+	}
+	if _, found := shares[toApp.GUID]; !found {
+		appGUIDs = append(appGUIDs, toApp.GUID)
+	}
+
+	started := cmd.Now()
+	fromDestinations := 0
+	for _, destination := range route.Destinations {
+		if destination.App.GUID == fromApp.GUID {
+			fromDestinations++
+		}
+	}
+
+	for shares[fromApp.GUID] > 0 {
+		// Each destination of the source app needs a weight of at least 1,
+		// so a step that would leave it less than that moves all of its
+		// remaining traffic instead.
+		moved := cmd.Step
+		if shares[fromApp.GUID]-moved < fromDestinations {
+			moved = shares[fromApp.GUID]
+		}
+		shares[fromApp.GUID] -= moved
+		shares[toApp.GUID] += moved
+
+		var weights []v7action.RouteWeight
+		for _, appGUID := range appGUIDs {
+			if shares[appGUID] > 0 {
+				weights = append(weights, v7action.RouteWeight{AppGUID: appGUID, Weight: shares[appGUID]})
+			}
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Routing {{.FromShare}}% of traffic to {{.From}} and {{.ToShare}}% to {{.To}}...", map[string]interface{}{
+			"FromShare": shares[fromApp.GUID],
+			"From":      cmd.From,
+			"ToShare":   shares[toApp.GUID],
+			"To":        cmd.To,
+		})
+
+		_, warnings, err = cmd.Actor.SetRouteWeights(route, weights)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.Sleep(cmd.Interval.Value)
+
+		crashCount, err := cmd.crashCount(spaceGUID, started)
+		if err != nil {
+			return err
+		}
+		if crashCount > 0 {
+			return cmd.revert(route, crashCount)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("All traffic of route {{.URL}} now goes to {{.To}}.", map[string]interface{}{
+		"URL": cmd.RequiredArgs.Route,
+		"To":  cmd.To,
+	})
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ShiftTrafficCommand) crashCount(spaceGUID string, since time.Time) (int, error) {
+	crashes, warnings, err := cmd.Actor.GetApplicationCrashesByNameAndSpace(cmd.To, spaceGUID, since, 0, nil)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, crash := range crashes {
+		if crash.Type == v7action.ProcessCrashEventType {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (cmd ShiftTrafficCommand) revert(route resources.Route, crashCount int) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayWarning("App {{.AppName}} crashed, restoring the original destinations of route {{.URL}}...", map[string]interface{}{
+		"AppName": cmd.To,
+		"URL":     cmd.RequiredArgs.Route,
+	})
+
+	_, warnings, err := cmd.Actor.ReplaceRouteDestinations(route.GUID, route.Destinations)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	return translatableerror.TrafficShiftAbortedError{AppName: cmd.To, CrashCount: crashCount}
+}
+
+// trafficSharesByApp sums the traffic shares of the destinations per app. It
+// also returns the app GUIDs in the order they first appear.
+func trafficSharesByApp(destinations []resources.RouteDestination) ([]string, map[string]int) {
+	var appGUIDs []string
+	shares := map[string]int{}
+	for i, share := range v7action.RouteTrafficShares(destinations) {
+		appGUID := destinations[i].App.GUID
+		if _, found := shares[appGUID]; !found {
+			appGUIDs = append(appGUIDs, appGUID)
+		}
+		shares[appGUID] += share
+	}
+	return appGUIDs, shares
+}
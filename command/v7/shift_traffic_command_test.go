@@ -0,0 +1,221 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("shift-traffic Command", func() {
+	var (
+		cmd             v7.ShiftTrafficCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		now             time.Time
+		sleeps          []time.Duration
+		route           resources.Route
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		now = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		sleeps = nil
+
+		cmd = v7.ShiftTrafficCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.RouteURL{Route: "myapp.example.com"},
+			From:         "blue",
+			To:           "green",
+			Step:         40,
+			Interval:     flag.Duration{Value: time.Minute, IsSet: true},
+			Now:          func() time.Time { return now },
+			Sleep:        func(d time.Duration) { sleeps = append(sleeps, d) },
+		}
+
+		route = resources.Route{
+			GUID: "route-guid",
+			Destinations: []resources.RouteDestination{
+				{GUID: "destination-guid", App: resources.RouteDestinationApp{GUID: "blue-guid"}},
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetRouteReturns(route, v7action.Warnings{"route-warning"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(0, resources.Application{GUID: "blue-guid", Name: "blue"}, nil, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(1, resources.Application{GUID: "green-guid", Name: "green"}, nil, nil)
+		fakeActor.SetRouteWeightsReturns(nil, v7action.Warnings{"weights-warning"}, nil)
+		fakeActor.GetApplicationCrashesByNameAndSpaceReturns(
+			[]v7action.ApplicationCrash{{Type: v7action.ProcessReschedulingEventType}},
+			nil,
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the target", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("moves the traffic step by step, waiting after each step", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.SetRouteWeightsCallCount()).To(Equal(3))
+		passedRoute, weights := fakeActor.SetRouteWeightsArgsForCall(0)
+		Expect(passedRoute).To(Equal(route))
+		Expect(weights).To(Equal([]v7action.RouteWeight{{AppGUID: "blue-guid", Weight: 60}, {AppGUID: "green-guid", Weight: 40}}))
+		_, weights = fakeActor.SetRouteWeightsArgsForCall(1)
+		Expect(weights).To(Equal([]v7action.RouteWeight{{AppGUID: "blue-guid", Weight: 20}, {AppGUID: "green-guid", Weight: 80}}))
+		_, weights = fakeActor.SetRouteWeightsArgsForCall(2)
+		Expect(weights).To(Equal([]v7action.RouteWeight{{AppGUID: "green-guid", Weight: 100}}))
+
+		Expect(sleeps).To(Equal([]time.Duration{time.Minute, time.Minute, time.Minute}))
+
+		Expect(fakeActor.GetApplicationCrashesByNameAndSpaceCallCount()).To(Equal(3))
+		appName, spaceGUID, since, logLines, _ := fakeActor.GetApplicationCrashesByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("green"))
+		Expect(spaceGUID).To(Equal("space-guid"))
+		Expect(since).To(Equal(now))
+		Expect(logLines).To(Equal(0))
+
+		Expect(testUI.Out).To(Say(`Shifting traffic of route myapp\.example\.com from blue to green in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`Routing 60% of traffic to blue and 40% to green\.\.\.`))
+		Expect(testUI.Out).To(Say(`Routing 20% of traffic to blue and 80% to green\.\.\.`))
+		Expect(testUI.Out).To(Say(`Routing 0% of traffic to blue and 100% to green\.\.\.`))
+		Expect(testUI.Out).To(Say(`All traffic of route myapp\.example\.com now goes to green\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("route-warning"))
+		Expect(testUI.Err).To(Say("weights-warning"))
+
+		Expect(fakeActor.ReplaceRouteDestinationsCallCount()).To(Equal(0))
+	})
+
+	When("the source app has several destinations on the route", func() {
+		BeforeEach(func() {
+			cmd.Step = 49
+			route.Destinations = []resources.RouteDestination{
+				{GUID: "web-destination-guid", App: resources.RouteDestinationApp{GUID: "blue-guid"}},
+				{GUID: "worker-destination-guid", App: resources.RouteDestinationApp{GUID: "blue-guid"}},
+				{GUID: "other-port-destination-guid", App: resources.RouteDestinationApp{GUID: "blue-guid"}},
+			}
+			fakeActor.GetRouteReturns(route, nil, nil)
+		})
+
+		It("moves all remaining traffic once it is too little to split across them", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.SetRouteWeightsCallCount()).To(Equal(2))
+			_, weights := fakeActor.SetRouteWeightsArgsForCall(0)
+			Expect(weights).To(Equal([]v7action.RouteWeight{{AppGUID: "blue-guid", Weight: 51}, {AppGUID: "green-guid", Weight: 49}}))
+			_, weights = fakeActor.SetRouteWeightsArgsForCall(1)
+			Expect(weights).To(Equal([]v7action.RouteWeight{{AppGUID: "green-guid", Weight: 100}}))
+		})
+	})
+
+	When("the target app crashes", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashesByNameAndSpaceReturnsOnCall(1,
+				[]v7action.ApplicationCrash{{Type: v7action.ProcessCrashEventType}, {Type: v7action.ProcessCrashEventType}},
+				nil,
+				nil,
+			)
+			fakeActor.ReplaceRouteDestinationsReturns(nil, v7action.Warnings{"revert-warning"}, nil)
+		})
+
+		It("restores the original destinations and aborts", func() {
+			Expect(executeErr).To(MatchError(translatableerror.TrafficShiftAbortedError{AppName: "green", CrashCount: 2}))
+
+			Expect(fakeActor.SetRouteWeightsCallCount()).To(Equal(2))
+			Expect(fakeActor.ReplaceRouteDestinationsCallCount()).To(Equal(1))
+			routeGUID, destinations := fakeActor.ReplaceRouteDestinationsArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(destinations).To(Equal(route.Destinations))
+
+			Expect(testUI.Err).To(Say(`App green crashed, restoring the original destinations of route myapp\.example\.com\.\.\.`))
+			Expect(testUI.Err).To(Say("revert-warning"))
+		})
+
+		When("restoring the destinations fails", func() {
+			BeforeEach(func() {
+				fakeActor.ReplaceRouteDestinationsReturns(nil, nil, errors.New("boom"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("boom"))
+			})
+		})
+	})
+
+	When("the source app does not receive traffic from the route", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(0, resources.Application{GUID: "other-guid", Name: "blue"}, nil, nil)
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "app blue does not receive traffic from route myapp.example.com"}))
+			Expect(fakeActor.SetRouteWeightsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the step is out of range", func() {
+		BeforeEach(func() {
+			cmd.Step = 0
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--step must be between 1 and 100"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--from and --to are the same app", func() {
+		BeforeEach(func() {
+			cmd.To = "blue"
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--from and --to must be different apps"}))
+		})
+	})
+
+	When("setting the weights fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetRouteWeightsReturns(nil, v7action.Warnings{"weights-warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("weights-warning"))
+		})
+	})
+})
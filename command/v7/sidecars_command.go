@@ -0,0 +1,82 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type SidecarsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME sidecars APP_NAME"`
+	relatedCommands interface{}  `related_commands:"app, create-sidecar, delete-sidecar, update-sidecar"`
+}
+
+func (cmd SidecarsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting sidecars for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	sidecars, warnings, err := cmd.Actor.GetApplicationSidecars(application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(sidecars) == 0 {
+		cmd.UI.DisplayText("No sidecars found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("command"),
+			cmd.UI.TranslateText("process types"),
+			cmd.UI.TranslateText("memory"),
+		},
+	}
+	for _, sidecar := range sidecars {
+		var memory string
+		if sidecar.MemoryInMB.IsSet {
+			memory = fmt.Sprintf("%dM", sidecar.MemoryInMB.Value)
+		}
+
+		table = append(table, []string{
+			sidecar.Name,
+			sidecar.Command.Value,
+			strings.Join(sidecar.ProcessTypes, ", "),
+			memory,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sidecars Command", func() {
+	var (
+		cmd             SidecarsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = SidecarsCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the app has sidecars", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsReturns(
+				[]resources.Sidecar{
+					{
+						Name:         "config-server",
+						Command:      types.FilteredString{IsSet: true, Value: "./config-server"},
+						ProcessTypes: []string{"web", "worker"},
+						MemoryInMB:   types.NullUint64{IsSet: true, Value: 128},
+					},
+					{
+						Name:         "proxy",
+						Command:      types.FilteredString{IsSet: true, Value: "./proxy"},
+						ProcessTypes: []string{"web"},
+					},
+				},
+				v7action.Warnings{"sidecars-warning"},
+				nil,
+			)
+		})
+
+		It("lists the sidecars", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetApplicationSidecarsArgsForCall(0)).To(Equal("some-app-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting sidecars for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+command\s+process types\s+memory`))
+			Expect(testUI.Out).To(Say(`config-server\s+\./config-server\s+web, worker\s+128M`))
+			Expect(testUI.Out).To(Say(`proxy\s+\./proxy\s+web`))
+
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("sidecars-warning"))
+		})
+	})
+
+	When("the app has no sidecars", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No sidecars found."))
+		})
+	})
+
+	When("getting the sidecars fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsReturns(nil, v7action.Warnings{"sidecars-warning"}, errors.New("sidecars-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("sidecars-error"))
+			Expect(testUI.Err).To(Say("sidecars-warning"))
+		})
+	})
+})
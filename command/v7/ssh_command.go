@@ -12,6 +12,7 @@ import (
 
 type SharedSSHActor interface {
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	RunSecureShellCommand(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) ([]byte, error)
 }
 
 type SSHCommand struct {
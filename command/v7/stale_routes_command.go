@@ -0,0 +1,177 @@
+package v7
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type StaleRoutesCommand struct {
+	BaseCommand
+
+	OrgLevel        bool          `long:"org-level" description:"List stale routes for all spaces of the current organization"`
+	OlderThan       flag.Duration `long:"older-than" description:"Only list routes created longer ago than this, e.g. 24h or 720h"`
+	Delete          bool          `long:"delete" description:"Delete the stale routes, asking once for all of them"`
+	ConfirmEach     bool          `long:"confirm-each" description:"With --delete, ask before deleting each route"`
+	Force           bool          `short:"f" description:"With --delete, delete without confirmation"`
+	usage           interface{}   `usage:"CF_NAME stale-routes [--org-level] [--older-than DURATION] [--delete [--confirm-each | -f]]\n\n   Lists routes that cannot reach a running app: every destination app is stopped, has no\n   instances or has no process of the destination process type, or the route has no\n   destinations but is bound to a route service. Routes without destinations or route\n   services are removed by 'CF_NAME delete-orphaned-routes'.\n\nEXAMPLES:\n   CF_NAME stale-routes --org-level\n   CF_NAME stale-routes --older-than 720h --delete --confirm-each"`
+	relatedCommands interface{}   `related_commands:"delete-orphaned-routes, delete-route, routes, unbind-route-service"`
+}
+
+func (cmd StaleRoutesCommand) Execute(args []string) error {
+	if cmd.Force && cmd.ConfirmEach {
+		return translatableerror.ArgumentCombinationError{Args: []string{"-f", "--confirm-each"}}
+	}
+	if cmd.Force && !cmd.Delete {
+		return translatableerror.RequiredFlagsError{Arg1: "-f", Arg2: "--delete"}
+	}
+	if cmd.ConfirmEach && !cmd.Delete {
+		return translatableerror.RequiredFlagsError{Arg1: "--confirm-each", Arg2: "--delete"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	currentUser, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	targetedOrg := cmd.Config.TargetedOrganization()
+	targetedSpace := cmd.Config.TargetedSpace()
+
+	var (
+		routes   []resources.Route
+		warnings v7action.Warnings
+	)
+	if cmd.OrgLevel {
+		cmd.UI.DisplayTextWithFlavor("Getting stale routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
+			"CurrentOrg":  targetedOrg.Name,
+			"CurrentUser": currentUser.Name,
+		})
+		routes, warnings, err = cmd.Actor.GetRoutesByOrg(targetedOrg.GUID, "")
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting stale routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
+			"CurrentOrg":   targetedOrg.Name,
+			"CurrentSpace": targetedSpace.Name,
+			"CurrentUser":  currentUser.Name,
+		})
+		routes, warnings, err = cmd.Actor.GetRoutesBySpace(targetedSpace.GUID, "")
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var createdBefore time.Time
+	if cmd.OlderThan.IsSet {
+		createdBefore = time.Now().Add(-cmd.OlderThan.Value)
+	}
+
+	staleRoutes, warnings, err := cmd.Actor.GetStaleRoutes(routes, createdBefore)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(staleRoutes) == 0 {
+		cmd.UI.DisplayText("No stale routes found.")
+		return nil
+	}
+
+	cmd.displayStaleRoutesTable(staleRoutes)
+
+	if !cmd.Delete {
+		return nil
+	}
+
+	return cmd.deleteStaleRoutes(staleRoutes)
+}
+
+func (cmd StaleRoutesCommand) deleteStaleRoutes(staleRoutes []v7action.StaleRoute) error {
+	cmd.UI.DisplayNewline()
+
+	if !cmd.Force && !cmd.ConfirmEach {
+		deleteAll, err := cmd.UI.DisplayBoolPrompt(false, "Really delete {{.Count}} stale routes?", map[string]interface{}{
+			"Count": len(staleRoutes),
+		})
+		if err != nil {
+			return err
+		}
+
+		if !deleteAll {
+			cmd.UI.DisplayText("Routes have not been deleted.")
+			return nil
+		}
+	}
+
+	deleted := 0
+	for _, staleRoute := range staleRoutes {
+		if cmd.ConfirmEach {
+			deleteRoute, err := cmd.UI.DisplayBoolPrompt(false, "Really delete the route {{.URL}}?", map[string]interface{}{
+				"URL": staleRoute.Route.URL,
+			})
+			if err != nil {
+				return err
+			}
+
+			if !deleteRoute {
+				continue
+			}
+		}
+
+		cmd.UI.DisplayText("Deleting route {{.URL}}...", map[string]interface{}{
+			"URL": staleRoute.Route.URL,
+		})
+		warnings, err := cmd.Actor.DeleteStaleRoute(staleRoute)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		deleted++
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Deleted {{.Count}} stale routes.", map[string]interface{}{
+		"Count": deleted,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd StaleRoutesCommand) displayStaleRoutesTable(staleRoutes []v7action.StaleRoute) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("route"),
+			cmd.UI.TranslateText("apps"),
+			cmd.UI.TranslateText("service instance"),
+			cmd.UI.TranslateText("reason"),
+		},
+	}
+
+	for _, staleRoute := range staleRoutes {
+		var reasons []string
+		for _, reason := range staleRoute.Reasons {
+			reasons = append(reasons, cmd.UI.TranslateText(string(reason)))
+		}
+
+		table = append(table, []string{
+			staleRoute.SpaceName,
+			staleRoute.Route.URL,
+			strings.Join(staleRoute.AppNames, ", "),
+			staleRoute.ServiceInstanceName,
+			strings.Join(reasons, ", "),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
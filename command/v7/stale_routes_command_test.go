@@ -0,0 +1,267 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("stale-routes Command", func() {
+	var (
+		cmd             StaleRoutesCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+		routes          []resources.Route
+		staleRoutes     []v7action.StaleRoute
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = StaleRoutesCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		routes = []resources.Route{{GUID: "route-1-guid"}, {GUID: "route-2-guid"}}
+		fakeActor.GetRoutesBySpaceReturns(routes, v7action.Warnings{"get-routes-warning"}, nil)
+		fakeActor.GetRoutesByOrgReturns(routes, v7action.Warnings{"get-routes-warning"}, nil)
+
+		staleRoutes = []v7action.StaleRoute{
+			{
+				Route:     resources.Route{GUID: "route-1-guid", URL: "old.example.com"},
+				SpaceName: "some-space",
+				AppNames:  []string{"app-1", "app-2"},
+				Reasons:   []v7action.StaleRouteReason{v7action.StaleRouteAppStopped, v7action.StaleRouteNoInstances},
+			},
+			{
+				Route:               resources.Route{GUID: "route-2-guid", URL: "proxied.example.com"},
+				SpaceName:           "some-space",
+				ServiceInstanceName: "some-route-service",
+				RouteBindingGUID:    "binding-guid",
+				Reasons:             []v7action.StaleRouteReason{v7action.StaleRouteOnlyRouteService},
+			},
+		}
+		fakeActor.GetStaleRoutesReturns(staleRoutes, v7action.Warnings{"get-stale-routes-warning"}, nil)
+		fakeActor.DeleteStaleRouteReturns(v7action.Warnings{"delete-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("-f is given without --delete", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-f", Arg2: "--delete"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--confirm-each is given without --delete", func() {
+		BeforeEach(func() {
+			cmd.ConfirmEach = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--confirm-each", Arg2: "--delete"}))
+		})
+	})
+
+	When("-f and --confirm-each are both given", func() {
+		BeforeEach(func() {
+			cmd.Delete = true
+			cmd.Force = true
+			cmd.ConfirmEach = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"-f", "--confirm-each"}}))
+		})
+	})
+
+	It("lists the stale routes of the targeted space", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		spaceGUID, labels := fakeActor.GetRoutesBySpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(labels).To(BeEmpty())
+
+		passedRoutes, createdBefore := fakeActor.GetStaleRoutesArgsForCall(0)
+		Expect(passedRoutes).To(Equal(routes))
+		Expect(createdBefore.IsZero()).To(BeTrue())
+
+		Expect(testUI.Out).To(Say(`Getting stale routes for org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`space\s+route\s+apps\s+service instance\s+reason`))
+		Expect(testUI.Out).To(Say(`some-space\s+old\.example\.com\s+app-1, app-2\s+app stopped, no instances`))
+		Expect(testUI.Out).To(Say(`some-space\s+proxied\.example\.com\s+some-route-service\s+route service without apps`))
+		Expect(testUI.Err).To(Say("get-routes-warning"))
+		Expect(testUI.Err).To(Say("get-stale-routes-warning"))
+
+		Expect(fakeActor.DeleteStaleRouteCallCount()).To(Equal(0))
+	})
+
+	When("--org-level and --older-than are given", func() {
+		BeforeEach(func() {
+			cmd.OrgLevel = true
+			cmd.OlderThan = flag.Duration{Value: 24 * time.Hour, IsSet: true}
+		})
+
+		It("lists the old stale routes of the targeted org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			orgGUID, _ := fakeActor.GetRoutesByOrgArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(fakeActor.GetRoutesBySpaceCallCount()).To(Equal(0))
+
+			_, createdBefore := fakeActor.GetStaleRoutesArgsForCall(0)
+			Expect(createdBefore).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+
+			Expect(testUI.Out).To(Say(`Getting stale routes for org some-org as steve\.\.\.`))
+		})
+	})
+
+	When("there are no stale routes", func() {
+		BeforeEach(func() {
+			cmd.Delete = true
+			fakeActor.GetStaleRoutesReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No stale routes found\.`))
+			Expect(fakeActor.DeleteStaleRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--delete is given", func() {
+		BeforeEach(func() {
+			cmd.Delete = true
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("deletes every stale route", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Really delete 2 stale routes\?`))
+				Expect(testUI.Out).To(Say(`Deleting route old\.example\.com\.\.\.`))
+				Expect(testUI.Out).To(Say(`Deleting route proxied\.example\.com\.\.\.`))
+				Expect(testUI.Out).To(Say(`Deleted 2 stale routes\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+
+				Expect(fakeActor.DeleteStaleRouteCallCount()).To(Equal(2))
+				Expect(fakeActor.DeleteStaleRouteArgsForCall(1)).To(Equal(staleRoutes[1]))
+			})
+		})
+
+		When("the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("deletes nothing", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Routes have not been deleted\.`))
+				Expect(fakeActor.DeleteStaleRouteCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--confirm-each is given", func() {
+			BeforeEach(func() {
+				cmd.ConfirmEach = true
+				_, err := input.Write([]byte("n\ny\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("asks for each route", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Really delete the route old\.example\.com\?`))
+				Expect(testUI.Out).To(Say(`Really delete the route proxied\.example\.com\?`))
+				Expect(testUI.Out).To(Say(`Deleted 1 stale routes\.`))
+
+				Expect(fakeActor.DeleteStaleRouteCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteStaleRouteArgsForCall(0)).To(Equal(staleRoutes[1]))
+			})
+		})
+
+		When("-f is given", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("deletes without asking", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).NotTo(Say(`Really delete`))
+				Expect(fakeActor.DeleteStaleRouteCallCount()).To(Equal(2))
+			})
+		})
+
+		When("deleting a route fails", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+				fakeActor.DeleteStaleRouteReturns(v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("stops and returns the error", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+				Expect(fakeActor.DeleteStaleRouteCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
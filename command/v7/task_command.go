@@ -0,0 +1,97 @@
+package v7
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type TaskCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.TaskArgs `positional-args:"yes"`
+	usage           interface{}   `usage:"CF_NAME task APP_NAME TASK_ID\n\nEXAMPLES:\n   CF_NAME task my-app 3"`
+	relatedCommands interface{}   `related_commands:"logs, run-task, tasks, terminate-task"`
+}
+
+func (cmd TaskCommand) Execute(args []string) error {
+	sequenceID, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
+	if err != nil {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "TASK_ID",
+			ExpectedType: "integer",
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting task {{.TaskSequenceID}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TaskSequenceID": sequenceID,
+		"AppName":        cmd.RequiredArgs.AppName,
+		"OrgName":        cmd.Config.TargetedOrganization().Name,
+		"SpaceName":      space.Name,
+		"CurrentUser":    user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceID, application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	startTime := task.CreatedAt
+	if t, err := time.Parse(time.RFC3339, task.CreatedAt); err == nil {
+		startTime = t.Format(time.RFC1123)
+	}
+
+	command := task.Command
+	if command == "" {
+		command = "[hidden]"
+	}
+
+	logRateLimit := "unlimited"
+	if task.LogRateLimitInBPS != -1 {
+		logRateLimit = bytefmt.ByteSize(uint64(task.LogRateLimitInBPS)) + "/s"
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("id:"), strconv.FormatInt(task.SequenceID, 10)},
+		{cmd.UI.TranslateText("name:"), task.Name},
+		{cmd.UI.TranslateText("state:"), cmd.UI.TranslateText(string(task.State))},
+		{cmd.UI.TranslateText("start time:"), startTime},
+		{cmd.UI.TranslateText("command:"), command},
+		{cmd.UI.TranslateText("memory:"), fmt.Sprintf("%dM", task.MemoryInMB)},
+		{cmd.UI.TranslateText("disk:"), fmt.Sprintf("%dM", task.DiskInMB)},
+		{cmd.UI.TranslateText("log rate limit:"), logRateLimit},
+		{cmd.UI.TranslateText("droplet:"), task.DropletGUID},
+	}
+
+	if task.Result != nil && task.Result.FailureReason != "" {
+		table = append(table, []string{cmd.UI.TranslateText("failure reason:"), task.Result.FailureReason})
+	}
+
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	return nil
+}
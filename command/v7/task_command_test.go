@@ -0,0 +1,172 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task Command", func() {
+	var (
+		cmd             TaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = TaskCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		cmd.RequiredArgs.AppName = "some-app-name"
+		cmd.RequiredArgs.SequenceID = "3"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			GUID: "some-org-guid",
+			Name: "some-org",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			GUID: "some-space-guid",
+			Name: "some-space",
+		})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			resources.Application{GUID: "some-app-guid"},
+			v7action.Warnings{"get-application-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the task id argument is not an integer", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.SequenceID = "not-an-integer"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "TASK_ID",
+				ExpectedType: "integer",
+			}))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the task exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetTaskBySequenceIDAndApplicationReturns(
+				resources.Task{
+					GUID:              "task-guid",
+					SequenceID:        3,
+					Name:              "migrate",
+					State:             constant.TaskFailed,
+					CreatedAt:         "2016-11-08T22:26:02Z",
+					Command:           "rake db:migrate",
+					MemoryInMB:        256,
+					DiskInMB:          1024,
+					LogRateLimitInBPS: -1,
+					DropletGUID:       "some-droplet-guid",
+					Result:            &resources.TaskResult{FailureReason: "Exited with status 1"},
+				},
+				v7action.Warnings{"get-task-warning"},
+				nil)
+		})
+
+		It("displays the task details and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app-name"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			sequenceID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+			Expect(sequenceID).To(Equal(3))
+			Expect(appGUID).To(Equal("some-app-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting task 3 for app some-app-name in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`id:\s+3`))
+			Expect(testUI.Out).To(Say(`name:\s+migrate`))
+			Expect(testUI.Out).To(Say(`state:\s+FAILED`))
+			Expect(testUI.Out).To(Say(`start time:\s+Tue, 08 Nov 2016 22:26:02 UTC`))
+			Expect(testUI.Out).To(Say(`command:\s+rake db:migrate`))
+			Expect(testUI.Out).To(Say(`memory:\s+256M`))
+			Expect(testUI.Out).To(Say(`disk:\s+1024M`))
+			Expect(testUI.Out).To(Say(`log rate limit:\s+unlimited`))
+			Expect(testUI.Out).To(Say(`droplet:\s+some-droplet-guid`))
+			Expect(testUI.Out).To(Say(`failure reason:\s+Exited with status 1`))
+
+			Expect(testUI.Err).To(Say("get-application-warning"))
+			Expect(testUI.Err).To(Say("get-task-warning"))
+		})
+
+		When("the command is not visible to the user", func() {
+			BeforeEach(func() {
+				fakeActor.GetTaskBySequenceIDAndApplicationReturns(resources.Task{SequenceID: 3, LogRateLimitInBPS: 1024}, nil, nil)
+			})
+
+			It("displays it as hidden", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`command:\s+\[hidden\]`))
+				Expect(testUI.Out).To(Say(`log rate limit:\s+1K/s`))
+				Expect(testUI.Out).ToNot(Say("failure reason:"))
+			})
+		})
+	})
+
+	When("getting the task fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetTaskBySequenceIDAndApplicationReturns(
+				resources.Task{},
+				v7action.Warnings{"get-task-warning"},
+				errors.New("get-task-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("get-task-error"))
+			Expect(testUI.Err).To(Say("get-task-warning"))
+		})
+	})
+})
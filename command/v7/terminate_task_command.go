@@ -1,32 +1,54 @@
 package v7
 
 import (
+	"strconv"
+
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type TerminateTaskCommand struct {
 	BaseCommand
 
 	RequiredArgs    flag.TerminateTaskArgs `positional-args:"yes"`
-	usage           interface{}            `usage:"CF_NAME terminate-task APP_NAME TASK_ID\n\nEXAMPLES:\n   CF_NAME terminate-task my-app 3"`
-	relatedCommands interface{}            `related_commands:"tasks"`
+	AllRunning      bool                   `long:"all-running" description:"Terminate all running tasks of the app"`
+	NamePattern     string                 `long:"name" description:"Terminate all running tasks whose name matches this pattern (e.g. 'migrate-*')"`
+	usage           interface{}            `usage:"CF_NAME terminate-task APP_NAME TASK_ID\n   CF_NAME terminate-task APP_NAME (--all-running | --name PATTERN)\n\nEXAMPLES:\n   CF_NAME terminate-task my-app 3\n   CF_NAME terminate-task my-app --all-running\n   CF_NAME terminate-task my-app --name 'migrate-*'"`
+	relatedCommands interface{}            `related_commands:"task, tasks"`
 }
 
 func (cmd TerminateTaskCommand) Execute(args []string) error {
-	sequenceID, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
-	if err != nil {
-		return translatableerror.ParseArgumentError{
-			ArgumentName: "TASK_ID",
-			ExpectedType: "integer",
+	bulk := cmd.AllRunning || cmd.NamePattern != ""
+
+	if cmd.RequiredArgs.SequenceID != "" && bulk {
+		conflictingArgs := []string{"TASK_ID"}
+		if cmd.AllRunning {
+			conflictingArgs = append(conflictingArgs, "--all-running")
 		}
+		if cmd.NamePattern != "" {
+			conflictingArgs = append(conflictingArgs, "--name")
+		}
+		return translatableerror.ArgumentCombinationError{Args: conflictingArgs}
 	}
 
-	if err != nil {
-		return err
+	if cmd.RequiredArgs.SequenceID == "" && !bulk {
+		return translatableerror.RequiredArgumentError{ArgumentName: "TASK_ID"}
+	}
+
+	var sequenceID int
+	if !bulk {
+		var err error
+		sequenceID, err = flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
+		if err != nil {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "TASK_ID",
+				ExpectedType: "integer",
+			}
+		}
 	}
 
-	err = cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -44,6 +66,10 @@ func (cmd TerminateTaskCommand) Execute(args []string) error {
 		return err
 	}
 
+	if bulk {
+		return cmd.terminateRunningTasks(application.GUID, user.Name)
+	}
+
 	task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceID, application.GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -69,3 +95,57 @@ func (cmd TerminateTaskCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd TerminateTaskCommand) terminateRunningTasks(appGUID string, username string) error {
+	cmd.UI.DisplayTextWithFlavor("Terminating running tasks of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": username,
+		})
+
+	tasks, warnings, err := cmd.Actor.GetRunningApplicationTasks(appGUID, cmd.NamePattern)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(tasks) == 0 {
+		cmd.UI.DisplayText("No running tasks found.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	var failedTasks []resources.Task
+	for _, task := range tasks {
+		_, warnings, err := cmd.Actor.TerminateTask(task.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning("Failed to terminate task {{.TaskSequenceID}} ({{.TaskName}}): {{.Error}}", map[string]interface{}{
+				"TaskSequenceID": task.SequenceID,
+				"TaskName":       task.Name,
+				"Error":          err,
+			})
+			failedTasks = append(failedTasks, task)
+			continue
+		}
+
+		cmd.UI.DisplayText("Terminated task {{.TaskSequenceID}} ({{.TaskName}})", map[string]interface{}{
+			"TaskSequenceID": task.SequenceID,
+			"TaskName":       task.Name,
+		})
+	}
+
+	if len(failedTasks) > 0 {
+		var ids []string
+		for _, task := range failedTasks {
+			ids = append(ids, strconv.FormatInt(task.SequenceID, 10))
+		}
+		return translatableerror.TasksNotTerminatedError{SequenceIDs: ids}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -68,6 +68,30 @@ var _ = Describe("terminate-task Command", func() {
 		})
 	})
 
+	When("neither a task id nor a bulk flag is given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.SequenceID = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "TASK_ID"}))
+		})
+	})
+
+	When("a task id is given together with a bulk flag", func() {
+		BeforeEach(func() {
+			cmd.AllRunning = true
+			cmd.NamePattern = "migrate-*"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"TASK_ID", "--all-running", "--name"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	When("checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -160,6 +184,75 @@ var _ = Describe("terminate-task Command", func() {
 				})
 			})
 
+			When("terminating running tasks in bulk", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.SequenceID = ""
+					cmd.NamePattern = "migrate-*"
+
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						resources.Application{GUID: "some-app-guid"},
+						nil,
+						nil)
+					fakeActor.GetRunningApplicationTasksReturns(
+						[]resources.Task{
+							{GUID: "task-2-guid", SequenceID: 2, Name: "migrate-orders"},
+							{GUID: "task-5-guid", SequenceID: 5, Name: "migrate-users"},
+						},
+						v7action.Warnings{"get-tasks-warning"},
+						nil)
+					fakeActor.TerminateTaskReturns(
+						resources.Task{},
+						v7action.Warnings{"terminate-task-warning"},
+						nil)
+				})
+
+				It("terminates every matching running task", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					appGUID, namePattern := fakeActor.GetRunningApplicationTasksArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(namePattern).To(Equal("migrate-*"))
+
+					Expect(fakeActor.TerminateTaskCallCount()).To(Equal(2))
+					Expect(fakeActor.TerminateTaskArgsForCall(0)).To(Equal("task-2-guid"))
+					Expect(fakeActor.TerminateTaskArgsForCall(1)).To(Equal("task-5-guid"))
+					Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(Equal(0))
+
+					Expect(testUI.Out).To(Say(`Terminating running tasks of app some-app-name in org some-org / space some-space as some-user\.\.\.`))
+					Expect(testUI.Out).To(Say(`Terminated task 2 \(migrate-orders\)`))
+					Expect(testUI.Out).To(Say(`Terminated task 5 \(migrate-users\)`))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("get-tasks-warning"))
+					Expect(testUI.Err).To(Say("terminate-task-warning"))
+				})
+
+				When("there are no running tasks", func() {
+					BeforeEach(func() {
+						fakeActor.GetRunningApplicationTasksReturns(nil, nil, nil)
+					})
+
+					It("says so", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("No running tasks found."))
+						Expect(fakeActor.TerminateTaskCallCount()).To(Equal(0))
+					})
+				})
+
+				When("terminating some of the tasks fails", func() {
+					BeforeEach(func() {
+						fakeActor.TerminateTaskReturnsOnCall(0, resources.Task{}, nil, errors.New("cancel-error"))
+						fakeActor.TerminateTaskReturnsOnCall(1, resources.Task{}, nil, nil)
+					})
+
+					It("terminates the remaining tasks and returns an error listing the failures", func() {
+						Expect(executeErr).To(MatchError(translatableerror.TasksNotTerminatedError{SequenceIDs: []string{"2"}}))
+						Expect(fakeActor.TerminateTaskCallCount()).To(Equal(2))
+						Expect(testUI.Err).To(Say(`Failed to terminate task 2 \(migrate-orders\): cancel-error`))
+						Expect(testUI.Out).To(Say(`Terminated task 5 \(migrate-users\)`))
+					})
+				})
+			})
+
 			When("there are errors", func() {
 				When("the error is translatable", func() {
 					var (
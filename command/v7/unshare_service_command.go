@@ -1,8 +1,11 @@
 package v7
 
 import (
+	"strconv"
+
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/types"
 )
 
@@ -10,21 +13,35 @@ type UnshareServiceCommand struct {
 	BaseCommand
 
 	RequiredArgs    flag.ShareServiceArgs `positional-args:"yes"`
-	SpaceName       string                `short:"s" required:"true" description:"Space to unshare the service instance from"`
+	SpaceName       string                `short:"s" description:"Space to unshare the service instance from"`
 	OrgName         flag.OptionalString   `short:"o" required:"false" description:"Org of the other space (Default: targeted org)"`
-	Force           bool                  `short:"f" description:"Force unshare without confirmation"`
+	All             bool                  `long:"all" description:"Unshare the service instance from every space it is shared into"`
+	Force           bool                  `short:"f" description:"Force unshare without confirmation, even when apps are bound to the service instance in the shared spaces"`
 	relatedCommands interface{}           `related_commands:"delete-service, service, services, share-service, unbind-service"`
 }
 
 func (cmd UnshareServiceCommand) Usage() string {
-	return "CF_NAME unshare-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG] [-f]"
+	return "CF_NAME unshare-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG] [-f]\n   CF_NAME unshare-service SERVICE_INSTANCE --all [-f]"
 }
 
 func (cmd UnshareServiceCommand) Execute(args []string) error {
+	switch {
+	case cmd.All && cmd.SpaceName != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"--all", "-s"}}
+	case cmd.All && cmd.OrgName.IsSet:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--all", "-o"}}
+	case !cmd.All && cmd.SpaceName == "":
+		return translatableerror.IncorrectUsageError{Message: "either -s or --all must be provided"}
+	}
+
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
 
+	if cmd.All {
+		return cmd.unshareFromAllSpaces()
+	}
+
 	if !cmd.Force {
 		cmd.UI.DisplayWarning(
 			`WARNING: Unsharing this service instance will remove any existing bindings originating from the service instance in the space "{{.SpaceName}}". This could cause apps to stop working.`,
@@ -104,3 +121,84 @@ func (cmd UnshareServiceCommand) displayPrompt() (bool, error) {
 
 	return unshare, nil
 }
+
+func (cmd UnshareServiceCommand) unshareFromAllSpaces() error {
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"Unsharing service instance {{.ServiceInstanceName}} from all spaces as {{.Username}}...",
+		map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"Username":            user.Name,
+		},
+	)
+
+	sharedSpaces, warnings, err := cmd.Actor.GetServiceInstanceSharedSpacesUsage(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(sharedSpaces.Spaces) == 0 {
+		cmd.UI.DisplayText("Service instance {{.ServiceInstanceName}} is not shared into any spaces.", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	var (
+		spaceGUIDs    []string
+		boundAppCount int
+	)
+	table := [][]string{{
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("bound apps"),
+	}}
+	for _, space := range sharedSpaces.Spaces {
+		spaceGUIDs = append(spaceGUIDs, space.SpaceGUID)
+		boundAppCount += space.BoundAppCount
+		table = append(table, []string{space.OrganizationName, space.SpaceName, strconv.Itoa(space.BoundAppCount)})
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if !cmd.Force {
+		if boundAppCount > 0 {
+			return translatableerror.ServiceInstanceSharedBindingsError{
+				ServiceInstanceName: cmd.RequiredArgs.ServiceInstance,
+				BoundAppCount:       boundAppCount,
+			}
+		}
+
+		cmd.UI.DisplayNewline()
+		unshare, err := cmd.UI.DisplayBoolPrompt(
+			false,
+			"Really unshare the service instance {{.ServiceInstanceName}} from {{.Count}} spaces?",
+			map[string]interface{}{
+				"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+				"Count":               len(spaceGUIDs),
+			})
+		if err != nil {
+			return err
+		}
+
+		if !unshare {
+			cmd.UI.DisplayText("Unshare cancelled")
+			return nil
+		}
+	}
+
+	warnings, err = cmd.Actor.UnshareServiceInstanceFromSpaces(sharedSpaces.ServiceInstanceGUID, spaceGUIDs)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
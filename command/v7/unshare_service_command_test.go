@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -124,6 +125,7 @@ var _ = Describe("unshare-service command", func() {
 				Actor:       fakeActor,
 			},
 		}
+		setFlag(&cmd, "-s", expectedSpaceName)
 	})
 
 	JustBeforeEach(func() {
@@ -228,4 +230,162 @@ var _ = Describe("unshare-service command", func() {
 			})
 		})
 	})
+
+	Context("flag validation", func() {
+		When("neither -s nor --all is given", func() {
+			BeforeEach(func() {
+				cmd.SpaceName = ""
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "either -s or --all must be provided"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+			})
+		})
+
+		When("-s and --all are both given", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--all")
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all", "-s"}}))
+			})
+		})
+
+		When("-o and --all are both given", func() {
+			BeforeEach(func() {
+				cmd.SpaceName = ""
+				setFlag(&cmd, "--all")
+				setFlag(&cmd, "-o", types.NewOptionalString("other-org"))
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all", "-o"}}))
+			})
+		})
+	})
+
+	Context("the --all flag is given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ServiceInstance = expectedServiceInstanceName
+			cmd.SpaceName = ""
+			setFlag(&cmd, "--all")
+
+			fakeActor.GetServiceInstanceSharedSpacesUsageReturns(
+				v7action.ServiceInstanceSharedSpaces{
+					ServiceInstanceGUID: "instance-guid",
+					Spaces: []v7action.SharedSpaceUsage{
+						{SpaceGUID: "space-1-guid", SpaceName: "space-1", OrganizationName: "org-1"},
+						{SpaceGUID: "space-2-guid", SpaceName: "space-2", OrganizationName: "org-2"},
+					},
+				},
+				v7action.Warnings{"usage warning"},
+				nil,
+			)
+			fakeActor.UnshareServiceInstanceFromSpacesReturns(v7action.Warnings{"unshare warning"}, nil)
+		})
+
+		It("lists the shared spaces and prompts the user", func() {
+			Expect(testUI.Out).To(Say(`Unsharing service instance %s from all spaces as %s\.\.\.`, expectedServiceInstanceName, expectedUser))
+			Expect(testUI.Out).To(Say(`org\s+space\s+bound apps`))
+			Expect(testUI.Out).To(Say(`org-1\s+space-1\s+0`))
+			Expect(testUI.Out).To(Say(`org-2\s+space-2\s+0`))
+			Expect(testUI.Out).To(Say(`Really unshare the service instance %s from 2 spaces\? \[yN\]:`, expectedServiceInstanceName))
+			Expect(testUI.Err).To(Say("usage warning"))
+
+			instanceName, spaceGUID := fakeActor.GetServiceInstanceSharedSpacesUsageArgsForCall(0)
+			Expect(instanceName).To(Equal(expectedServiceInstanceName))
+			Expect(spaceGUID).To(Equal(expectedTargetedSpaceGuid))
+		})
+
+		When("the user says yes", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("unshares the instance from every space", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.UnshareServiceInstanceFromSpacesCallCount()).To(Equal(1))
+				instanceGUID, spaceGUIDs := fakeActor.UnshareServiceInstanceFromSpacesArgsForCall(0)
+				Expect(instanceGUID).To(Equal("instance-guid"))
+				Expect(spaceGUIDs).To(Equal([]string{"space-1-guid", "space-2-guid"}))
+				Expect(testUI.Err).To(Say("unshare warning"))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("the user says no", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not unshare", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("Unshare cancelled"))
+				Expect(fakeActor.UnshareServiceInstanceFromSpacesCallCount()).To(BeZero())
+			})
+		})
+
+		When("apps are bound in a shared space", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceSharedSpacesUsageReturns(
+					v7action.ServiceInstanceSharedSpaces{
+						ServiceInstanceGUID: "instance-guid",
+						Spaces: []v7action.SharedSpaceUsage{
+							{SpaceGUID: "space-1-guid", SpaceName: "space-1", OrganizationName: "org-1", BoundAppCount: 2},
+						},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("refuses to unshare", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceSharedBindingsError{
+					ServiceInstanceName: expectedServiceInstanceName,
+					BoundAppCount:       2,
+				}))
+				Expect(fakeActor.UnshareServiceInstanceFromSpacesCallCount()).To(BeZero())
+			})
+
+			When("the -f flag is specified", func() {
+				BeforeEach(func() {
+					setFlag(&cmd, "-f")
+				})
+
+				It("unshares without prompting", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).NotTo(Say("Really unshare"))
+					Expect(fakeActor.UnshareServiceInstanceFromSpacesCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("the instance is not shared", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceSharedSpacesUsageReturns(v7action.ServiceInstanceSharedSpaces{ServiceInstanceGUID: "instance-guid"}, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("Service instance %s is not shared into any spaces.", expectedServiceInstanceName))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeActor.UnshareServiceInstanceFromSpacesCallCount()).To(BeZero())
+			})
+		})
+
+		When("getting the shared spaces fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceSharedSpacesUsageReturns(v7action.ServiceInstanceSharedSpaces{}, v7action.Warnings{"usage warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(testUI.Err).To(Say("usage warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,76 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type UpdateRouteCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.Domain        `positional-args:"yes"`
+	usage           interface{}        `usage:"Update an HTTP route:\n      CF_NAME update-route DOMAIN [--hostname HOSTNAME] [--path PATH] [--option KEY=VALUE]... [--remove-option KEY]...\n\n   Update a TCP route:\n      CF_NAME update-route DOMAIN --port PORT [--option KEY=VALUE]... [--remove-option KEY]...\n\nEXAMPLES:\n   CF_NAME update-route example.com --hostname myapp --option loadbalancing=least-connection\n   CF_NAME update-route example.com --hostname myapp --remove-option loadbalancing"`
+	Hostname        string             `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
+	Path            flag.V7RoutePath   `long:"path" description:"Path used to identify the HTTP route"`
+	Port            int                `long:"port" description:"Port used to identify the TCP route"`
+	Options         []flag.RouteOption `long:"option" description:"Set a route option as KEY=VALUE, such as loadbalancing=round-robin or loadbalancing=least-connection (can be used multiple times)"`
+	RemoveOptions   []string           `long:"remove-option" description:"Remove a route option (can be used multiple times)"`
+	relatedCommands interface{}        `related_commands:"check-route, create-route, map-route, route, routes"`
+}
+
+func (cmd UpdateRouteCommand) Execute(args []string) error {
+	if len(cmd.Options) == 0 && len(cmd.RemoveOptions) == 0 {
+		return translatableerror.IncorrectUsageError{Message: "at least one of --option or --remove-option must be provided"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	domain, warnings, err := cmd.Actor.GetDomainByName(cmd.RequiredArgs.Domain)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating route {{.URL}} for org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...",
+		map[string]interface{}{
+			"URL":       desiredURL(domain.Name, cmd.Hostname, cmd.Path.Path, cmd.Port),
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"User":      user.Name,
+		})
+
+	route, warnings, err := cmd.Actor.GetRouteByAttributes(domain, cmd.Hostname, cmd.Path.Path, cmd.Port)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	options := flag.RouteOptions(cmd.Options)
+	if options == nil {
+		options = make(map[string]*string)
+	}
+	for _, key := range cmd.RemoveOptions {
+		options[key] = nil
+	}
+
+	route, warnings, err = cmd.Actor.UpdateRoute(route.GUID, options)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Route {{.URL}} has been updated with options: {{.Options}}", map[string]interface{}{
+		"URL":     route.URL,
+		"Options": formatRouteOptions(route.Options),
+	})
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,144 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-route Command", func() {
+	var (
+		cmd             v7.UpdateRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.UpdateRouteCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.Domain{Domain: "example.com"},
+		}
+		setFlag(&cmd, "--hostname", "myapp")
+		setFlag(&cmd, "--option", []flag.RouteOption{{Key: "loadbalancing", Value: "least-connection"}})
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetDomainByNameReturns(resources.Domain{GUID: "domain-guid", Name: "example.com"}, v7action.Warnings{"domain-warning"}, nil)
+		fakeActor.GetRouteByAttributesReturns(resources.Route{GUID: "route-guid"}, v7action.Warnings{"route-warning"}, nil)
+
+		leastConnection := "least-connection"
+		fakeActor.UpdateRouteReturns(
+			resources.Route{GUID: "route-guid", URL: "myapp.example.com", Options: map[string]*string{"loadbalancing": &leastConnection}},
+			v7action.Warnings{"update-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the target", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("updates the options of the route", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.GetDomainByNameArgsForCall(0)).To(Equal("example.com"))
+		domain, hostname, path, port := fakeActor.GetRouteByAttributesArgsForCall(0)
+		Expect(domain.GUID).To(Equal("domain-guid"))
+		Expect(hostname).To(Equal("myapp"))
+		Expect(path).To(BeEmpty())
+		Expect(port).To(BeZero())
+
+		Expect(fakeActor.UpdateRouteCallCount()).To(Equal(1))
+		routeGUID, options := fakeActor.UpdateRouteArgsForCall(0)
+		Expect(routeGUID).To(Equal("route-guid"))
+		Expect(options).To(HaveLen(1))
+		Expect(*options["loadbalancing"]).To(Equal("least-connection"))
+
+		Expect(testUI.Out).To(Say(`Updating route myapp\.example\.com for org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`Route myapp\.example\.com has been updated with options: loadbalancing=least-connection`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("domain-warning"))
+		Expect(testUI.Err).To(Say("route-warning"))
+		Expect(testUI.Err).To(Say("update-warning"))
+	})
+
+	When("options are removed", func() {
+		BeforeEach(func() {
+			cmd.Options = nil
+			setFlag(&cmd, "--remove-option", []string{"loadbalancing"})
+		})
+
+		It("sends a nil value for the removed options", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			_, options := fakeActor.UpdateRouteArgsForCall(0)
+			Expect(options).To(HaveKeyWithValue("loadbalancing", BeNil()))
+		})
+	})
+
+	When("no options are given", func() {
+		BeforeEach(func() {
+			cmd.Options = nil
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "at least one of --option or --remove-option must be provided"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+		})
+	})
+
+	When("the route does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteByAttributesReturns(resources.Route{}, v7action.Warnings{"route-warning"}, actionerror.RouteNotFoundError{Host: "myapp", DomainName: "example.com"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{Host: "myapp", DomainName: "example.com"}))
+			Expect(fakeActor.UpdateRouteCallCount()).To(BeZero())
+		})
+	})
+
+	When("updating the route fails", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateRouteReturns(resources.Route{}, v7action.Warnings{"update-warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("update-warning"))
+		})
+	})
+})
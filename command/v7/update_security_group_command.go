@@ -32,8 +32,12 @@ func (cmd UpdateSecurityGroupCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	warnings, err := cmd.Actor.UpdateSecurityGroup(cmd.RequiredArgs.SecurityGroup, string(cmd.RequiredArgs.PathToJSONRules))
+	warnings, err := cmd.Actor.LintSecurityGroupRules(string(cmd.RequiredArgs.PathToJSONRules))
 	cmd.UI.DisplayWarnings(warnings)
+	if err == nil {
+		warnings, err = cmd.Actor.UpdateSecurityGroup(cmd.RequiredArgs.SecurityGroup, string(cmd.RequiredArgs.PathToJSONRules))
+		cmd.UI.DisplayWarnings(warnings)
+	}
 	if _, ok := err.(*json.SyntaxError); ok {
 		return actionerror.SecurityGroupJsonSyntaxError{Path: string(cmd.RequiredArgs.PathToJSONRules)}
 	}
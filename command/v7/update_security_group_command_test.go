@@ -89,6 +89,33 @@ var _ = Describe("update-security-group Command", func() {
 		})
 	})
 
+	When("linting the rules warns", func() {
+		BeforeEach(func() {
+			fakeActor.LintSecurityGroupRulesReturns(v7action.Warnings{"Rule 2 is shadowed by rule 1."}, nil)
+		})
+
+		It("displays the lint warnings and updates the security group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.LintSecurityGroupRulesArgsForCall(0)).To(Equal("some-path"))
+			Expect(testUI.Err).To(Say(`Rule 2 is shadowed by rule 1\.`))
+			Expect(fakeActor.UpdateSecurityGroupCallCount()).To(Equal(1))
+		})
+	})
+
+	When("the rules are invalid", func() {
+		var lintErr actionerror.InvalidSecurityGroupRulesError
+
+		BeforeEach(func() {
+			lintErr = actionerror.InvalidSecurityGroupRulesError{Path: "some-path", Problems: []string{"rule 1: ports are required for protocol tcp"}}
+			fakeActor.LintSecurityGroupRulesReturns(nil, lintErr)
+		})
+
+		It("returns the error without updating the security group", func() {
+			Expect(executeErr).To(MatchError(lintErr))
+			Expect(fakeActor.UpdateSecurityGroupCallCount()).To(Equal(0))
+		})
+	})
+
 	When("the provided JSON is invalid", func() {
 		BeforeEach(func() {
 			fakeActor.UpdateSecurityGroupReturns(
@@ -1,17 +1,20 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/servicebroker"
 )
 
 type UpdateServiceBrokerCommand struct {
 	BaseCommand
 
 	PositionalArgs  flag.ServiceBrokerArgs `positional-args:"yes"`
-	usage           any                    `usage:"CF_NAME update-service-broker SERVICE_BROKER USERNAME PASSWORD URL\n   CF_NAME update-service-broker SERVICE_BROKER USERNAME URL (omit password to specify interactively or via environment variable)\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history"`
-	relatedCommands any                    `related_commands:"rename-service-broker, service-brokers"`
+	DryRun          bool                   `long:"dry-run" description:"Show how the catalog of the broker would change, without updating the broker"`
+	usage           any                    `usage:"CF_NAME update-service-broker SERVICE_BROKER USERNAME PASSWORD URL [--dry-run]\n   CF_NAME update-service-broker SERVICE_BROKER USERNAME URL [--dry-run] (omit password to specify interactively or via environment variable)\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history"`
+	relatedCommands any                    `related_commands:"rename-service-broker, service-broker-catalog, service-brokers"`
 	envPassword     any                    `environmentName:"CF_BROKER_PASSWORD" environmentDescription:"Password associated with user. Overridden if PASSWORD argument is provided" environmentDefault:"password"`
 }
 
@@ -36,9 +39,92 @@ func (cmd UpdateServiceBrokerCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.DryRun {
+		return cmd.displayCatalogDiff(user.Name, brokerName, username, password, url)
+	}
+
 	return updateServiceBroker(cmd.UI, cmd.Actor, user.Name, serviceBroker.GUID, brokerName, username, password, url)
 }
 
+func (cmd UpdateServiceBrokerCommand) displayCatalogDiff(user, brokerName, username, password, url string) error {
+	cmd.UI.DisplayTextWithFlavor(
+		"Comparing the catalog of service broker {{.ServiceBroker}} at {{.URL}} with the current catalog as {{.Username}}...",
+		map[string]any{
+			"ServiceBroker": brokerName,
+			"URL":           url,
+			"Username":      user,
+		},
+	)
+	cmd.UI.DisplayNewline()
+
+	fetcher := servicebroker.NewClient(cmd.Config.DialTimeout(), cmd.Config.SkipSSLValidation())
+	diff, warnings, err := cmd.Actor.DiffServiceBrokerCatalog(brokerName, url, username, password, fetcher)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if !diff.HasChanges() {
+		cmd.UI.DisplayText("The catalog of service broker {{.ServiceBroker}} would not change.", map[string]any{
+			"ServiceBroker": brokerName,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayDiffUnchanged("offerings:", 0, false)
+	for _, offering := range diff.Offerings {
+		displayCatalogEntry(cmd.UI, offering.Change, offering.Name, offering.Fields, 1)
+		if len(offering.Plans) == 0 {
+			continue
+		}
+
+		displayCatalogLine(cmd.UI, offering.Change, "plans:", 1, false)
+		for _, plan := range offering.Plans {
+			displayCatalogEntry(cmd.UI, plan.Change, plan.Name, plan.Fields, 2)
+		}
+	}
+	cmd.UI.DisplayNewline()
+
+	for _, offering := range diff.Offerings {
+		for _, plan := range offering.Plans {
+			if plan.Change == v7action.CatalogEntryRemoved && plan.ServiceInstanceCount > 0 {
+				cmd.UI.DisplayWarning("Plan {{.ServicePlan}} of service offering {{.ServiceOffering}} would be removed, but {{.Count}} service instances still use it.", map[string]any{
+					"ServicePlan":     plan.Name,
+					"ServiceOffering": offering.Name,
+					"Count":           plan.ServiceInstanceCount,
+				})
+			}
+		}
+	}
+
+	cmd.UI.DisplayText("Dry run: service broker {{.ServiceBroker}} was not updated.", map[string]any{
+		"ServiceBroker": brokerName,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func displayCatalogEntry(ui command.UI, change v7action.CatalogChange, name string, fields []v7action.CatalogFieldChange, depth int) {
+	displayCatalogLine(ui, change, formatServiceField("name", name), depth, true)
+	for _, field := range fields {
+		ui.DisplayDiffRemoval(formatServiceField(field.Field, field.Current), depth, false)
+		ui.DisplayDiffAddition(formatServiceField(field.Field, field.Proposed), depth, false)
+	}
+}
+
+func displayCatalogLine(ui command.UI, change v7action.CatalogChange, line string, depth int, addHyphen bool) {
+	switch change {
+	case v7action.CatalogEntryAdded:
+		ui.DisplayDiffAddition(line, depth, addHyphen)
+	case v7action.CatalogEntryRemoved:
+		ui.DisplayDiffRemoval(line, depth, addHyphen)
+	default:
+		ui.DisplayDiffUnchanged(line, depth, addHyphen)
+	}
+}
+
 func updateServiceBroker(ui command.UI, actor Actor, user, brokerGUID, brokerName, username, password, url string) error {
 	ui.DisplayTextWithFlavor(
 		"Updating service broker {{.ServiceBroker}} as {{.Username}}...",
@@ -3,6 +3,7 @@ package v7_test
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -129,6 +130,104 @@ var _ = Describe("update-service-broker command", func() {
 			})
 		})
 
+		When("--dry-run is provided", func() {
+			BeforeEach(func() {
+				setFlag(cmd, "--dry-run")
+				fakeConfig.DialTimeoutReturns(time.Second)
+
+				fakeUpdateServiceBrokerActor.DiffServiceBrokerCatalogReturns(
+					v7action.ServiceBrokerCatalogDiff{
+						Offerings: []v7action.ServiceBrokerCatalogOfferingDiff{
+							{
+								Name:   "mysql",
+								Change: v7action.CatalogEntryUpdated,
+								Fields: []v7action.CatalogFieldChange{{Field: "description", Current: "old", Proposed: "new"}},
+								Plans: []v7action.ServiceBrokerCatalogPlanDiff{
+									{Name: "large", Change: v7action.CatalogEntryUnchanged},
+									{Name: "xl", Change: v7action.CatalogEntryAdded},
+									{Name: "small", Change: v7action.CatalogEntryRemoved, ServiceInstanceCount: 2},
+								},
+							},
+							{
+								Name:   "redis",
+								Change: v7action.CatalogEntryRemoved,
+								Plans:  []v7action.ServiceBrokerCatalogPlanDiff{{Name: "cache", Change: v7action.CatalogEntryRemoved}},
+							},
+						},
+					},
+					v7action.Warnings{"diff warning"},
+					nil,
+				)
+			})
+
+			It("compares the catalogs without updating the broker", func() {
+				Expect(cmd.Execute(nil)).To(Succeed())
+
+				Expect(fakeUpdateServiceBrokerActor.DiffServiceBrokerCatalogCallCount()).To(Equal(1))
+				brokerName, brokerURL, brokerUsername, brokerPassword, fetcher := fakeUpdateServiceBrokerActor.DiffServiceBrokerCatalogArgsForCall(0)
+				Expect(brokerName).To(Equal(serviceBrokerName))
+				Expect(brokerURL).To(Equal(url))
+				Expect(brokerUsername).To(Equal(username))
+				Expect(brokerPassword).To(Equal(password))
+				Expect(fetcher).NotTo(BeNil())
+
+				Expect(fakeUpdateServiceBrokerActor.UpdateServiceBrokerCallCount()).To(BeZero())
+			})
+
+			It("displays the differences and flags removed plans that are in use", func() {
+				Expect(cmd.Execute(nil)).To(Succeed())
+
+				Expect(testUI.Out).To(SatisfyAll(
+					Say(`Comparing the catalog of service broker fake-service-broker-name at fake-url with the current catalog as user\.\.\.\n\n`),
+					Say(`  offerings:\n`),
+					Say(`  - name: mysql\n`),
+					Say(`-   description: old\n`),
+					Say(`\+   description: new\n`),
+					Say(`    plans:\n`),
+					Say(`    - name: large\n`),
+					Say(`\+   - name: xl\n`),
+					Say(`-   - name: small\n`),
+					Say(`- - name: redis\n`),
+					Say(`-   plans:\n`),
+					Say(`-   - name: cache\n`),
+					Say(`Dry run: service broker fake-service-broker-name was not updated\.\n`),
+					Say(`OK`),
+				))
+				Expect(testUI.Err).To(SatisfyAll(
+					Say("diff warning"),
+					Say(`Plan small of service offering mysql would be removed, but 2 service instances still use it\.`),
+				))
+			})
+
+			When("the catalog would not change", func() {
+				BeforeEach(func() {
+					fakeUpdateServiceBrokerActor.DiffServiceBrokerCatalogReturns(
+						v7action.ServiceBrokerCatalogDiff{
+							Offerings: []v7action.ServiceBrokerCatalogOfferingDiff{{Name: "mysql", Change: v7action.CatalogEntryUnchanged}},
+						},
+						nil,
+						nil,
+					)
+				})
+
+				It("says so", func() {
+					Expect(cmd.Execute(nil)).To(Succeed())
+					Expect(testUI.Out).To(Say(`The catalog of service broker fake-service-broker-name would not change\.`))
+				})
+			})
+
+			When("comparing the catalogs fails", func() {
+				BeforeEach(func() {
+					fakeUpdateServiceBrokerActor.DiffServiceBrokerCatalogReturns(v7action.ServiceBrokerCatalogDiff{}, v7action.Warnings{"diff warning"}, errors.New("broker unreachable"))
+				})
+
+				It("returns the error and displays any warnings", func() {
+					Expect(cmd.Execute(nil)).To(MatchError("broker unreachable"))
+					Expect(testUI.Err).To(Say("diff warning"))
+				})
+			})
+		})
+
 		When("password is provided as environment variable", func() {
 			const (
 				varName     = "CF_BROKER_PASSWORD"
@@ -0,0 +1,72 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type UpdateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SidecarArgs `positional-args:"yes"`
+	Command         string           `long:"command" short:"c" description:"The command to start the sidecar with"`
+	ProcessTypes    []string         `long:"process-type" description:"Process type the sidecar runs alongside; can specify multiple times. Replaces the current process types"`
+	Memory          flag.Megabytes   `long:"memory" short:"m" description:"Memory reserved for the sidecar out of the process's memory limit (e.g. 256M, 1G)"`
+	usage           interface{}      `usage:"CF_NAME update-sidecar APP_NAME SIDECAR_NAME [-c COMMAND] [--process-type PROCESS_TYPE]... [-m MEMORY]\n\nEXAMPLES:\n   CF_NAME update-sidecar my-app config-server -m 256M"`
+	relatedCommands interface{}      `related_commands:"create-sidecar, restart, sidecars"`
+}
+
+func (cmd UpdateSidecarCommand) Execute(args []string) error {
+	if cmd.Command == "" && len(cmd.ProcessTypes) == 0 && !cmd.Memory.IsSet {
+		return translatableerror.IncorrectUsageError{Message: "at least one of --command, --process-type or --memory must be provided"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	sidecar := resources.Sidecar{
+		ProcessTypes: cmd.ProcessTypes,
+		MemoryInMB:   cmd.Memory.NullUint64,
+	}
+	if cmd.Command != "" {
+		sidecar.Command = types.FilteredString{IsSet: true, Value: cmd.Command}
+	}
+
+	_, warnings, err = cmd.Actor.UpdateApplicationSidecar(application.GUID, cmd.RequiredArgs.SidecarName, sidecar)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use 'cf restart {{.AppName}}' to ensure your sidecar changes take effect.", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-sidecar Command", func() {
+	var (
+		cmd             UpdateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = UpdateSidecarCommand{
+			RequiredArgs: flag.SidecarArgs{AppName: "some-app", SidecarName: "config-server"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, nil, nil)
+		fakeActor.UpdateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"update-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no update flag is given", func() {
+		It("returns an IncorrectUsageError without updating the sidecar", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "at least one of --command, --process-type or --memory must be provided",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.UpdateApplicationSidecarCallCount()).To(Equal(0))
+		})
+	})
+
+	When("only memory is given", func() {
+		BeforeEach(func() {
+			cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 256}}
+		})
+
+		It("updates only the memory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appGUID, sidecarName, sidecar := fakeActor.UpdateApplicationSidecarArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(sidecarName).To(Equal("config-server"))
+			Expect(sidecar).To(Equal(resources.Sidecar{
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 256},
+			}))
+
+			Expect(testUI.Out).To(Say(`Updating sidecar config-server for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("update-warning"))
+		})
+	})
+
+	When("the command and process types are given", func() {
+		BeforeEach(func() {
+			cmd.Command = "./new-server"
+			cmd.ProcessTypes = []string{"worker"}
+		})
+
+		It("updates them", func() {
+			_, _, sidecar := fakeActor.UpdateApplicationSidecarArgsForCall(0)
+			Expect(sidecar).To(Equal(resources.Sidecar{
+				Command:      types.FilteredString{IsSet: true, Value: "./new-server"},
+				ProcessTypes: []string{"worker"},
+			}))
+		})
+	})
+
+	When("the sidecar does not exist", func() {
+		BeforeEach(func() {
+			cmd.Command = "./new-server"
+			fakeActor.UpdateApplicationSidecarReturns(resources.Sidecar{}, nil, actionerror.SidecarNotFoundError{Name: "config-server"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "config-server"}))
+		})
+	})
+})
@@ -0,0 +1,283 @@
+package v7
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+const (
+	upgradeResultUpgraded = "upgraded"
+	upgradeResultFailed   = "failed"
+	upgradeResultSkipped  = "skipped"
+)
+
+type UpgradeServicesCommand struct {
+	BaseCommand
+
+	ServiceOffering string               `long:"offering" required:"true" description:"Service offering whose instances should be upgraded"`
+	ServiceBroker   string               `short:"b" long:"broker" description:"Only upgrade instances of the service offering from this service broker"`
+	ServicePlan     string               `short:"p" long:"plan" description:"Only upgrade instances of this service plan"`
+	Organization    string               `short:"o" long:"org" description:"Only upgrade instances in this org (Default: targeted org)"`
+	AllOrgs         bool                 `long:"all-orgs" description:"Upgrade instances in all orgs"`
+	Parallel        flag.PositiveInteger `long:"parallel" default:"5" description:"Maximum number of upgrades to run at the same time"`
+	DryRun          bool                 `long:"dry-run" description:"List the service instances that would be upgraded without upgrading them"`
+	Force           bool                 `short:"f" long:"force" description:"Force upgrade without asking for confirmation"`
+	usage           interface{}          `usage:"CF_NAME upgrade-services --offering SERVICE_OFFERING [-b SERVICE_BROKER] [-p SERVICE_PLAN] [-o ORG | --all-orgs] [--parallel N] [--dry-run] [-f]\n\nEXAMPLES:\n   CF_NAME upgrade-services --offering p-mysql --dry-run\n   CF_NAME upgrade-services --offering p-mysql -p db-small --all-orgs --parallel 10 -f"`
+	relatedCommands interface{}          `related_commands:"marketplace, services, upgrade-service"`
+}
+
+type serviceInstanceUpgrade struct {
+	instance v7action.ServiceInstanceForUpgrade
+	result   string
+	details  string
+	warnings v7action.Warnings
+}
+
+func (cmd UpgradeServicesCommand) Execute(args []string) error {
+	if cmd.Organization != "" && cmd.AllOrgs {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--org", "--all-orgs"}}
+	}
+
+	useTargetedOrg := cmd.Organization == "" && !cmd.AllOrgs
+	if err := cmd.SharedActor.CheckTarget(useTargetedOrg, false); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	params := v7action.ServiceInstancesForUpgradeParams{
+		ServiceOfferingName: cmd.ServiceOffering,
+		ServiceBrokerName:   cmd.ServiceBroker,
+		ServicePlanName:     cmd.ServicePlan,
+	}
+
+	switch {
+	case cmd.AllOrgs:
+		cmd.UI.DisplayTextWithFlavor("Getting instances of service offering {{.ServiceOffering}} in all orgs as {{.Username}}...", map[string]interface{}{
+			"ServiceOffering": cmd.ServiceOffering,
+			"Username":        user.Name,
+		})
+	case cmd.Organization != "":
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		params.OrganizationGUIDs = []string{org.GUID}
+		cmd.displayOrgFlavor(org.Name, user.Name)
+	default:
+		params.OrganizationGUIDs = []string{cmd.Config.TargetedOrganization().GUID}
+		cmd.displayOrgFlavor(cmd.Config.TargetedOrganization().Name, user.Name)
+	}
+	cmd.UI.DisplayNewline()
+
+	instances, warnings, err := cmd.Actor.GetServiceInstancesForUpgrade(params)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(instances) == 0 {
+		cmd.UI.DisplayText("No service instances found.")
+		return nil
+	}
+
+	upgrades, pending := planServiceInstanceUpgrades(instances)
+
+	if cmd.DryRun {
+		for _, i := range pending {
+			upgrades[i].result = "would upgrade"
+		}
+		cmd.displayUpgradeTable(upgrades)
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run: {{.Count}} of {{.Total}} service instances would be upgraded.", map[string]interface{}{
+			"Count": len(pending),
+			"Total": len(upgrades),
+		})
+		return nil
+	}
+
+	if len(pending) == 0 {
+		cmd.displayUpgradeTable(upgrades)
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("No service instances have an upgrade available.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if !cmd.Force {
+		upgrade, err := cmd.UI.DisplayBoolPrompt(
+			false,
+			"Do you really want to upgrade {{.Count}} service instances?",
+			map[string]interface{}{"Count": len(pending)},
+		)
+		if err != nil {
+			return err
+		}
+
+		if !upgrade {
+			cmd.UI.DisplayText("Upgrade cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayText("Upgrading {{.Count}} service instances, {{.Parallel}} at a time...", map[string]interface{}{
+		"Count":    len(pending),
+		"Parallel": cmd.parallel(),
+	})
+	cmd.runUpgrades(upgrades, pending)
+
+	for _, upgrade := range upgrades {
+		cmd.UI.DisplayWarnings(upgrade.warnings)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.displayUpgradeTable(upgrades)
+	cmd.UI.DisplayNewline()
+
+	counts := map[string]int{}
+	for _, upgrade := range upgrades {
+		counts[upgrade.result]++
+	}
+	cmd.UI.DisplayText("{{.Upgraded}} upgraded, {{.Failed}} failed, {{.Skipped}} skipped", map[string]interface{}{
+		"Upgraded": counts[upgradeResultUpgraded],
+		"Failed":   counts[upgradeResultFailed],
+		"Skipped":  counts[upgradeResultSkipped],
+	})
+
+	if counts[upgradeResultFailed] > 0 {
+		return translatableerror.ServiceInstanceUpgradesFailedError{
+			Failed: counts[upgradeResultFailed],
+			Total:  len(pending),
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd UpgradeServicesCommand) displayOrgFlavor(orgName, username string) {
+	cmd.UI.DisplayTextWithFlavor("Getting instances of service offering {{.ServiceOffering}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceOffering": cmd.ServiceOffering,
+		"OrgName":         orgName,
+		"Username":        username,
+	})
+}
+
+func (cmd UpgradeServicesCommand) parallel() int {
+	if cmd.Parallel.Value < 1 {
+		return 1
+	}
+	return int(cmd.Parallel.Value)
+}
+
+// runUpgrades upgrades the pending instances, running at most cmd.Parallel
+// upgrades at a time, and waits for every upgrade operation to finish.
+func (cmd UpgradeServicesCommand) runUpgrades(upgrades []serviceInstanceUpgrade, pending []int) {
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, cmd.parallel())
+
+	for _, i := range pending {
+		wg.Add(1)
+		slots <- struct{}{}
+
+		go func(upgrade *serviceInstanceUpgrade) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			cmd.upgrade(upgrade)
+			cmd.UI.DisplayText("   {{.OrgName}}/{{.SpaceName}}/{{.ServiceInstanceName}}: {{.Result}}", map[string]interface{}{
+				"OrgName":             upgrade.instance.OrganizationName,
+				"SpaceName":           upgrade.instance.SpaceName,
+				"ServiceInstanceName": upgrade.instance.Name,
+				"Result":              upgrade.result,
+			})
+		}(&upgrades[i])
+	}
+
+	wg.Wait()
+}
+
+func (cmd UpgradeServicesCommand) upgrade(upgrade *serviceInstanceUpgrade) {
+	stream, warnings, err := cmd.Actor.UpgradeManagedServiceInstance(upgrade.instance.Name, upgrade.instance.SpaceGUID)
+	upgrade.warnings = append(upgrade.warnings, warnings...)
+
+	switch err.(type) {
+	case nil:
+	case actionerror.ServiceInstanceUpgradeNotAvailableError:
+		upgrade.result = upgradeResultSkipped
+		upgrade.details = "up to date"
+		return
+	default:
+		upgrade.result = upgradeResultFailed
+		upgrade.details = err.Error()
+		return
+	}
+
+	upgrade.result = upgradeResultUpgraded
+	if stream == nil {
+		return
+	}
+
+	for event := range stream {
+		upgrade.warnings = append(upgrade.warnings, event.Warnings...)
+		if event.Err != nil {
+			upgrade.result = upgradeResultFailed
+			upgrade.details = event.Err.Error()
+		}
+	}
+}
+
+func (cmd UpgradeServicesCommand) displayUpgradeTable(upgrades []serviceInstanceUpgrade) {
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("plan"),
+		cmd.UI.TranslateText("result"),
+		cmd.UI.TranslateText("details"),
+	}}
+	for _, upgrade := range upgrades {
+		table = append(table, []string{
+			upgrade.instance.Name,
+			upgrade.instance.OrganizationName,
+			upgrade.instance.SpaceName,
+			upgrade.instance.ServicePlanName,
+			upgrade.result,
+			upgrade.details,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
+
+// planServiceInstanceUpgrades marks the instances that cannot be upgraded as
+// skipped and returns the indexes of the ones that can.
+func planServiceInstanceUpgrades(instances []v7action.ServiceInstanceForUpgrade) ([]serviceInstanceUpgrade, []int) {
+	upgrades := make([]serviceInstanceUpgrade, len(instances))
+	var pending []int
+
+	for i, instance := range instances {
+		upgrades[i].instance = instance
+		switch {
+		case instance.OperationInProgress:
+			upgrades[i].result = upgradeResultSkipped
+			upgrades[i].details = "operation in progress"
+		case !instance.UpgradeAvailable:
+			upgrades[i].result = upgradeResultSkipped
+			upgrades[i].details = "up to date"
+		default:
+			pending = append(pending, i)
+		}
+	}
+
+	return upgrades, pending
+}
@@ -0,0 +1,305 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("upgrade-services Command", func() {
+	var (
+		cmd             v7.UpgradeServicesCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	completedStream := func(events ...v7action.PollJobEvent) chan v7action.PollJobEvent {
+		stream := make(chan v7action.PollJobEvent, len(events))
+		for _, event := range events {
+			stream <- event
+		}
+		close(stream)
+		return stream
+	}
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.UpgradeServicesCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		setFlag(&cmd, "--offering", "fake-offering")
+		setFlag(&cmd, "--parallel", "2")
+		setFlag(&cmd, "-f")
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "targeted-org-guid", Name: "targeted-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetServiceInstancesForUpgradeReturns(
+			[]v7action.ServiceInstanceForUpgrade{
+				{Name: "db-1", SpaceGUID: "space-guid-1", SpaceName: "dev", OrganizationName: "targeted-org", ServicePlanName: "small", UpgradeAvailable: true},
+				{Name: "db-2", SpaceGUID: "space-guid-1", SpaceName: "dev", OrganizationName: "targeted-org", ServicePlanName: "small"},
+				{Name: "db-3", SpaceGUID: "space-guid-2", SpaceName: "prod", OrganizationName: "targeted-org", ServicePlanName: "large", UpgradeAvailable: true},
+				{Name: "db-4", SpaceGUID: "space-guid-2", SpaceName: "prod", OrganizationName: "targeted-org", ServicePlanName: "large", UpgradeAvailable: true, OperationInProgress: true},
+			},
+			v7action.Warnings{"list warning"},
+			nil,
+		)
+
+		fakeActor.UpgradeManagedServiceInstanceStub = func(name, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+			return completedStream(
+				v7action.PollJobEvent{State: v7action.JobPolling, Warnings: v7action.Warnings{name + " poll warning"}},
+				v7action.PollJobEvent{State: v7action.JobComplete},
+			), v7action.Warnings{name + " warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in and targeting an org", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		org, space := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(org).To(BeTrue())
+		Expect(space).To(BeFalse())
+	})
+
+	It("looks for instances of the offering in the targeted org", func() {
+		Expect(fakeActor.GetServiceInstancesForUpgradeCallCount()).To(Equal(1))
+		Expect(fakeActor.GetServiceInstancesForUpgradeArgsForCall(0)).To(Equal(v7action.ServiceInstancesForUpgradeParams{
+			ServiceOfferingName: "fake-offering",
+			OrganizationGUIDs:   []string{"targeted-org-guid"},
+		}))
+	})
+
+	It("upgrades the instances that have an upgrade available", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(2))
+		var upgraded []string
+		for i := 0; i < 2; i++ {
+			name, spaceGUID := fakeActor.UpgradeManagedServiceInstanceArgsForCall(i)
+			upgraded = append(upgraded, name+"/"+spaceGUID)
+		}
+		Expect(upgraded).To(ConsistOf("db-1/space-guid-1", "db-3/space-guid-2"))
+	})
+
+	It("displays progress, a summary table and warnings", func() {
+		Expect(testUI.Out).To(SatisfyAll(
+			Say(`Getting instances of service offering fake-offering in org targeted-org as steve\.\.\.`),
+			Say(`Upgrading 2 service instances, 2 at a time\.\.\.`),
+		))
+		Expect(testUI.Out).To(Say(`targeted-org/dev/db-1: upgraded`))
+		Expect(testUI.Out).To(SatisfyAll(
+			Say(`name\s+org\s+space\s+plan\s+result\s+details`),
+			Say(`db-1\s+targeted-org\s+dev\s+small\s+upgraded`),
+			Say(`db-2\s+targeted-org\s+dev\s+small\s+skipped\s+up to date`),
+			Say(`db-3\s+targeted-org\s+prod\s+large\s+upgraded`),
+			Say(`db-4\s+targeted-org\s+prod\s+large\s+skipped\s+operation in progress`),
+			Say(`2 upgraded, 0 failed, 2 skipped`),
+			Say(`OK`),
+		))
+		Expect(testUI.Err).To(SatisfyAll(
+			Say("list warning"),
+			Say("db-1 warning"),
+			Say("db-1 poll warning"),
+			Say("db-3 warning"),
+			Say("db-3 poll warning"),
+		))
+	})
+
+	When("some upgrades fail", func() {
+		BeforeEach(func() {
+			fakeActor.UpgradeManagedServiceInstanceStub = func(name, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+				switch name {
+				case "db-1":
+					return nil, nil, errors.New("broker said no")
+				default:
+					return completedStream(v7action.PollJobEvent{State: v7action.JobFailed, Err: errors.New("job failed")}), nil, nil
+				}
+			}
+		})
+
+		It("reports them and returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceUpgradesFailedError{Failed: 2, Total: 2}))
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`db-1\s+targeted-org\s+dev\s+small\s+failed\s+broker said no`),
+				Say(`db-3\s+targeted-org\s+prod\s+large\s+failed\s+job failed`),
+				Say(`0 upgraded, 2 failed, 2 skipped`),
+			))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+
+	When("an instance was upgraded in the meantime", func() {
+		BeforeEach(func() {
+			fakeActor.UpgradeManagedServiceInstanceReturns(nil, nil, actionerror.ServiceInstanceUpgradeNotAvailableError{})
+			fakeActor.UpgradeManagedServiceInstanceStub = nil
+		})
+
+		It("skips it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`0 upgraded, 0 failed, 4 skipped`))
+		})
+	})
+
+	When("--dry-run is provided", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--dry-run")
+		})
+
+		It("lists what would be upgraded without upgrading", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(BeZero())
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`db-1\s+targeted-org\s+dev\s+small\s+would upgrade`),
+				Say(`db-2\s+targeted-org\s+dev\s+small\s+skipped\s+up to date`),
+				Say(`Dry run: 2 of 4 service instances would be upgraded\.`),
+			))
+		})
+	})
+
+	When("no instance has an upgrade available", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesForUpgradeReturns(
+				[]v7action.ServiceInstanceForUpgrade{{Name: "db-2", SpaceName: "dev", OrganizationName: "targeted-org"}},
+				nil,
+				nil,
+			)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(BeZero())
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`No service instances have an upgrade available\.`),
+				Say(`OK`),
+			))
+		})
+	})
+
+	When("no instances are found", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesForUpgradeReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No service instances found\.`))
+		})
+	})
+
+	When("--org is provided", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--org", "other-org")
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{GUID: "other-org-guid", Name: "other-org"}, v7action.Warnings{"org warning"}, nil)
+		})
+
+		It("looks in that org without requiring a targeted org", func() {
+			org, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(org).To(BeFalse())
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+			Expect(fakeActor.GetServiceInstancesForUpgradeArgsForCall(0).OrganizationGUIDs).To(Equal([]string{"other-org-guid"}))
+			Expect(testUI.Out).To(Say(`in org other-org as steve`))
+			Expect(testUI.Err).To(Say("org warning"))
+		})
+
+		When("--all-orgs is also provided", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--all-orgs")
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--org", "--all-orgs"}}))
+			})
+		})
+	})
+
+	When("--all-orgs is provided", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--all-orgs")
+			setFlag(&cmd, "-b", "fake-broker")
+			setFlag(&cmd, "-p", "small")
+		})
+
+		It("looks in every org", func() {
+			Expect(fakeActor.GetServiceInstancesForUpgradeArgsForCall(0)).To(Equal(v7action.ServiceInstancesForUpgradeParams{
+				ServiceOfferingName: "fake-offering",
+				ServiceBrokerName:   "fake-broker",
+				ServicePlanName:     "small",
+			}))
+			Expect(testUI.Out).To(Say(`Getting instances of service offering fake-offering in all orgs as steve\.\.\.`))
+		})
+	})
+
+	When("not forced", func() {
+		BeforeEach(func() {
+			cmd.Force = false
+		})
+
+		When("the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not upgrade anything", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(SatisfyAll(
+					Say(`Do you really want to upgrade 2 service instances\?`),
+					Say(`Upgrade cancelled`),
+				))
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(BeZero())
+			})
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("upgrades the instances", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(2))
+			})
+		})
+	})
+
+	When("getting the instances fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesForUpgradeReturns(nil, v7action.Warnings{"list warning"}, actionerror.ServiceNotFoundError{Name: "fake-offering"})
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "fake-offering"}))
+			Expect(testUI.Err).To(Say("list warning"))
+		})
+	})
+})
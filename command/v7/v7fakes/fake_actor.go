@@ -22,6 +22,20 @@ import (
 )
 
 type FakeActor struct {
+	AddRouteDestinationsStub        func(string, []resources.RouteDestination) (v7action.Warnings, error)
+	addRouteDestinationsMutex       sync.RWMutex
+	addRouteDestinationsArgsForCall []struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}
+	addRouteDestinationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	addRouteDestinationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	ApplyOrganizationQuotaByNameStub        func(string, string) (v7action.Warnings, error)
 	applyOrganizationQuotaByNameMutex       sync.RWMutex
 	applyOrganizationQuotaByNameArgsForCall []struct {
@@ -36,6 +50,19 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	ApplySecurityGroupChangesStub        func([]v7action.SecurityGroupChange) (v7action.Warnings, error)
+	applySecurityGroupChangesMutex       sync.RWMutex
+	applySecurityGroupChangesArgsForCall []struct {
+		arg1 []v7action.SecurityGroupChange
+	}
+	applySecurityGroupChangesReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	applySecurityGroupChangesReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	ApplySpaceQuotaByNameStub        func(string, string, string) (v7action.Warnings, error)
 	applySpaceQuotaByNameMutex       sync.RWMutex
 	applySpaceQuotaByNameArgsForCall []struct {
@@ -192,6 +219,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarStub        func(string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	createApplicationSidecarMutex       sync.RWMutex
+	createApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}
+	createApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	createApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreateBitsPackageByApplicationStub        func(string) (resources.Package, v7action.Warnings, error)
 	createBitsPackageByApplicationMutex       sync.RWMutex
 	createBitsPackageByApplicationArgsForCall []struct {
@@ -375,7 +418,7 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
-	CreateRouteStub        func(string, string, string, string, int) (resources.Route, v7action.Warnings, error)
+	CreateRouteStub        func(string, string, string, string, int, map[string]*string) (resources.Route, v7action.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
 		arg1 string
@@ -383,6 +426,7 @@ type FakeActor struct {
 		arg3 string
 		arg4 string
 		arg5 int
+		arg6 map[string]*string
 	}
 	createRouteReturns struct {
 		result1 resources.Route
@@ -409,6 +453,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CreateRouteInPortRangeStub        func(string, string, v7action.PortRange, map[string]*string) (resources.Route, v7action.Warnings, error)
+	createRouteInPortRangeMutex       sync.RWMutex
+	createRouteInPortRangeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.PortRange
+		arg4 map[string]*string
+	}
+	createRouteInPortRangeReturns struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	createRouteInPortRangeReturnsOnCall map[int]struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreateSecurityGroupStub        func(string, string) (v7action.Warnings, error)
 	createSecurityGroupMutex       sync.RWMutex
 	createSecurityGroupArgsForCall []struct {
@@ -575,6 +637,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteApplicationSidecarStub        func(string, string) (v7action.Warnings, error)
+	deleteApplicationSidecarMutex       sync.RWMutex
+	deleteApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteApplicationSidecarReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteApplicationSidecarReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteBuildpackByNameAndStackStub        func(string, string) (v7action.Warnings, error)
 	deleteBuildpackByNameAndStackMutex       sync.RWMutex
 	deleteBuildpackByNameAndStackArgsForCall []struct {
@@ -760,6 +836,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	DeleteServiceAppBindingByGUIDStub        func(string) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	deleteServiceAppBindingByGUIDMutex       sync.RWMutex
+	deleteServiceAppBindingByGUIDArgsForCall []struct {
+		arg1 string
+	}
+	deleteServiceAppBindingByGUIDReturns struct {
+		result1 chan v7action.PollJobEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	deleteServiceAppBindingByGUIDReturnsOnCall map[int]struct {
+		result1 chan v7action.PollJobEvent
+		result2 v7action.Warnings
+		result3 error
+	}
 	DeleteServiceBrokerStub        func(string) (v7action.Warnings, error)
 	deleteServiceBrokerMutex       sync.RWMutex
 	deleteServiceBrokerArgsForCall []struct {
@@ -851,6 +942,19 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteStaleRouteStub        func(v7action.StaleRoute) (v7action.Warnings, error)
+	deleteStaleRouteMutex       sync.RWMutex
+	deleteStaleRouteArgsForCall []struct {
+		arg1 v7action.StaleRoute
+	}
+	deleteStaleRouteReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteStaleRouteReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteUserStub        func(string) (v7action.Warnings, error)
 	deleteUserMutex       sync.RWMutex
 	deleteUserArgsForCall []struct {
@@ -864,6 +968,25 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DiffServiceBrokerCatalogStub        func(string, string, string, string, v7action.ServiceBrokerCatalogFetcher) (v7action.ServiceBrokerCatalogDiff, v7action.Warnings, error)
+	diffServiceBrokerCatalogMutex       sync.RWMutex
+	diffServiceBrokerCatalogArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 v7action.ServiceBrokerCatalogFetcher
+	}
+	diffServiceBrokerCatalogReturns struct {
+		result1 v7action.ServiceBrokerCatalogDiff
+		result2 v7action.Warnings
+		result3 error
+	}
+	diffServiceBrokerCatalogReturnsOnCall map[int]struct {
+		result1 v7action.ServiceBrokerCatalogDiff
+		result2 v7action.Warnings
+		result3 error
+	}
 	DiffSpaceManifestStub        func(string, []byte) (resources.ManifestDiff, v7action.Warnings, error)
 	diffSpaceManifestMutex       sync.RWMutex
 	diffSpaceManifestArgsForCall []struct {
@@ -1039,6 +1162,25 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationCrashesByNameAndSpaceStub        func(string, string, time.Time, int, sharedaction.LogCacheClient) ([]v7action.ApplicationCrash, v7action.Warnings, error)
+	getApplicationCrashesByNameAndSpaceMutex       sync.RWMutex
+	getApplicationCrashesByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+		arg4 int
+		arg5 sharedaction.LogCacheClient
+	}
+	getApplicationCrashesByNameAndSpaceReturns struct {
+		result1 []v7action.ApplicationCrash
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationCrashesByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []v7action.ApplicationCrash
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationDropletsStub        func(string, string) ([]resources.Droplet, v7action.Warnings, error)
 	getApplicationDropletsMutex       sync.RWMutex
 	getApplicationDropletsArgsForCall []struct {
@@ -1148,6 +1290,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsStub        func(string) ([]resources.Sidecar, v7action.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -1180,6 +1337,36 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAuditEventsStub        func(v7action.EventFilter) ([]v7action.Event, v7action.Warnings, error)
+	getAuditEventsMutex       sync.RWMutex
+	getAuditEventsArgsForCall []struct {
+		arg1 v7action.EventFilter
+	}
+	getAuditEventsReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAuditEventsReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetBindingsStub        func(v7action.GetBindingsParams) ([]v7action.Binding, v7action.Warnings, error)
+	getBindingsMutex       sync.RWMutex
+	getBindingsArgsForCall []struct {
+		arg1 v7action.GetBindingsParams
+	}
+	getBindingsReturns struct {
+		result1 []v7action.Binding
+		result2 v7action.Warnings
+		result3 error
+	}
+	getBindingsReturnsOnCall map[int]struct {
+		result1 []v7action.Binding
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetBuildpackLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getBuildpackLabelsMutex       sync.RWMutex
 	getBuildpackLabelsArgsForCall []struct {
@@ -1316,6 +1503,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetEffectiveSecurityRulesStub        func(string, string, constanta.SecurityGroupLifecycle) ([]v7action.EffectiveSecurityRule, v7action.Warnings, error)
+	getEffectiveSecurityRulesMutex       sync.RWMutex
+	getEffectiveSecurityRulesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 constanta.SecurityGroupLifecycle
+	}
+	getEffectiveSecurityRulesReturns struct {
+		result1 []v7action.EffectiveSecurityRule
+		result2 v7action.Warnings
+		result3 error
+	}
+	getEffectiveSecurityRulesReturnsOnCall map[int]struct {
+		result1 []v7action.EffectiveSecurityRule
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetEnvironmentVariableGroupStub        func(constanta.EnvironmentVariableGroupName) (v7action.EnvironmentVariableGroup, v7action.Warnings, error)
 	getEnvironmentVariableGroupMutex       sync.RWMutex
 	getEnvironmentVariableGroupArgsForCall []struct {
@@ -1404,6 +1608,38 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetInternalRouteStub        func(string, string) (v7action.InternalRoute, v7action.Warnings, error)
+	getInternalRouteMutex       sync.RWMutex
+	getInternalRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getInternalRouteReturns struct {
+		result1 v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}
+	getInternalRouteReturnsOnCall map[int]struct {
+		result1 v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetInternalRoutesStub        func(string, string) ([]v7action.InternalRoute, v7action.Warnings, error)
+	getInternalRoutesMutex       sync.RWMutex
+	getInternalRoutesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getInternalRoutesReturns struct {
+		result1 []v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}
+	getInternalRoutesReturnsOnCall map[int]struct {
+		result1 []v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetIsolationSegmentByNameStub        func(string) (resources.IsolationSegment, v7action.Warnings, error)
 	getIsolationSegmentByNameMutex       sync.RWMutex
 	getIsolationSegmentByNameArgsForCall []struct {
@@ -1752,6 +1988,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRouteStub        func(string, string) (resources.Route, v7action.Warnings, error)
+	getRouteMutex       sync.RWMutex
+	getRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getRouteReturns struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteReturnsOnCall map[int]struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteByAttributesStub        func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)
 	getRouteByAttributesMutex       sync.RWMutex
 	getRouteByAttributesArgsForCall []struct {
@@ -1815,6 +2067,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRouterGroupDetailsStub        func(string) (v7action.RouterGroupDetails, v7action.Warnings, error)
+	getRouterGroupDetailsMutex       sync.RWMutex
+	getRouterGroupDetailsArgsForCall []struct {
+		arg1 string
+	}
+	getRouterGroupDetailsReturns struct {
+		result1 v7action.RouterGroupDetails
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouterGroupDetailsReturnsOnCall map[int]struct {
+		result1 v7action.RouterGroupDetails
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouterGroupsStub        func() ([]v7action.RouterGroup, error)
 	getRouterGroupsMutex       sync.RWMutex
 	getRouterGroupsArgsForCall []struct {
@@ -1859,6 +2126,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRunningApplicationTasksStub        func(string, string) ([]resources.Task, v7action.Warnings, error)
+	getRunningApplicationTasksMutex       sync.RWMutex
+	getRunningApplicationTasksArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getRunningApplicationTasksReturns struct {
+		result1 []resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRunningApplicationTasksReturnsOnCall map[int]struct {
+		result1 []resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSSHEnabledStub        func(string) (ccv3.SSHEnabled, v7action.Warnings, error)
 	getSSHEnabledMutex       sync.RWMutex
 	getSSHEnabledArgsForCall []struct {
@@ -1981,6 +2264,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceAppBindingStub        func(v7action.GetServiceAppBindingParams) (resources.ServiceCredentialBinding, v7action.Warnings, error)
+	getServiceAppBindingMutex       sync.RWMutex
+	getServiceAppBindingArgsForCall []struct {
+		arg1 v7action.GetServiceAppBindingParams
+	}
+	getServiceAppBindingReturns struct {
+		result1 resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceAppBindingReturnsOnCall map[int]struct {
+		result1 resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceBrokerByNameStub        func(string) (resources.ServiceBroker, v7action.Warnings, error)
 	getServiceBrokerByNameMutex       sync.RWMutex
 	getServiceBrokerByNameArgsForCall []struct {
@@ -1996,6 +2294,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceBrokerCatalogStub        func(string) (v7action.ServiceBrokerCatalog, v7action.Warnings, error)
+	getServiceBrokerCatalogMutex       sync.RWMutex
+	getServiceBrokerCatalogArgsForCall []struct {
+		arg1 string
+	}
+	getServiceBrokerCatalogReturns struct {
+		result1 v7action.ServiceBrokerCatalog
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceBrokerCatalogReturnsOnCall map[int]struct {
+		result1 v7action.ServiceBrokerCatalog
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceBrokerLabelsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
 	getServiceBrokerLabelsMutex       sync.RWMutex
 	getServiceBrokerLabelsArgsForCall []struct {
@@ -2090,6 +2403,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceInstanceSharedSpacesUsageStub        func(string, string) (v7action.ServiceInstanceSharedSpaces, v7action.Warnings, error)
+	getServiceInstanceSharedSpacesUsageMutex       sync.RWMutex
+	getServiceInstanceSharedSpacesUsageArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceInstanceSharedSpacesUsageReturns struct {
+		result1 v7action.ServiceInstanceSharedSpaces
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceSharedSpacesUsageReturnsOnCall map[int]struct {
+		result1 v7action.ServiceInstanceSharedSpaces
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceInstanceSharesForOrganizationStub        func(string) ([]v7action.ServiceInstanceShare, v7action.Warnings, error)
+	getServiceInstanceSharesForOrganizationMutex       sync.RWMutex
+	getServiceInstanceSharesForOrganizationArgsForCall []struct {
+		arg1 string
+	}
+	getServiceInstanceSharesForOrganizationReturns struct {
+		result1 []v7action.ServiceInstanceShare
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceSharesForOrganizationReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceInstanceShare
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceInstancesForSpaceStub        func(string, bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
 	getServiceInstancesForSpaceMutex       sync.RWMutex
 	getServiceInstancesForSpaceArgsForCall []struct {
@@ -2106,6 +2450,36 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceInstancesForUpgradeStub        func(v7action.ServiceInstancesForUpgradeParams) ([]v7action.ServiceInstanceForUpgrade, v7action.Warnings, error)
+	getServiceInstancesForUpgradeMutex       sync.RWMutex
+	getServiceInstancesForUpgradeArgsForCall []struct {
+		arg1 v7action.ServiceInstancesForUpgradeParams
+	}
+	getServiceInstancesForUpgradeReturns struct {
+		result1 []v7action.ServiceInstanceForUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstancesForUpgradeReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceInstanceForUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceInstancesWithPendingUpgradesStub        func(v7action.PendingUpgradesParams) ([]v7action.ServiceInstanceWithPendingUpgrade, v7action.Warnings, error)
+	getServiceInstancesWithPendingUpgradesMutex       sync.RWMutex
+	getServiceInstancesWithPendingUpgradesArgsForCall []struct {
+		arg1 v7action.PendingUpgradesParams
+	}
+	getServiceInstancesWithPendingUpgradesReturns struct {
+		result1 []v7action.ServiceInstanceWithPendingUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstancesWithPendingUpgradesReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceInstanceWithPendingUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceKeyByServiceInstanceAndNameStub        func(string, string, string) (resources.ServiceCredentialBinding, v7action.Warnings, error)
 	getServiceKeyByServiceInstanceAndNameMutex       sync.RWMutex
 	getServiceKeyByServiceInstanceAndNameArgsForCall []struct {
@@ -2140,6 +2514,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceKeyRotationStub        func(string, string, string) (v7action.ServiceKeyRotation, v7action.Warnings, error)
+	getServiceKeyRotationMutex       sync.RWMutex
+	getServiceKeyRotationArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getServiceKeyRotationReturns struct {
+		result1 v7action.ServiceKeyRotation
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceKeyRotationReturnsOnCall map[int]struct {
+		result1 v7action.ServiceKeyRotation
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceKeysByServiceInstanceStub        func(string, string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)
 	getServiceKeysByServiceInstanceMutex       sync.RWMutex
 	getServiceKeysByServiceInstanceArgsForCall []struct {
@@ -2362,6 +2753,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetStaleRoutesStub        func([]resources.Route, time.Time) ([]v7action.StaleRoute, v7action.Warnings, error)
+	getStaleRoutesMutex       sync.RWMutex
+	getStaleRoutesArgsForCall []struct {
+		arg1 []resources.Route
+		arg2 time.Time
+	}
+	getStaleRoutesReturns struct {
+		result1 []v7action.StaleRoute
+		result2 v7action.Warnings
+		result3 error
+	}
+	getStaleRoutesReturnsOnCall map[int]struct {
+		result1 []v7action.StaleRoute
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -2383,6 +2790,23 @@ type FakeActor struct {
 		result4 v7action.Warnings
 		result5 error
 	}
+	GetStreamingLogsForTaskStub        func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc)
+	getStreamingLogsForTaskMutex       sync.RWMutex
+	getStreamingLogsForTaskArgsForCall []struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}
+	getStreamingLogsForTaskReturns struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
+	getStreamingLogsForTaskReturnsOnCall map[int]struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
 	GetTaskBySequenceIDAndApplicationStub        func(int, string) (resources.Task, v7action.Warnings, error)
 	getTaskBySequenceIDAndApplicationMutex       sync.RWMutex
 	getTaskBySequenceIDAndApplicationArgsForCall []struct {
@@ -2440,6 +2864,19 @@ type FakeActor struct {
 		result1 resources.User
 		result2 error
 	}
+	LintSecurityGroupRulesStub        func(string) (v7action.Warnings, error)
+	lintSecurityGroupRulesMutex       sync.RWMutex
+	lintSecurityGroupRulesArgsForCall []struct {
+		arg1 string
+	}
+	lintSecurityGroupRulesReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	lintSecurityGroupRulesReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	MakeCurlRequestStub        func(string, string, []string, string, bool) ([]byte, *http.Response, error)
 	makeCurlRequestMutex       sync.RWMutex
 	makeCurlRequestArgsForCall []struct {
@@ -2516,13 +2953,29 @@ type FakeActor struct {
 		result1 jwt.JWT
 		result2 error
 	}
-	PollBuildStub        func(string, string) (resources.Droplet, v7action.Warnings, error)
-	pollBuildMutex       sync.RWMutex
-	pollBuildArgsForCall []struct {
-		arg1 string
-		arg2 string
+	PlanSecurityGroupsStub        func([]v7action.SecurityGroupDefinition, bool) ([]v7action.SecurityGroupChange, v7action.Warnings, error)
+	planSecurityGroupsMutex       sync.RWMutex
+	planSecurityGroupsArgsForCall []struct {
+		arg1 []v7action.SecurityGroupDefinition
+		arg2 bool
 	}
-	pollBuildReturns struct {
+	planSecurityGroupsReturns struct {
+		result1 []v7action.SecurityGroupChange
+		result2 v7action.Warnings
+		result3 error
+	}
+	planSecurityGroupsReturnsOnCall map[int]struct {
+		result1 []v7action.SecurityGroupChange
+		result2 v7action.Warnings
+		result3 error
+	}
+	PollBuildStub        func(string, string) (resources.Droplet, v7action.Warnings, error)
+	pollBuildMutex       sync.RWMutex
+	pollBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	pollBuildReturns struct {
 		result1 resources.Droplet
 		result2 v7action.Warnings
 		result3 error
@@ -2726,6 +3179,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	ReplaceRouteDestinationsStub        func(string, []resources.RouteDestination) ([]resources.RouteDestination, v7action.Warnings, error)
+	replaceRouteDestinationsMutex       sync.RWMutex
+	replaceRouteDestinationsArgsForCall []struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}
+	replaceRouteDestinationsReturns struct {
+		result1 []resources.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}
+	replaceRouteDestinationsReturnsOnCall map[int]struct {
+		result1 []resources.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}
 	ResetOrganizationDefaultIsolationSegmentStub        func(string) (v7action.Warnings, error)
 	resetOrganizationDefaultIsolationSegmentMutex       sync.RWMutex
 	resetOrganizationDefaultIsolationSegmentArgsForCall []struct {
@@ -2945,6 +3414,22 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	SetRouteWeightsStub        func(resources.Route, []v7action.RouteWeight) ([]resources.RouteDestination, v7action.Warnings, error)
+	setRouteWeightsMutex       sync.RWMutex
+	setRouteWeightsArgsForCall []struct {
+		arg1 resources.Route
+		arg2 []v7action.RouteWeight
+	}
+	setRouteWeightsReturns struct {
+		result1 []resources.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}
+	setRouteWeightsReturnsOnCall map[int]struct {
+		result1 []resources.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}
 	SetSpaceManifestStub        func(string, []byte) (v7action.Warnings, error)
 	setSpaceManifestMutex       sync.RWMutex
 	setSpaceManifestArgsForCall []struct {
@@ -3193,6 +3678,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UnshareServiceInstanceFromSpacesStub        func(string, []string) (v7action.Warnings, error)
+	unshareServiceInstanceFromSpacesMutex       sync.RWMutex
+	unshareServiceInstanceFromSpacesArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	unshareServiceInstanceFromSpacesReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	unshareServiceInstanceFromSpacesReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateAppFeatureStub        func(resources.Application, bool, string) (v7action.Warnings, error)
 	updateAppFeatureMutex       sync.RWMutex
 	updateAppFeatureArgsForCall []struct {
@@ -3238,6 +3737,23 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateApplicationSidecarStub        func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	updateApplicationSidecarMutex       sync.RWMutex
+	updateApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}
+	updateApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	UpdateBuildpackByNameAndStackStub        func(string, string, resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	updateBuildpackByNameAndStackMutex       sync.RWMutex
 	updateBuildpackByNameAndStackArgsForCall []struct {
@@ -3358,6 +3874,22 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateRouteStub        func(string, map[string]*string) (resources.Route, v7action.Warnings, error)
+	updateRouteMutex       sync.RWMutex
+	updateRouteArgsForCall []struct {
+		arg1 string
+		arg2 map[string]*string
+	}
+	updateRouteReturns struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateRouteReturnsOnCall map[int]struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
 	UpdateRouteLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateRouteLabelsMutex       sync.RWMutex
 	updateRouteLabelsArgsForCall []struct {
@@ -3636,6 +4168,75 @@ type FakeActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeActor) AddRouteDestinations(arg1 string, arg2 []resources.RouteDestination) (v7action.Warnings, error) {
+	var arg2Copy []resources.RouteDestination
+	if arg2 != nil {
+		arg2Copy = make([]resources.RouteDestination, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.addRouteDestinationsReturnsOnCall[len(fake.addRouteDestinationsArgsForCall)]
+	fake.addRouteDestinationsArgsForCall = append(fake.addRouteDestinationsArgsForCall, struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}{arg1, arg2Copy})
+	fake.recordInvocation("AddRouteDestinations", []interface{}{arg1, arg2Copy})
+	fake.addRouteDestinationsMutex.Unlock()
+	if fake.AddRouteDestinationsStub != nil {
+		return fake.AddRouteDestinationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.addRouteDestinationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) AddRouteDestinationsCallCount() int {
+	fake.addRouteDestinationsMutex.RLock()
+	defer fake.addRouteDestinationsMutex.RUnlock()
+	return len(fake.addRouteDestinationsArgsForCall)
+}
+
+func (fake *FakeActor) AddRouteDestinationsCalls(stub func(string, []resources.RouteDestination) (v7action.Warnings, error)) {
+	fake.addRouteDestinationsMutex.Lock()
+	defer fake.addRouteDestinationsMutex.Unlock()
+	fake.AddRouteDestinationsStub = stub
+}
+
+func (fake *FakeActor) AddRouteDestinationsArgsForCall(i int) (string, []resources.RouteDestination) {
+	fake.addRouteDestinationsMutex.RLock()
+	defer fake.addRouteDestinationsMutex.RUnlock()
+	argsForCall := fake.addRouteDestinationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) AddRouteDestinationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.addRouteDestinationsMutex.Lock()
+	defer fake.addRouteDestinationsMutex.Unlock()
+	fake.AddRouteDestinationsStub = nil
+	fake.addRouteDestinationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) AddRouteDestinationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.addRouteDestinationsMutex.Lock()
+	defer fake.addRouteDestinationsMutex.Unlock()
+	fake.AddRouteDestinationsStub = nil
+	if fake.addRouteDestinationsReturnsOnCall == nil {
+		fake.addRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.addRouteDestinationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) ApplyOrganizationQuotaByName(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.applyOrganizationQuotaByNameMutex.Lock()
 	ret, specificReturn := fake.applyOrganizationQuotaByNameReturnsOnCall[len(fake.applyOrganizationQuotaByNameArgsForCall)]
@@ -3700,6 +4301,74 @@ func (fake *FakeActor) ApplyOrganizationQuotaByNameReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeActor) ApplySecurityGroupChanges(arg1 []v7action.SecurityGroupChange) (v7action.Warnings, error) {
+	var arg1Copy []v7action.SecurityGroupChange
+	if arg1 != nil {
+		arg1Copy = make([]v7action.SecurityGroupChange, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.applySecurityGroupChangesMutex.Lock()
+	ret, specificReturn := fake.applySecurityGroupChangesReturnsOnCall[len(fake.applySecurityGroupChangesArgsForCall)]
+	fake.applySecurityGroupChangesArgsForCall = append(fake.applySecurityGroupChangesArgsForCall, struct {
+		arg1 []v7action.SecurityGroupChange
+	}{arg1Copy})
+	fake.recordInvocation("ApplySecurityGroupChanges", []interface{}{arg1Copy})
+	fake.applySecurityGroupChangesMutex.Unlock()
+	if fake.ApplySecurityGroupChangesStub != nil {
+		return fake.ApplySecurityGroupChangesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.applySecurityGroupChangesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ApplySecurityGroupChangesCallCount() int {
+	fake.applySecurityGroupChangesMutex.RLock()
+	defer fake.applySecurityGroupChangesMutex.RUnlock()
+	return len(fake.applySecurityGroupChangesArgsForCall)
+}
+
+func (fake *FakeActor) ApplySecurityGroupChangesCalls(stub func([]v7action.SecurityGroupChange) (v7action.Warnings, error)) {
+	fake.applySecurityGroupChangesMutex.Lock()
+	defer fake.applySecurityGroupChangesMutex.Unlock()
+	fake.ApplySecurityGroupChangesStub = stub
+}
+
+func (fake *FakeActor) ApplySecurityGroupChangesArgsForCall(i int) []v7action.SecurityGroupChange {
+	fake.applySecurityGroupChangesMutex.RLock()
+	defer fake.applySecurityGroupChangesMutex.RUnlock()
+	argsForCall := fake.applySecurityGroupChangesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ApplySecurityGroupChangesReturns(result1 v7action.Warnings, result2 error) {
+	fake.applySecurityGroupChangesMutex.Lock()
+	defer fake.applySecurityGroupChangesMutex.Unlock()
+	fake.ApplySecurityGroupChangesStub = nil
+	fake.applySecurityGroupChangesReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ApplySecurityGroupChangesReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.applySecurityGroupChangesMutex.Lock()
+	defer fake.applySecurityGroupChangesMutex.Unlock()
+	fake.ApplySecurityGroupChangesStub = nil
+	if fake.applySecurityGroupChangesReturnsOnCall == nil {
+		fake.applySecurityGroupChangesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.applySecurityGroupChangesReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) ApplySpaceQuotaByName(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
 	fake.applySpaceQuotaByNameMutex.Lock()
 	ret, specificReturn := fake.applySpaceQuotaByNameReturnsOnCall[len(fake.applySpaceQuotaByNameArgsForCall)]
@@ -4384,6 +5053,73 @@ func (fake *FakeActor) CreateApplicationInSpaceReturnsOnCall(i int, result1 reso
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) CreateApplicationSidecar(arg1 string, arg2 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.createApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarReturnsOnCall[len(fake.createApplicationSidecarArgsForCall)]
+	fake.createApplicationSidecarArgsForCall = append(fake.createApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}{arg1, arg2})
+	fake.recordInvocation("CreateApplicationSidecar", []interface{}{arg1, arg2})
+	fake.createApplicationSidecarMutex.Unlock()
+	if fake.CreateApplicationSidecarStub != nil {
+		return fake.CreateApplicationSidecarStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createApplicationSidecarReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCallCount() int {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	return len(fake.createApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCalls(stub func(string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) CreateApplicationSidecarArgsForCall(i int) (string, resources.Sidecar) {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	fake.createApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	if fake.createApplicationSidecarReturnsOnCall == nil {
+		fake.createApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) CreateBitsPackageByApplication(arg1 string) (resources.Package, v7action.Warnings, error) {
 	fake.createBitsPackageByApplicationMutex.Lock()
 	ret, specificReturn := fake.createBitsPackageByApplicationReturnsOnCall[len(fake.createBitsPackageByApplicationArgsForCall)]
@@ -5175,7 +5911,7 @@ func (fake *FakeActor) CreatePrivateDomainReturnsOnCall(i int, result1 v7action.
 	}{result1, result2}
 }
 
-func (fake *FakeActor) CreateRoute(arg1 string, arg2 string, arg3 string, arg4 string, arg5 int) (resources.Route, v7action.Warnings, error) {
+func (fake *FakeActor) CreateRoute(arg1 string, arg2 string, arg3 string, arg4 string, arg5 int, arg6 map[string]*string) (resources.Route, v7action.Warnings, error) {
 	fake.createRouteMutex.Lock()
 	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
 	fake.createRouteArgsForCall = append(fake.createRouteArgsForCall, struct {
@@ -5184,11 +5920,12 @@ func (fake *FakeActor) CreateRoute(arg1 string, arg2 string, arg3 string, arg4 s
 		arg3 string
 		arg4 string
 		arg5 int
-	}{arg1, arg2, arg3, arg4, arg5})
-	fake.recordInvocation("CreateRoute", []interface{}{arg1, arg2, arg3, arg4, arg5})
+		arg6 map[string]*string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("CreateRoute", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.createRouteMutex.Unlock()
 	if fake.CreateRouteStub != nil {
-		return fake.CreateRouteStub(arg1, arg2, arg3, arg4, arg5)
+		return fake.CreateRouteStub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -5203,17 +5940,17 @@ func (fake *FakeActor) CreateRouteCallCount() int {
 	return len(fake.createRouteArgsForCall)
 }
 
-func (fake *FakeActor) CreateRouteCalls(stub func(string, string, string, string, int) (resources.Route, v7action.Warnings, error)) {
+func (fake *FakeActor) CreateRouteCalls(stub func(string, string, string, string, int, map[string]*string) (resources.Route, v7action.Warnings, error)) {
 	fake.createRouteMutex.Lock()
 	defer fake.createRouteMutex.Unlock()
 	fake.CreateRouteStub = stub
 }
 
-func (fake *FakeActor) CreateRouteArgsForCall(i int) (string, string, string, string, int) {
+func (fake *FakeActor) CreateRouteArgsForCall(i int) (string, string, string, string, int, map[string]*string) {
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
 	argsForCall := fake.createRouteArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeActor) CreateRouteReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
@@ -5311,6 +6048,75 @@ func (fake *FakeActor) CreateRouteBindingReturnsOnCall(i int, result1 chan v7act
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) CreateRouteInPortRange(arg1 string, arg2 string, arg3 v7action.PortRange, arg4 map[string]*string) (resources.Route, v7action.Warnings, error) {
+	fake.createRouteInPortRangeMutex.Lock()
+	ret, specificReturn := fake.createRouteInPortRangeReturnsOnCall[len(fake.createRouteInPortRangeArgsForCall)]
+	fake.createRouteInPortRangeArgsForCall = append(fake.createRouteInPortRangeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.PortRange
+		arg4 map[string]*string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CreateRouteInPortRange", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createRouteInPortRangeMutex.Unlock()
+	if fake.CreateRouteInPortRangeStub != nil {
+		return fake.CreateRouteInPortRangeStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createRouteInPortRangeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CreateRouteInPortRangeCallCount() int {
+	fake.createRouteInPortRangeMutex.RLock()
+	defer fake.createRouteInPortRangeMutex.RUnlock()
+	return len(fake.createRouteInPortRangeArgsForCall)
+}
+
+func (fake *FakeActor) CreateRouteInPortRangeCalls(stub func(string, string, v7action.PortRange, map[string]*string) (resources.Route, v7action.Warnings, error)) {
+	fake.createRouteInPortRangeMutex.Lock()
+	defer fake.createRouteInPortRangeMutex.Unlock()
+	fake.CreateRouteInPortRangeStub = stub
+}
+
+func (fake *FakeActor) CreateRouteInPortRangeArgsForCall(i int) (string, string, v7action.PortRange, map[string]*string) {
+	fake.createRouteInPortRangeMutex.RLock()
+	defer fake.createRouteInPortRangeMutex.RUnlock()
+	argsForCall := fake.createRouteInPortRangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) CreateRouteInPortRangeReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.createRouteInPortRangeMutex.Lock()
+	defer fake.createRouteInPortRangeMutex.Unlock()
+	fake.CreateRouteInPortRangeStub = nil
+	fake.createRouteInPortRangeReturns = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CreateRouteInPortRangeReturnsOnCall(i int, result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.createRouteInPortRangeMutex.Lock()
+	defer fake.createRouteInPortRangeMutex.Unlock()
+	fake.CreateRouteInPortRangeStub = nil
+	if fake.createRouteInPortRangeReturnsOnCall == nil {
+		fake.createRouteInPortRangeReturnsOnCall = make(map[int]struct {
+			result1 resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createRouteInPortRangeReturnsOnCall[i] = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) CreateSecurityGroup(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.createSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.createSecurityGroupReturnsOnCall[len(fake.createSecurityGroupArgsForCall)]
@@ -6031,6 +6837,70 @@ func (fake *FakeActor) DeleteApplicationByNameAndSpaceReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DeleteApplicationSidecar(arg1 string, arg2 string) (v7action.Warnings, error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationSidecarReturnsOnCall[len(fake.deleteApplicationSidecarArgsForCall)]
+	fake.deleteApplicationSidecarArgsForCall = append(fake.deleteApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteApplicationSidecar", []interface{}{arg1, arg2})
+	fake.deleteApplicationSidecarMutex.Unlock()
+	if fake.DeleteApplicationSidecarStub != nil {
+		return fake.DeleteApplicationSidecarStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteApplicationSidecarReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarCallCount() int {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	return len(fake.deleteApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarCalls(stub func(string, string) (v7action.Warnings, error)) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarArgsForCall(i int) (string, string) {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.deleteApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	fake.deleteApplicationSidecarReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	if fake.deleteApplicationSidecarReturnsOnCall == nil {
+		fake.deleteApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationSidecarReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteBuildpackByNameAndStack(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.deleteBuildpackByNameAndStackMutex.Lock()
 	ret, specificReturn := fake.deleteBuildpackByNameAndStackReturnsOnCall[len(fake.deleteBuildpackByNameAndStackArgsForCall)]
@@ -6868,6 +7738,72 @@ func (fake *FakeActor) DeleteServiceAppBindingReturnsOnCall(i int, result1 chan
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) DeleteServiceAppBindingByGUID(arg1 string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+	fake.deleteServiceAppBindingByGUIDMutex.Lock()
+	ret, specificReturn := fake.deleteServiceAppBindingByGUIDReturnsOnCall[len(fake.deleteServiceAppBindingByGUIDArgsForCall)]
+	fake.deleteServiceAppBindingByGUIDArgsForCall = append(fake.deleteServiceAppBindingByGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteServiceAppBindingByGUID", []interface{}{arg1})
+	fake.deleteServiceAppBindingByGUIDMutex.Unlock()
+	if fake.DeleteServiceAppBindingByGUIDStub != nil {
+		return fake.DeleteServiceAppBindingByGUIDStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteServiceAppBindingByGUIDReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDCallCount() int {
+	fake.deleteServiceAppBindingByGUIDMutex.RLock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.RUnlock()
+	return len(fake.deleteServiceAppBindingByGUIDArgsForCall)
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDCalls(stub func(string) (chan v7action.PollJobEvent, v7action.Warnings, error)) {
+	fake.deleteServiceAppBindingByGUIDMutex.Lock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.Unlock()
+	fake.DeleteServiceAppBindingByGUIDStub = stub
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDArgsForCall(i int) string {
+	fake.deleteServiceAppBindingByGUIDMutex.RLock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.RUnlock()
+	argsForCall := fake.deleteServiceAppBindingByGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDReturns(result1 chan v7action.PollJobEvent, result2 v7action.Warnings, result3 error) {
+	fake.deleteServiceAppBindingByGUIDMutex.Lock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.Unlock()
+	fake.DeleteServiceAppBindingByGUIDStub = nil
+	fake.deleteServiceAppBindingByGUIDReturns = struct {
+		result1 chan v7action.PollJobEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDReturnsOnCall(i int, result1 chan v7action.PollJobEvent, result2 v7action.Warnings, result3 error) {
+	fake.deleteServiceAppBindingByGUIDMutex.Lock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.Unlock()
+	fake.DeleteServiceAppBindingByGUIDStub = nil
+	if fake.deleteServiceAppBindingByGUIDReturnsOnCall == nil {
+		fake.deleteServiceAppBindingByGUIDReturnsOnCall = make(map[int]struct {
+			result1 chan v7action.PollJobEvent
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.deleteServiceAppBindingByGUIDReturnsOnCall[i] = struct {
+		result1 chan v7action.PollJobEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) DeleteServiceBroker(arg1 string) (v7action.Warnings, error) {
 	fake.deleteServiceBrokerMutex.Lock()
 	ret, specificReturn := fake.deleteServiceBrokerReturnsOnCall[len(fake.deleteServiceBrokerArgsForCall)]
@@ -7261,9 +8197,72 @@ func (fake *FakeActor) DeleteSpaceRoleReturnsOnCall(i int, result1 v7action.Warn
 	}{result1, result2}
 }
 
-func (fake *FakeActor) DeleteUser(arg1 string) (v7action.Warnings, error) {
-	fake.deleteUserMutex.Lock()
-	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+func (fake *FakeActor) DeleteStaleRoute(arg1 v7action.StaleRoute) (v7action.Warnings, error) {
+	fake.deleteStaleRouteMutex.Lock()
+	ret, specificReturn := fake.deleteStaleRouteReturnsOnCall[len(fake.deleteStaleRouteArgsForCall)]
+	fake.deleteStaleRouteArgsForCall = append(fake.deleteStaleRouteArgsForCall, struct {
+		arg1 v7action.StaleRoute
+	}{arg1})
+	fake.recordInvocation("DeleteStaleRoute", []interface{}{arg1})
+	fake.deleteStaleRouteMutex.Unlock()
+	if fake.DeleteStaleRouteStub != nil {
+		return fake.DeleteStaleRouteStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteStaleRouteReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteStaleRouteCallCount() int {
+	fake.deleteStaleRouteMutex.RLock()
+	defer fake.deleteStaleRouteMutex.RUnlock()
+	return len(fake.deleteStaleRouteArgsForCall)
+}
+
+func (fake *FakeActor) DeleteStaleRouteCalls(stub func(v7action.StaleRoute) (v7action.Warnings, error)) {
+	fake.deleteStaleRouteMutex.Lock()
+	defer fake.deleteStaleRouteMutex.Unlock()
+	fake.DeleteStaleRouteStub = stub
+}
+
+func (fake *FakeActor) DeleteStaleRouteArgsForCall(i int) v7action.StaleRoute {
+	fake.deleteStaleRouteMutex.RLock()
+	defer fake.deleteStaleRouteMutex.RUnlock()
+	argsForCall := fake.deleteStaleRouteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DeleteStaleRouteReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteStaleRouteMutex.Lock()
+	defer fake.deleteStaleRouteMutex.Unlock()
+	fake.DeleteStaleRouteStub = nil
+	fake.deleteStaleRouteReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteStaleRouteReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteStaleRouteMutex.Lock()
+	defer fake.deleteStaleRouteMutex.Unlock()
+	fake.DeleteStaleRouteStub = nil
+	if fake.deleteStaleRouteReturnsOnCall == nil {
+		fake.deleteStaleRouteReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteStaleRouteReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteUser(arg1 string) (v7action.Warnings, error) {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
 	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
 		arg1 string
 	}{arg1})
@@ -7324,6 +8323,76 @@ func (fake *FakeActor) DeleteUserReturnsOnCall(i int, result1 v7action.Warnings,
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DiffServiceBrokerCatalog(arg1 string, arg2 string, arg3 string, arg4 string, arg5 v7action.ServiceBrokerCatalogFetcher) (v7action.ServiceBrokerCatalogDiff, v7action.Warnings, error) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	ret, specificReturn := fake.diffServiceBrokerCatalogReturnsOnCall[len(fake.diffServiceBrokerCatalogArgsForCall)]
+	fake.diffServiceBrokerCatalogArgsForCall = append(fake.diffServiceBrokerCatalogArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 v7action.ServiceBrokerCatalogFetcher
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("DiffServiceBrokerCatalog", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.diffServiceBrokerCatalogMutex.Unlock()
+	if fake.DiffServiceBrokerCatalogStub != nil {
+		return fake.DiffServiceBrokerCatalogStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.diffServiceBrokerCatalogReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogCallCount() int {
+	fake.diffServiceBrokerCatalogMutex.RLock()
+	defer fake.diffServiceBrokerCatalogMutex.RUnlock()
+	return len(fake.diffServiceBrokerCatalogArgsForCall)
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogCalls(stub func(string, string, string, string, v7action.ServiceBrokerCatalogFetcher) (v7action.ServiceBrokerCatalogDiff, v7action.Warnings, error)) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	defer fake.diffServiceBrokerCatalogMutex.Unlock()
+	fake.DiffServiceBrokerCatalogStub = stub
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogArgsForCall(i int) (string, string, string, string, v7action.ServiceBrokerCatalogFetcher) {
+	fake.diffServiceBrokerCatalogMutex.RLock()
+	defer fake.diffServiceBrokerCatalogMutex.RUnlock()
+	argsForCall := fake.diffServiceBrokerCatalogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogReturns(result1 v7action.ServiceBrokerCatalogDiff, result2 v7action.Warnings, result3 error) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	defer fake.diffServiceBrokerCatalogMutex.Unlock()
+	fake.DiffServiceBrokerCatalogStub = nil
+	fake.diffServiceBrokerCatalogReturns = struct {
+		result1 v7action.ServiceBrokerCatalogDiff
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogReturnsOnCall(i int, result1 v7action.ServiceBrokerCatalogDiff, result2 v7action.Warnings, result3 error) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	defer fake.diffServiceBrokerCatalogMutex.Unlock()
+	fake.DiffServiceBrokerCatalogStub = nil
+	if fake.diffServiceBrokerCatalogReturnsOnCall == nil {
+		fake.diffServiceBrokerCatalogReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceBrokerCatalogDiff
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.diffServiceBrokerCatalogReturnsOnCall[i] = struct {
+		result1 v7action.ServiceBrokerCatalogDiff
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) DiffSpaceManifest(arg1 string, arg2 []byte) (resources.ManifestDiff, v7action.Warnings, error) {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -8063,6 +9132,76 @@ func (fake *FakeActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationCrashesByNameAndSpace(arg1 string, arg2 string, arg3 time.Time, arg4 int, arg5 sharedaction.LogCacheClient) ([]v7action.ApplicationCrash, v7action.Warnings, error) {
+	fake.getApplicationCrashesByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationCrashesByNameAndSpaceReturnsOnCall[len(fake.getApplicationCrashesByNameAndSpaceArgsForCall)]
+	fake.getApplicationCrashesByNameAndSpaceArgsForCall = append(fake.getApplicationCrashesByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+		arg4 int
+		arg5 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("GetApplicationCrashesByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getApplicationCrashesByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationCrashesByNameAndSpaceStub != nil {
+		return fake.GetApplicationCrashesByNameAndSpaceStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationCrashesByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationCrashesByNameAndSpaceCallCount() int {
+	fake.getApplicationCrashesByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationCrashesByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationCrashesByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationCrashesByNameAndSpaceCalls(stub func(string, string, time.Time, int, sharedaction.LogCacheClient) ([]v7action.ApplicationCrash, v7action.Warnings, error)) {
+	fake.getApplicationCrashesByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationCrashesByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationCrashesByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationCrashesByNameAndSpaceArgsForCall(i int) (string, string, time.Time, int, sharedaction.LogCacheClient) {
+	fake.getApplicationCrashesByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationCrashesByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationCrashesByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeActor) GetApplicationCrashesByNameAndSpaceReturns(result1 []v7action.ApplicationCrash, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationCrashesByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationCrashesByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationCrashesByNameAndSpaceStub = nil
+	fake.getApplicationCrashesByNameAndSpaceReturns = struct {
+		result1 []v7action.ApplicationCrash
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationCrashesByNameAndSpaceReturnsOnCall(i int, result1 []v7action.ApplicationCrash, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationCrashesByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationCrashesByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationCrashesByNameAndSpaceStub = nil
+	if fake.getApplicationCrashesByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationCrashesByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ApplicationCrash
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationCrashesByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []v7action.ApplicationCrash
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationDroplets(arg1 string, arg2 string) ([]resources.Droplet, v7action.Warnings, error) {
 	fake.getApplicationDropletsMutex.Lock()
 	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
@@ -8529,6 +9668,72 @@ func (fake *FakeActor) GetApplicationRoutesReturnsOnCall(i int, result1 []resour
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationSidecars(arg1 string) ([]resources.Sidecar, v7action.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{arg1})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if fake.GetApplicationSidecarsStub != nil {
+		return fake.GetApplicationSidecarsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationSidecarsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationSidecarsCalls(stub func(string) ([]resources.Sidecar, v7action.Warnings, error)) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = stub
+}
+
+func (fake *FakeActor) GetApplicationSidecarsArgsForCall(i int) string {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetApplicationSidecarsReturns(result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationSidecarsReturnsOnCall(i int, result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationTasks(arg1 string, arg2 v7action.SortOrder) ([]resources.Task, v7action.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -8668,6 +9873,138 @@ func (fake *FakeActor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetAuditEvents(arg1 v7action.EventFilter) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getAuditEventsMutex.Lock()
+	ret, specificReturn := fake.getAuditEventsReturnsOnCall[len(fake.getAuditEventsArgsForCall)]
+	fake.getAuditEventsArgsForCall = append(fake.getAuditEventsArgsForCall, struct {
+		arg1 v7action.EventFilter
+	}{arg1})
+	fake.recordInvocation("GetAuditEvents", []interface{}{arg1})
+	fake.getAuditEventsMutex.Unlock()
+	if fake.GetAuditEventsStub != nil {
+		return fake.GetAuditEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAuditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAuditEventsCallCount() int {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	return len(fake.getAuditEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetAuditEventsCalls(stub func(v7action.EventFilter) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = stub
+}
+
+func (fake *FakeActor) GetAuditEventsArgsForCall(i int) v7action.EventFilter {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	argsForCall := fake.getAuditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetAuditEventsReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	fake.getAuditEventsReturns = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAuditEventsReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	if fake.getAuditEventsReturnsOnCall == nil {
+		fake.getAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAuditEventsReturnsOnCall[i] = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetBindings(arg1 v7action.GetBindingsParams) ([]v7action.Binding, v7action.Warnings, error) {
+	fake.getBindingsMutex.Lock()
+	ret, specificReturn := fake.getBindingsReturnsOnCall[len(fake.getBindingsArgsForCall)]
+	fake.getBindingsArgsForCall = append(fake.getBindingsArgsForCall, struct {
+		arg1 v7action.GetBindingsParams
+	}{arg1})
+	fake.recordInvocation("GetBindings", []interface{}{arg1})
+	fake.getBindingsMutex.Unlock()
+	if fake.GetBindingsStub != nil {
+		return fake.GetBindingsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getBindingsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetBindingsCallCount() int {
+	fake.getBindingsMutex.RLock()
+	defer fake.getBindingsMutex.RUnlock()
+	return len(fake.getBindingsArgsForCall)
+}
+
+func (fake *FakeActor) GetBindingsCalls(stub func(v7action.GetBindingsParams) ([]v7action.Binding, v7action.Warnings, error)) {
+	fake.getBindingsMutex.Lock()
+	defer fake.getBindingsMutex.Unlock()
+	fake.GetBindingsStub = stub
+}
+
+func (fake *FakeActor) GetBindingsArgsForCall(i int) v7action.GetBindingsParams {
+	fake.getBindingsMutex.RLock()
+	defer fake.getBindingsMutex.RUnlock()
+	argsForCall := fake.getBindingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetBindingsReturns(result1 []v7action.Binding, result2 v7action.Warnings, result3 error) {
+	fake.getBindingsMutex.Lock()
+	defer fake.getBindingsMutex.Unlock()
+	fake.GetBindingsStub = nil
+	fake.getBindingsReturns = struct {
+		result1 []v7action.Binding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetBindingsReturnsOnCall(i int, result1 []v7action.Binding, result2 v7action.Warnings, result3 error) {
+	fake.getBindingsMutex.Lock()
+	defer fake.getBindingsMutex.Unlock()
+	fake.GetBindingsStub = nil
+	if fake.getBindingsReturnsOnCall == nil {
+		fake.getBindingsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Binding
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getBindingsReturnsOnCall[i] = struct {
+		result1 []v7action.Binding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetBuildpackLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getBuildpackLabelsMutex.Lock()
 	ret, specificReturn := fake.getBuildpackLabelsReturnsOnCall[len(fake.getBuildpackLabelsArgsForCall)]
@@ -9255,6 +10592,74 @@ func (fake *FakeActor) GetEffectiveIsolationSegmentBySpaceReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetEffectiveSecurityRules(arg1 string, arg2 string, arg3 constanta.SecurityGroupLifecycle) ([]v7action.EffectiveSecurityRule, v7action.Warnings, error) {
+	fake.getEffectiveSecurityRulesMutex.Lock()
+	ret, specificReturn := fake.getEffectiveSecurityRulesReturnsOnCall[len(fake.getEffectiveSecurityRulesArgsForCall)]
+	fake.getEffectiveSecurityRulesArgsForCall = append(fake.getEffectiveSecurityRulesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 constanta.SecurityGroupLifecycle
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetEffectiveSecurityRules", []interface{}{arg1, arg2, arg3})
+	fake.getEffectiveSecurityRulesMutex.Unlock()
+	if fake.GetEffectiveSecurityRulesStub != nil {
+		return fake.GetEffectiveSecurityRulesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEffectiveSecurityRulesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetEffectiveSecurityRulesCallCount() int {
+	fake.getEffectiveSecurityRulesMutex.RLock()
+	defer fake.getEffectiveSecurityRulesMutex.RUnlock()
+	return len(fake.getEffectiveSecurityRulesArgsForCall)
+}
+
+func (fake *FakeActor) GetEffectiveSecurityRulesCalls(stub func(string, string, constanta.SecurityGroupLifecycle) ([]v7action.EffectiveSecurityRule, v7action.Warnings, error)) {
+	fake.getEffectiveSecurityRulesMutex.Lock()
+	defer fake.getEffectiveSecurityRulesMutex.Unlock()
+	fake.GetEffectiveSecurityRulesStub = stub
+}
+
+func (fake *FakeActor) GetEffectiveSecurityRulesArgsForCall(i int) (string, string, constanta.SecurityGroupLifecycle) {
+	fake.getEffectiveSecurityRulesMutex.RLock()
+	defer fake.getEffectiveSecurityRulesMutex.RUnlock()
+	argsForCall := fake.getEffectiveSecurityRulesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetEffectiveSecurityRulesReturns(result1 []v7action.EffectiveSecurityRule, result2 v7action.Warnings, result3 error) {
+	fake.getEffectiveSecurityRulesMutex.Lock()
+	defer fake.getEffectiveSecurityRulesMutex.Unlock()
+	fake.GetEffectiveSecurityRulesStub = nil
+	fake.getEffectiveSecurityRulesReturns = struct {
+		result1 []v7action.EffectiveSecurityRule
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEffectiveSecurityRulesReturnsOnCall(i int, result1 []v7action.EffectiveSecurityRule, result2 v7action.Warnings, result3 error) {
+	fake.getEffectiveSecurityRulesMutex.Lock()
+	defer fake.getEffectiveSecurityRulesMutex.Unlock()
+	fake.GetEffectiveSecurityRulesStub = nil
+	if fake.getEffectiveSecurityRulesReturnsOnCall == nil {
+		fake.getEffectiveSecurityRulesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.EffectiveSecurityRule
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEffectiveSecurityRulesReturnsOnCall[i] = struct {
+		result1 []v7action.EffectiveSecurityRule
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetEnvironmentVariableGroup(arg1 constanta.EnvironmentVariableGroupName) (v7action.EnvironmentVariableGroup, v7action.Warnings, error) {
 	fake.getEnvironmentVariableGroupMutex.Lock()
 	ret, specificReturn := fake.getEnvironmentVariableGroupReturnsOnCall[len(fake.getEnvironmentVariableGroupArgsForCall)]
@@ -9628,58 +11033,192 @@ func (fake *FakeActor) GetGlobalStagingSecurityGroupsReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetIsolationSegmentByName(arg1 string) (resources.IsolationSegment, v7action.Warnings, error) {
-	fake.getIsolationSegmentByNameMutex.Lock()
-	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
-	fake.getIsolationSegmentByNameArgsForCall = append(fake.getIsolationSegmentByNameArgsForCall, struct {
+func (fake *FakeActor) GetInternalRoute(arg1 string, arg2 string) (v7action.InternalRoute, v7action.Warnings, error) {
+	fake.getInternalRouteMutex.Lock()
+	ret, specificReturn := fake.getInternalRouteReturnsOnCall[len(fake.getInternalRouteArgsForCall)]
+	fake.getInternalRouteArgsForCall = append(fake.getInternalRouteArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	fake.recordInvocation("GetIsolationSegmentByName", []interface{}{arg1})
-	fake.getIsolationSegmentByNameMutex.Unlock()
-	if fake.GetIsolationSegmentByNameStub != nil {
-		return fake.GetIsolationSegmentByNameStub(arg1)
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetInternalRoute", []interface{}{arg1, arg2})
+	fake.getInternalRouteMutex.Unlock()
+	if fake.GetInternalRouteStub != nil {
+		return fake.GetInternalRouteStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.getIsolationSegmentByNameReturns
+	fakeReturns := fake.getInternalRouteReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetIsolationSegmentByNameCallCount() int {
-	fake.getIsolationSegmentByNameMutex.RLock()
-	defer fake.getIsolationSegmentByNameMutex.RUnlock()
-	return len(fake.getIsolationSegmentByNameArgsForCall)
+func (fake *FakeActor) GetInternalRouteCallCount() int {
+	fake.getInternalRouteMutex.RLock()
+	defer fake.getInternalRouteMutex.RUnlock()
+	return len(fake.getInternalRouteArgsForCall)
 }
 
-func (fake *FakeActor) GetIsolationSegmentByNameCalls(stub func(string) (resources.IsolationSegment, v7action.Warnings, error)) {
-	fake.getIsolationSegmentByNameMutex.Lock()
-	defer fake.getIsolationSegmentByNameMutex.Unlock()
-	fake.GetIsolationSegmentByNameStub = stub
+func (fake *FakeActor) GetInternalRouteCalls(stub func(string, string) (v7action.InternalRoute, v7action.Warnings, error)) {
+	fake.getInternalRouteMutex.Lock()
+	defer fake.getInternalRouteMutex.Unlock()
+	fake.GetInternalRouteStub = stub
 }
 
-func (fake *FakeActor) GetIsolationSegmentByNameArgsForCall(i int) string {
-	fake.getIsolationSegmentByNameMutex.RLock()
-	defer fake.getIsolationSegmentByNameMutex.RUnlock()
-	argsForCall := fake.getIsolationSegmentByNameArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeActor) GetInternalRouteArgsForCall(i int) (string, string) {
+	fake.getInternalRouteMutex.RLock()
+	defer fake.getInternalRouteMutex.RUnlock()
+	argsForCall := fake.getInternalRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeActor) GetIsolationSegmentByNameReturns(result1 resources.IsolationSegment, result2 v7action.Warnings, result3 error) {
-	fake.getIsolationSegmentByNameMutex.Lock()
-	defer fake.getIsolationSegmentByNameMutex.Unlock()
-	fake.GetIsolationSegmentByNameStub = nil
-	fake.getIsolationSegmentByNameReturns = struct {
-		result1 resources.IsolationSegment
+func (fake *FakeActor) GetInternalRouteReturns(result1 v7action.InternalRoute, result2 v7action.Warnings, result3 error) {
+	fake.getInternalRouteMutex.Lock()
+	defer fake.getInternalRouteMutex.Unlock()
+	fake.GetInternalRouteStub = nil
+	fake.getInternalRouteReturns = struct {
+		result1 v7action.InternalRoute
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetIsolationSegmentByNameReturnsOnCall(i int, result1 resources.IsolationSegment, result2 v7action.Warnings, result3 error) {
-	fake.getIsolationSegmentByNameMutex.Lock()
-	defer fake.getIsolationSegmentByNameMutex.Unlock()
-	fake.GetIsolationSegmentByNameStub = nil
+func (fake *FakeActor) GetInternalRouteReturnsOnCall(i int, result1 v7action.InternalRoute, result2 v7action.Warnings, result3 error) {
+	fake.getInternalRouteMutex.Lock()
+	defer fake.getInternalRouteMutex.Unlock()
+	fake.GetInternalRouteStub = nil
+	if fake.getInternalRouteReturnsOnCall == nil {
+		fake.getInternalRouteReturnsOnCall = make(map[int]struct {
+			result1 v7action.InternalRoute
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getInternalRouteReturnsOnCall[i] = struct {
+		result1 v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetInternalRoutes(arg1 string, arg2 string) ([]v7action.InternalRoute, v7action.Warnings, error) {
+	fake.getInternalRoutesMutex.Lock()
+	ret, specificReturn := fake.getInternalRoutesReturnsOnCall[len(fake.getInternalRoutesArgsForCall)]
+	fake.getInternalRoutesArgsForCall = append(fake.getInternalRoutesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetInternalRoutes", []interface{}{arg1, arg2})
+	fake.getInternalRoutesMutex.Unlock()
+	if fake.GetInternalRoutesStub != nil {
+		return fake.GetInternalRoutesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getInternalRoutesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetInternalRoutesCallCount() int {
+	fake.getInternalRoutesMutex.RLock()
+	defer fake.getInternalRoutesMutex.RUnlock()
+	return len(fake.getInternalRoutesArgsForCall)
+}
+
+func (fake *FakeActor) GetInternalRoutesCalls(stub func(string, string) ([]v7action.InternalRoute, v7action.Warnings, error)) {
+	fake.getInternalRoutesMutex.Lock()
+	defer fake.getInternalRoutesMutex.Unlock()
+	fake.GetInternalRoutesStub = stub
+}
+
+func (fake *FakeActor) GetInternalRoutesArgsForCall(i int) (string, string) {
+	fake.getInternalRoutesMutex.RLock()
+	defer fake.getInternalRoutesMutex.RUnlock()
+	argsForCall := fake.getInternalRoutesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetInternalRoutesReturns(result1 []v7action.InternalRoute, result2 v7action.Warnings, result3 error) {
+	fake.getInternalRoutesMutex.Lock()
+	defer fake.getInternalRoutesMutex.Unlock()
+	fake.GetInternalRoutesStub = nil
+	fake.getInternalRoutesReturns = struct {
+		result1 []v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetInternalRoutesReturnsOnCall(i int, result1 []v7action.InternalRoute, result2 v7action.Warnings, result3 error) {
+	fake.getInternalRoutesMutex.Lock()
+	defer fake.getInternalRoutesMutex.Unlock()
+	fake.GetInternalRoutesStub = nil
+	if fake.getInternalRoutesReturnsOnCall == nil {
+		fake.getInternalRoutesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InternalRoute
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getInternalRoutesReturnsOnCall[i] = struct {
+		result1 []v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetIsolationSegmentByName(arg1 string) (resources.IsolationSegment, v7action.Warnings, error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
+	fake.getIsolationSegmentByNameArgsForCall = append(fake.getIsolationSegmentByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetIsolationSegmentByName", []interface{}{arg1})
+	fake.getIsolationSegmentByNameMutex.Unlock()
+	if fake.GetIsolationSegmentByNameStub != nil {
+		return fake.GetIsolationSegmentByNameStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getIsolationSegmentByNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetIsolationSegmentByNameCallCount() int {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return len(fake.getIsolationSegmentByNameArgsForCall)
+}
+
+func (fake *FakeActor) GetIsolationSegmentByNameCalls(stub func(string) (resources.IsolationSegment, v7action.Warnings, error)) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	defer fake.getIsolationSegmentByNameMutex.Unlock()
+	fake.GetIsolationSegmentByNameStub = stub
+}
+
+func (fake *FakeActor) GetIsolationSegmentByNameArgsForCall(i int) string {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	argsForCall := fake.getIsolationSegmentByNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetIsolationSegmentByNameReturns(result1 resources.IsolationSegment, result2 v7action.Warnings, result3 error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	defer fake.getIsolationSegmentByNameMutex.Unlock()
+	fake.GetIsolationSegmentByNameStub = nil
+	fake.getIsolationSegmentByNameReturns = struct {
+		result1 resources.IsolationSegment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetIsolationSegmentByNameReturnsOnCall(i int, result1 resources.IsolationSegment, result2 v7action.Warnings, result3 error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	defer fake.getIsolationSegmentByNameMutex.Unlock()
+	fake.GetIsolationSegmentByNameStub = nil
 	if fake.getIsolationSegmentByNameReturnsOnCall == nil {
 		fake.getIsolationSegmentByNameReturnsOnCall = make(map[int]struct {
 			result1 resources.IsolationSegment
@@ -11120,6 +12659,73 @@ func (fake *FakeActor) GetRootResponseReturnsOnCall(i int, result1 v7action.Info
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRoute(arg1 string, arg2 string) (resources.Route, v7action.Warnings, error) {
+	fake.getRouteMutex.Lock()
+	ret, specificReturn := fake.getRouteReturnsOnCall[len(fake.getRouteArgsForCall)]
+	fake.getRouteArgsForCall = append(fake.getRouteArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetRoute", []interface{}{arg1, arg2})
+	fake.getRouteMutex.Unlock()
+	if fake.GetRouteStub != nil {
+		return fake.GetRouteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteCallCount() int {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	return len(fake.getRouteArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteCalls(stub func(string, string) (resources.Route, v7action.Warnings, error)) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = stub
+}
+
+func (fake *FakeActor) GetRouteArgsForCall(i int) (string, string) {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	argsForCall := fake.getRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetRouteReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	fake.getRouteReturns = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteReturnsOnCall(i int, result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	if fake.getRouteReturnsOnCall == nil {
+		fake.getRouteReturnsOnCall = make(map[int]struct {
+			result1 resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteReturnsOnCall[i] = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRouteByAttributes(arg1 resources.Domain, arg2 string, arg3 string, arg4 int) (resources.Route, v7action.Warnings, error) {
 	fake.getRouteByAttributesMutex.Lock()
 	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
@@ -11391,6 +12997,72 @@ func (fake *FakeActor) GetRouteSummariesReturnsOnCall(i int, result1 []v7action.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRouterGroupDetails(arg1 string) (v7action.RouterGroupDetails, v7action.Warnings, error) {
+	fake.getRouterGroupDetailsMutex.Lock()
+	ret, specificReturn := fake.getRouterGroupDetailsReturnsOnCall[len(fake.getRouterGroupDetailsArgsForCall)]
+	fake.getRouterGroupDetailsArgsForCall = append(fake.getRouterGroupDetailsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetRouterGroupDetails", []interface{}{arg1})
+	fake.getRouterGroupDetailsMutex.Unlock()
+	if fake.GetRouterGroupDetailsStub != nil {
+		return fake.GetRouterGroupDetailsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouterGroupDetailsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouterGroupDetailsCallCount() int {
+	fake.getRouterGroupDetailsMutex.RLock()
+	defer fake.getRouterGroupDetailsMutex.RUnlock()
+	return len(fake.getRouterGroupDetailsArgsForCall)
+}
+
+func (fake *FakeActor) GetRouterGroupDetailsCalls(stub func(string) (v7action.RouterGroupDetails, v7action.Warnings, error)) {
+	fake.getRouterGroupDetailsMutex.Lock()
+	defer fake.getRouterGroupDetailsMutex.Unlock()
+	fake.GetRouterGroupDetailsStub = stub
+}
+
+func (fake *FakeActor) GetRouterGroupDetailsArgsForCall(i int) string {
+	fake.getRouterGroupDetailsMutex.RLock()
+	defer fake.getRouterGroupDetailsMutex.RUnlock()
+	argsForCall := fake.getRouterGroupDetailsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRouterGroupDetailsReturns(result1 v7action.RouterGroupDetails, result2 v7action.Warnings, result3 error) {
+	fake.getRouterGroupDetailsMutex.Lock()
+	defer fake.getRouterGroupDetailsMutex.Unlock()
+	fake.GetRouterGroupDetailsStub = nil
+	fake.getRouterGroupDetailsReturns = struct {
+		result1 v7action.RouterGroupDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouterGroupDetailsReturnsOnCall(i int, result1 v7action.RouterGroupDetails, result2 v7action.Warnings, result3 error) {
+	fake.getRouterGroupDetailsMutex.Lock()
+	defer fake.getRouterGroupDetailsMutex.Unlock()
+	fake.GetRouterGroupDetailsStub = nil
+	if fake.getRouterGroupDetailsReturnsOnCall == nil {
+		fake.getRouterGroupDetailsReturnsOnCall = make(map[int]struct {
+			result1 v7action.RouterGroupDetails
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouterGroupDetailsReturnsOnCall[i] = struct {
+		result1 v7action.RouterGroupDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRouterGroups() ([]v7action.RouterGroup, error) {
 	fake.getRouterGroupsMutex.Lock()
 	ret, specificReturn := fake.getRouterGroupsReturnsOnCall[len(fake.getRouterGroupsArgsForCall)]
@@ -11580,6 +13252,73 @@ func (fake *FakeActor) GetRoutesBySpaceReturnsOnCall(i int, result1 []resources.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRunningApplicationTasks(arg1 string, arg2 string) ([]resources.Task, v7action.Warnings, error) {
+	fake.getRunningApplicationTasksMutex.Lock()
+	ret, specificReturn := fake.getRunningApplicationTasksReturnsOnCall[len(fake.getRunningApplicationTasksArgsForCall)]
+	fake.getRunningApplicationTasksArgsForCall = append(fake.getRunningApplicationTasksArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetRunningApplicationTasks", []interface{}{arg1, arg2})
+	fake.getRunningApplicationTasksMutex.Unlock()
+	if fake.GetRunningApplicationTasksStub != nil {
+		return fake.GetRunningApplicationTasksStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRunningApplicationTasksReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRunningApplicationTasksCallCount() int {
+	fake.getRunningApplicationTasksMutex.RLock()
+	defer fake.getRunningApplicationTasksMutex.RUnlock()
+	return len(fake.getRunningApplicationTasksArgsForCall)
+}
+
+func (fake *FakeActor) GetRunningApplicationTasksCalls(stub func(string, string) ([]resources.Task, v7action.Warnings, error)) {
+	fake.getRunningApplicationTasksMutex.Lock()
+	defer fake.getRunningApplicationTasksMutex.Unlock()
+	fake.GetRunningApplicationTasksStub = stub
+}
+
+func (fake *FakeActor) GetRunningApplicationTasksArgsForCall(i int) (string, string) {
+	fake.getRunningApplicationTasksMutex.RLock()
+	defer fake.getRunningApplicationTasksMutex.RUnlock()
+	argsForCall := fake.getRunningApplicationTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetRunningApplicationTasksReturns(result1 []resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.getRunningApplicationTasksMutex.Lock()
+	defer fake.getRunningApplicationTasksMutex.Unlock()
+	fake.GetRunningApplicationTasksStub = nil
+	fake.getRunningApplicationTasksReturns = struct {
+		result1 []resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRunningApplicationTasksReturnsOnCall(i int, result1 []resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.getRunningApplicationTasksMutex.Lock()
+	defer fake.getRunningApplicationTasksMutex.Unlock()
+	fake.GetRunningApplicationTasksStub = nil
+	if fake.getRunningApplicationTasksReturnsOnCall == nil {
+		fake.getRunningApplicationTasksReturnsOnCall = make(map[int]struct {
+			result1 []resources.Task
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRunningApplicationTasksReturnsOnCall[i] = struct {
+		result1 []resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSSHEnabled(arg1 string) (ccv3.SSHEnabled, v7action.Warnings, error) {
 	fake.getSSHEnabledMutex.Lock()
 	ret, specificReturn := fake.getSSHEnabledReturnsOnCall[len(fake.getSSHEnabledArgsForCall)]
@@ -12095,6 +13834,72 @@ func (fake *FakeActor) GetServiceAccessReturnsOnCall(i int, result1 []v7action.S
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceAppBinding(arg1 v7action.GetServiceAppBindingParams) (resources.ServiceCredentialBinding, v7action.Warnings, error) {
+	fake.getServiceAppBindingMutex.Lock()
+	ret, specificReturn := fake.getServiceAppBindingReturnsOnCall[len(fake.getServiceAppBindingArgsForCall)]
+	fake.getServiceAppBindingArgsForCall = append(fake.getServiceAppBindingArgsForCall, struct {
+		arg1 v7action.GetServiceAppBindingParams
+	}{arg1})
+	fake.recordInvocation("GetServiceAppBinding", []interface{}{arg1})
+	fake.getServiceAppBindingMutex.Unlock()
+	if fake.GetServiceAppBindingStub != nil {
+		return fake.GetServiceAppBindingStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceAppBindingReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceAppBindingCallCount() int {
+	fake.getServiceAppBindingMutex.RLock()
+	defer fake.getServiceAppBindingMutex.RUnlock()
+	return len(fake.getServiceAppBindingArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceAppBindingCalls(stub func(v7action.GetServiceAppBindingParams) (resources.ServiceCredentialBinding, v7action.Warnings, error)) {
+	fake.getServiceAppBindingMutex.Lock()
+	defer fake.getServiceAppBindingMutex.Unlock()
+	fake.GetServiceAppBindingStub = stub
+}
+
+func (fake *FakeActor) GetServiceAppBindingArgsForCall(i int) v7action.GetServiceAppBindingParams {
+	fake.getServiceAppBindingMutex.RLock()
+	defer fake.getServiceAppBindingMutex.RUnlock()
+	argsForCall := fake.getServiceAppBindingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceAppBindingReturns(result1 resources.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAppBindingMutex.Lock()
+	defer fake.getServiceAppBindingMutex.Unlock()
+	fake.GetServiceAppBindingStub = nil
+	fake.getServiceAppBindingReturns = struct {
+		result1 resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceAppBindingReturnsOnCall(i int, result1 resources.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAppBindingMutex.Lock()
+	defer fake.getServiceAppBindingMutex.Unlock()
+	fake.GetServiceAppBindingStub = nil
+	if fake.getServiceAppBindingReturnsOnCall == nil {
+		fake.getServiceAppBindingReturnsOnCall = make(map[int]struct {
+			result1 resources.ServiceCredentialBinding
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceAppBindingReturnsOnCall[i] = struct {
+		result1 resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceBrokerByName(arg1 string) (resources.ServiceBroker, v7action.Warnings, error) {
 	fake.getServiceBrokerByNameMutex.Lock()
 	ret, specificReturn := fake.getServiceBrokerByNameReturnsOnCall[len(fake.getServiceBrokerByNameArgsForCall)]
@@ -12161,6 +13966,72 @@ func (fake *FakeActor) GetServiceBrokerByNameReturnsOnCall(i int, result1 resour
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceBrokerCatalog(arg1 string) (v7action.ServiceBrokerCatalog, v7action.Warnings, error) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokerCatalogReturnsOnCall[len(fake.getServiceBrokerCatalogArgsForCall)]
+	fake.getServiceBrokerCatalogArgsForCall = append(fake.getServiceBrokerCatalogArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceBrokerCatalog", []interface{}{arg1})
+	fake.getServiceBrokerCatalogMutex.Unlock()
+	if fake.GetServiceBrokerCatalogStub != nil {
+		return fake.GetServiceBrokerCatalogStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceBrokerCatalogReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogCallCount() int {
+	fake.getServiceBrokerCatalogMutex.RLock()
+	defer fake.getServiceBrokerCatalogMutex.RUnlock()
+	return len(fake.getServiceBrokerCatalogArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogCalls(stub func(string) (v7action.ServiceBrokerCatalog, v7action.Warnings, error)) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	defer fake.getServiceBrokerCatalogMutex.Unlock()
+	fake.GetServiceBrokerCatalogStub = stub
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogArgsForCall(i int) string {
+	fake.getServiceBrokerCatalogMutex.RLock()
+	defer fake.getServiceBrokerCatalogMutex.RUnlock()
+	argsForCall := fake.getServiceBrokerCatalogArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogReturns(result1 v7action.ServiceBrokerCatalog, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	defer fake.getServiceBrokerCatalogMutex.Unlock()
+	fake.GetServiceBrokerCatalogStub = nil
+	fake.getServiceBrokerCatalogReturns = struct {
+		result1 v7action.ServiceBrokerCatalog
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogReturnsOnCall(i int, result1 v7action.ServiceBrokerCatalog, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	defer fake.getServiceBrokerCatalogMutex.Unlock()
+	fake.GetServiceBrokerCatalogStub = nil
+	if fake.getServiceBrokerCatalogReturnsOnCall == nil {
+		fake.getServiceBrokerCatalogReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceBrokerCatalog
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBrokerCatalogReturnsOnCall[i] = struct {
+		result1 v7action.ServiceBrokerCatalog
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceBrokerLabels(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getServiceBrokerLabelsMutex.Lock()
 	ret, specificReturn := fake.getServiceBrokerLabelsReturnsOnCall[len(fake.getServiceBrokerLabelsArgsForCall)]
@@ -12554,6 +14425,139 @@ func (fake *FakeActor) GetServiceInstanceParametersReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceInstanceSharedSpacesUsage(arg1 string, arg2 string) (v7action.ServiceInstanceSharedSpaces, v7action.Warnings, error) {
+	fake.getServiceInstanceSharedSpacesUsageMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSharedSpacesUsageReturnsOnCall[len(fake.getServiceInstanceSharedSpacesUsageArgsForCall)]
+	fake.getServiceInstanceSharedSpacesUsageArgsForCall = append(fake.getServiceInstanceSharedSpacesUsageArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetServiceInstanceSharedSpacesUsage", []interface{}{arg1, arg2})
+	fake.getServiceInstanceSharedSpacesUsageMutex.Unlock()
+	if fake.GetServiceInstanceSharedSpacesUsageStub != nil {
+		return fake.GetServiceInstanceSharedSpacesUsageStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstanceSharedSpacesUsageReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstanceSharedSpacesUsageCallCount() int {
+	fake.getServiceInstanceSharedSpacesUsageMutex.RLock()
+	defer fake.getServiceInstanceSharedSpacesUsageMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedSpacesUsageArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstanceSharedSpacesUsageCalls(stub func(string, string) (v7action.ServiceInstanceSharedSpaces, v7action.Warnings, error)) {
+	fake.getServiceInstanceSharedSpacesUsageMutex.Lock()
+	defer fake.getServiceInstanceSharedSpacesUsageMutex.Unlock()
+	fake.GetServiceInstanceSharedSpacesUsageStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstanceSharedSpacesUsageArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceSharedSpacesUsageMutex.RLock()
+	defer fake.getServiceInstanceSharedSpacesUsageMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceSharedSpacesUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceInstanceSharedSpacesUsageReturns(result1 v7action.ServiceInstanceSharedSpaces, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceSharedSpacesUsageMutex.Lock()
+	defer fake.getServiceInstanceSharedSpacesUsageMutex.Unlock()
+	fake.GetServiceInstanceSharedSpacesUsageStub = nil
+	fake.getServiceInstanceSharedSpacesUsageReturns = struct {
+		result1 v7action.ServiceInstanceSharedSpaces
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceSharedSpacesUsageReturnsOnCall(i int, result1 v7action.ServiceInstanceSharedSpaces, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceSharedSpacesUsageMutex.Lock()
+	defer fake.getServiceInstanceSharedSpacesUsageMutex.Unlock()
+	fake.GetServiceInstanceSharedSpacesUsageStub = nil
+	if fake.getServiceInstanceSharedSpacesUsageReturnsOnCall == nil {
+		fake.getServiceInstanceSharedSpacesUsageReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceInstanceSharedSpaces
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSharedSpacesUsageReturnsOnCall[i] = struct {
+		result1 v7action.ServiceInstanceSharedSpaces
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceSharesForOrganization(arg1 string) ([]v7action.ServiceInstanceShare, v7action.Warnings, error) {
+	fake.getServiceInstanceSharesForOrganizationMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSharesForOrganizationReturnsOnCall[len(fake.getServiceInstanceSharesForOrganizationArgsForCall)]
+	fake.getServiceInstanceSharesForOrganizationArgsForCall = append(fake.getServiceInstanceSharesForOrganizationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceInstanceSharesForOrganization", []interface{}{arg1})
+	fake.getServiceInstanceSharesForOrganizationMutex.Unlock()
+	if fake.GetServiceInstanceSharesForOrganizationStub != nil {
+		return fake.GetServiceInstanceSharesForOrganizationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstanceSharesForOrganizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstanceSharesForOrganizationCallCount() int {
+	fake.getServiceInstanceSharesForOrganizationMutex.RLock()
+	defer fake.getServiceInstanceSharesForOrganizationMutex.RUnlock()
+	return len(fake.getServiceInstanceSharesForOrganizationArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstanceSharesForOrganizationCalls(stub func(string) ([]v7action.ServiceInstanceShare, v7action.Warnings, error)) {
+	fake.getServiceInstanceSharesForOrganizationMutex.Lock()
+	defer fake.getServiceInstanceSharesForOrganizationMutex.Unlock()
+	fake.GetServiceInstanceSharesForOrganizationStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstanceSharesForOrganizationArgsForCall(i int) string {
+	fake.getServiceInstanceSharesForOrganizationMutex.RLock()
+	defer fake.getServiceInstanceSharesForOrganizationMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceSharesForOrganizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceInstanceSharesForOrganizationReturns(result1 []v7action.ServiceInstanceShare, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceSharesForOrganizationMutex.Lock()
+	defer fake.getServiceInstanceSharesForOrganizationMutex.Unlock()
+	fake.GetServiceInstanceSharesForOrganizationStub = nil
+	fake.getServiceInstanceSharesForOrganizationReturns = struct {
+		result1 []v7action.ServiceInstanceShare
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceSharesForOrganizationReturnsOnCall(i int, result1 []v7action.ServiceInstanceShare, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceSharesForOrganizationMutex.Lock()
+	defer fake.getServiceInstanceSharesForOrganizationMutex.Unlock()
+	fake.GetServiceInstanceSharesForOrganizationStub = nil
+	if fake.getServiceInstanceSharesForOrganizationReturnsOnCall == nil {
+		fake.getServiceInstanceSharesForOrganizationReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceInstanceShare
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSharesForOrganizationReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceInstanceShare
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceInstancesForSpace(arg1 string, arg2 bool) ([]v7action.ServiceInstance, v7action.Warnings, error) {
 	fake.getServiceInstancesForSpaceMutex.Lock()
 	ret, specificReturn := fake.getServiceInstancesForSpaceReturnsOnCall[len(fake.getServiceInstancesForSpaceArgsForCall)]
@@ -12621,6 +14625,138 @@ func (fake *FakeActor) GetServiceInstancesForSpaceReturnsOnCall(i int, result1 [
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceInstancesForUpgrade(arg1 v7action.ServiceInstancesForUpgradeParams) ([]v7action.ServiceInstanceForUpgrade, v7action.Warnings, error) {
+	fake.getServiceInstancesForUpgradeMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesForUpgradeReturnsOnCall[len(fake.getServiceInstancesForUpgradeArgsForCall)]
+	fake.getServiceInstancesForUpgradeArgsForCall = append(fake.getServiceInstancesForUpgradeArgsForCall, struct {
+		arg1 v7action.ServiceInstancesForUpgradeParams
+	}{arg1})
+	fake.recordInvocation("GetServiceInstancesForUpgrade", []interface{}{arg1})
+	fake.getServiceInstancesForUpgradeMutex.Unlock()
+	if fake.GetServiceInstancesForUpgradeStub != nil {
+		return fake.GetServiceInstancesForUpgradeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstancesForUpgradeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstancesForUpgradeCallCount() int {
+	fake.getServiceInstancesForUpgradeMutex.RLock()
+	defer fake.getServiceInstancesForUpgradeMutex.RUnlock()
+	return len(fake.getServiceInstancesForUpgradeArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstancesForUpgradeCalls(stub func(v7action.ServiceInstancesForUpgradeParams) ([]v7action.ServiceInstanceForUpgrade, v7action.Warnings, error)) {
+	fake.getServiceInstancesForUpgradeMutex.Lock()
+	defer fake.getServiceInstancesForUpgradeMutex.Unlock()
+	fake.GetServiceInstancesForUpgradeStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstancesForUpgradeArgsForCall(i int) v7action.ServiceInstancesForUpgradeParams {
+	fake.getServiceInstancesForUpgradeMutex.RLock()
+	defer fake.getServiceInstancesForUpgradeMutex.RUnlock()
+	argsForCall := fake.getServiceInstancesForUpgradeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceInstancesForUpgradeReturns(result1 []v7action.ServiceInstanceForUpgrade, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstancesForUpgradeMutex.Lock()
+	defer fake.getServiceInstancesForUpgradeMutex.Unlock()
+	fake.GetServiceInstancesForUpgradeStub = nil
+	fake.getServiceInstancesForUpgradeReturns = struct {
+		result1 []v7action.ServiceInstanceForUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstancesForUpgradeReturnsOnCall(i int, result1 []v7action.ServiceInstanceForUpgrade, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstancesForUpgradeMutex.Lock()
+	defer fake.getServiceInstancesForUpgradeMutex.Unlock()
+	fake.GetServiceInstancesForUpgradeStub = nil
+	if fake.getServiceInstancesForUpgradeReturnsOnCall == nil {
+		fake.getServiceInstancesForUpgradeReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceInstanceForUpgrade
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesForUpgradeReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceInstanceForUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstancesWithPendingUpgrades(arg1 v7action.PendingUpgradesParams) ([]v7action.ServiceInstanceWithPendingUpgrade, v7action.Warnings, error) {
+	fake.getServiceInstancesWithPendingUpgradesMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesWithPendingUpgradesReturnsOnCall[len(fake.getServiceInstancesWithPendingUpgradesArgsForCall)]
+	fake.getServiceInstancesWithPendingUpgradesArgsForCall = append(fake.getServiceInstancesWithPendingUpgradesArgsForCall, struct {
+		arg1 v7action.PendingUpgradesParams
+	}{arg1})
+	fake.recordInvocation("GetServiceInstancesWithPendingUpgrades", []interface{}{arg1})
+	fake.getServiceInstancesWithPendingUpgradesMutex.Unlock()
+	if fake.GetServiceInstancesWithPendingUpgradesStub != nil {
+		return fake.GetServiceInstancesWithPendingUpgradesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstancesWithPendingUpgradesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstancesWithPendingUpgradesCallCount() int {
+	fake.getServiceInstancesWithPendingUpgradesMutex.RLock()
+	defer fake.getServiceInstancesWithPendingUpgradesMutex.RUnlock()
+	return len(fake.getServiceInstancesWithPendingUpgradesArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstancesWithPendingUpgradesCalls(stub func(v7action.PendingUpgradesParams) ([]v7action.ServiceInstanceWithPendingUpgrade, v7action.Warnings, error)) {
+	fake.getServiceInstancesWithPendingUpgradesMutex.Lock()
+	defer fake.getServiceInstancesWithPendingUpgradesMutex.Unlock()
+	fake.GetServiceInstancesWithPendingUpgradesStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstancesWithPendingUpgradesArgsForCall(i int) v7action.PendingUpgradesParams {
+	fake.getServiceInstancesWithPendingUpgradesMutex.RLock()
+	defer fake.getServiceInstancesWithPendingUpgradesMutex.RUnlock()
+	argsForCall := fake.getServiceInstancesWithPendingUpgradesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceInstancesWithPendingUpgradesReturns(result1 []v7action.ServiceInstanceWithPendingUpgrade, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstancesWithPendingUpgradesMutex.Lock()
+	defer fake.getServiceInstancesWithPendingUpgradesMutex.Unlock()
+	fake.GetServiceInstancesWithPendingUpgradesStub = nil
+	fake.getServiceInstancesWithPendingUpgradesReturns = struct {
+		result1 []v7action.ServiceInstanceWithPendingUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstancesWithPendingUpgradesReturnsOnCall(i int, result1 []v7action.ServiceInstanceWithPendingUpgrade, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstancesWithPendingUpgradesMutex.Lock()
+	defer fake.getServiceInstancesWithPendingUpgradesMutex.Unlock()
+	fake.GetServiceInstancesWithPendingUpgradesStub = nil
+	if fake.getServiceInstancesWithPendingUpgradesReturnsOnCall == nil {
+		fake.getServiceInstancesWithPendingUpgradesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceInstanceWithPendingUpgrade
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesWithPendingUpgradesReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceInstanceWithPendingUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceKeyByServiceInstanceAndName(arg1 string, arg2 string, arg3 string) (resources.ServiceCredentialBinding, v7action.Warnings, error) {
 	fake.getServiceKeyByServiceInstanceAndNameMutex.Lock()
 	ret, specificReturn := fake.getServiceKeyByServiceInstanceAndNameReturnsOnCall[len(fake.getServiceKeyByServiceInstanceAndNameArgsForCall)]
@@ -12757,6 +14893,74 @@ func (fake *FakeActor) GetServiceKeyDetailsByServiceInstanceAndNameReturnsOnCall
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceKeyRotation(arg1 string, arg2 string, arg3 string) (v7action.ServiceKeyRotation, v7action.Warnings, error) {
+	fake.getServiceKeyRotationMutex.Lock()
+	ret, specificReturn := fake.getServiceKeyRotationReturnsOnCall[len(fake.getServiceKeyRotationArgsForCall)]
+	fake.getServiceKeyRotationArgsForCall = append(fake.getServiceKeyRotationArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetServiceKeyRotation", []interface{}{arg1, arg2, arg3})
+	fake.getServiceKeyRotationMutex.Unlock()
+	if fake.GetServiceKeyRotationStub != nil {
+		return fake.GetServiceKeyRotationStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceKeyRotationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceKeyRotationCallCount() int {
+	fake.getServiceKeyRotationMutex.RLock()
+	defer fake.getServiceKeyRotationMutex.RUnlock()
+	return len(fake.getServiceKeyRotationArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceKeyRotationCalls(stub func(string, string, string) (v7action.ServiceKeyRotation, v7action.Warnings, error)) {
+	fake.getServiceKeyRotationMutex.Lock()
+	defer fake.getServiceKeyRotationMutex.Unlock()
+	fake.GetServiceKeyRotationStub = stub
+}
+
+func (fake *FakeActor) GetServiceKeyRotationArgsForCall(i int) (string, string, string) {
+	fake.getServiceKeyRotationMutex.RLock()
+	defer fake.getServiceKeyRotationMutex.RUnlock()
+	argsForCall := fake.getServiceKeyRotationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetServiceKeyRotationReturns(result1 v7action.ServiceKeyRotation, result2 v7action.Warnings, result3 error) {
+	fake.getServiceKeyRotationMutex.Lock()
+	defer fake.getServiceKeyRotationMutex.Unlock()
+	fake.GetServiceKeyRotationStub = nil
+	fake.getServiceKeyRotationReturns = struct {
+		result1 v7action.ServiceKeyRotation
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceKeyRotationReturnsOnCall(i int, result1 v7action.ServiceKeyRotation, result2 v7action.Warnings, result3 error) {
+	fake.getServiceKeyRotationMutex.Lock()
+	defer fake.getServiceKeyRotationMutex.Unlock()
+	fake.GetServiceKeyRotationStub = nil
+	if fake.getServiceKeyRotationReturnsOnCall == nil {
+		fake.getServiceKeyRotationReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceKeyRotation
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeyRotationReturnsOnCall[i] = struct {
+		result1 v7action.ServiceKeyRotation
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceKeysByServiceInstance(arg1 string, arg2 string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error) {
 	fake.getServiceKeysByServiceInstanceMutex.Lock()
 	ret, specificReturn := fake.getServiceKeysByServiceInstanceReturnsOnCall[len(fake.getServiceKeysByServiceInstanceArgsForCall)]
@@ -13693,6 +15897,78 @@ func (fake *FakeActor) GetStacksReturnsOnCall(i int, result1 []resources.Stack,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetStaleRoutes(arg1 []resources.Route, arg2 time.Time) ([]v7action.StaleRoute, v7action.Warnings, error) {
+	var arg1Copy []resources.Route
+	if arg1 != nil {
+		arg1Copy = make([]resources.Route, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getStaleRoutesMutex.Lock()
+	ret, specificReturn := fake.getStaleRoutesReturnsOnCall[len(fake.getStaleRoutesArgsForCall)]
+	fake.getStaleRoutesArgsForCall = append(fake.getStaleRoutesArgsForCall, struct {
+		arg1 []resources.Route
+		arg2 time.Time
+	}{arg1Copy, arg2})
+	fake.recordInvocation("GetStaleRoutes", []interface{}{arg1Copy, arg2})
+	fake.getStaleRoutesMutex.Unlock()
+	if fake.GetStaleRoutesStub != nil {
+		return fake.GetStaleRoutesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getStaleRoutesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStaleRoutesCallCount() int {
+	fake.getStaleRoutesMutex.RLock()
+	defer fake.getStaleRoutesMutex.RUnlock()
+	return len(fake.getStaleRoutesArgsForCall)
+}
+
+func (fake *FakeActor) GetStaleRoutesCalls(stub func([]resources.Route, time.Time) ([]v7action.StaleRoute, v7action.Warnings, error)) {
+	fake.getStaleRoutesMutex.Lock()
+	defer fake.getStaleRoutesMutex.Unlock()
+	fake.GetStaleRoutesStub = stub
+}
+
+func (fake *FakeActor) GetStaleRoutesArgsForCall(i int) ([]resources.Route, time.Time) {
+	fake.getStaleRoutesMutex.RLock()
+	defer fake.getStaleRoutesMutex.RUnlock()
+	argsForCall := fake.getStaleRoutesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetStaleRoutesReturns(result1 []v7action.StaleRoute, result2 v7action.Warnings, result3 error) {
+	fake.getStaleRoutesMutex.Lock()
+	defer fake.getStaleRoutesMutex.Unlock()
+	fake.GetStaleRoutesStub = nil
+	fake.getStaleRoutesReturns = struct {
+		result1 []v7action.StaleRoute
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStaleRoutesReturnsOnCall(i int, result1 []v7action.StaleRoute, result2 v7action.Warnings, result3 error) {
+	fake.getStaleRoutesMutex.Lock()
+	defer fake.getStaleRoutesMutex.Unlock()
+	fake.GetStaleRoutesStub = nil
+	if fake.getStaleRoutesReturnsOnCall == nil {
+		fake.getStaleRoutesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.StaleRoute
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getStaleRoutesReturnsOnCall[i] = struct {
+		result1 []v7action.StaleRoute
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -13719,52 +15995,120 @@ func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceCallCount() i
 	return len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
-	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = stub
+func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = nil
+	fake.getStreamingLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan sharedaction.LogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+			result4 v7action.Warnings
+			result5 error
+		})
+	}
+	fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetStreamingLogsForTask(arg1 string, arg2 resources.Task, arg3 sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForTaskReturnsOnCall[len(fake.getStreamingLogsForTaskArgsForCall)]
+	fake.getStreamingLogsForTaskArgsForCall = append(fake.getStreamingLogsForTaskArgsForCall, struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetStreamingLogsForTask", []interface{}{arg1, arg2, arg3})
+	fake.getStreamingLogsForTaskMutex.Unlock()
+	if fake.GetStreamingLogsForTaskStub != nil {
+		return fake.GetStreamingLogsForTaskStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getStreamingLogsForTaskReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskCallCount() int {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	return len(fake.getStreamingLogsForTaskArgsForCall)
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskCalls(stub func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc)) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = stub
 }
 
-func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient) {
-	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i]
+func (fake *FakeActor) GetStreamingLogsForTaskArgsForCall(i int) (string, resources.Task, sharedaction.LogCacheClient) {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForTaskArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
-	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = nil
-	fake.getStreamingLogsForApplicationByNameAndSpaceReturns = struct {
+func (fake *FakeActor) GetStreamingLogsForTaskReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = nil
+	fake.getStreamingLogsForTaskReturns = struct {
 		result1 <-chan sharedaction.LogMessage
 		result2 <-chan error
 		result3 context.CancelFunc
-		result4 v7action.Warnings
-		result5 error
-	}{result1, result2, result3, result4, result5}
+	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
-	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = nil
-	if fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
-		fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+func (fake *FakeActor) GetStreamingLogsForTaskReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = nil
+	if fake.getStreamingLogsForTaskReturnsOnCall == nil {
+		fake.getStreamingLogsForTaskReturnsOnCall = make(map[int]struct {
 			result1 <-chan sharedaction.LogMessage
 			result2 <-chan error
 			result3 context.CancelFunc
-			result4 v7action.Warnings
-			result5 error
 		})
 	}
-	fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+	fake.getStreamingLogsForTaskReturnsOnCall[i] = struct {
 		result1 <-chan sharedaction.LogMessage
 		result2 <-chan error
 		result3 context.CancelFunc
-		result4 v7action.Warnings
-		result5 error
-	}{result1, result2, result3, result4, result5}
+	}{result1, result2, result3}
 }
 
 func (fake *FakeActor) GetTaskBySequenceIDAndApplication(arg1 int, arg2 string) (resources.Task, v7action.Warnings, error) {
@@ -14019,6 +16363,69 @@ func (fake *FakeActor) GetUserReturnsOnCall(i int, result1 resources.User, resul
 	}{result1, result2}
 }
 
+func (fake *FakeActor) LintSecurityGroupRules(arg1 string) (v7action.Warnings, error) {
+	fake.lintSecurityGroupRulesMutex.Lock()
+	ret, specificReturn := fake.lintSecurityGroupRulesReturnsOnCall[len(fake.lintSecurityGroupRulesArgsForCall)]
+	fake.lintSecurityGroupRulesArgsForCall = append(fake.lintSecurityGroupRulesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("LintSecurityGroupRules", []interface{}{arg1})
+	fake.lintSecurityGroupRulesMutex.Unlock()
+	if fake.LintSecurityGroupRulesStub != nil {
+		return fake.LintSecurityGroupRulesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.lintSecurityGroupRulesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) LintSecurityGroupRulesCallCount() int {
+	fake.lintSecurityGroupRulesMutex.RLock()
+	defer fake.lintSecurityGroupRulesMutex.RUnlock()
+	return len(fake.lintSecurityGroupRulesArgsForCall)
+}
+
+func (fake *FakeActor) LintSecurityGroupRulesCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.lintSecurityGroupRulesMutex.Lock()
+	defer fake.lintSecurityGroupRulesMutex.Unlock()
+	fake.LintSecurityGroupRulesStub = stub
+}
+
+func (fake *FakeActor) LintSecurityGroupRulesArgsForCall(i int) string {
+	fake.lintSecurityGroupRulesMutex.RLock()
+	defer fake.lintSecurityGroupRulesMutex.RUnlock()
+	argsForCall := fake.lintSecurityGroupRulesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) LintSecurityGroupRulesReturns(result1 v7action.Warnings, result2 error) {
+	fake.lintSecurityGroupRulesMutex.Lock()
+	defer fake.lintSecurityGroupRulesMutex.Unlock()
+	fake.LintSecurityGroupRulesStub = nil
+	fake.lintSecurityGroupRulesReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) LintSecurityGroupRulesReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.lintSecurityGroupRulesMutex.Lock()
+	defer fake.lintSecurityGroupRulesMutex.Unlock()
+	fake.LintSecurityGroupRulesStub = nil
+	if fake.lintSecurityGroupRulesReturnsOnCall == nil {
+		fake.lintSecurityGroupRulesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.lintSecurityGroupRulesReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) MakeCurlRequest(arg1 string, arg2 string, arg3 []string, arg4 string, arg5 bool) ([]byte, *http.Response, error) {
 	var arg3Copy []string
 	if arg3 != nil {
@@ -14352,6 +16759,78 @@ func (fake *FakeActor) ParseAccessTokenReturnsOnCall(i int, result1 jwt.JWT, res
 	}{result1, result2}
 }
 
+func (fake *FakeActor) PlanSecurityGroups(arg1 []v7action.SecurityGroupDefinition, arg2 bool) ([]v7action.SecurityGroupChange, v7action.Warnings, error) {
+	var arg1Copy []v7action.SecurityGroupDefinition
+	if arg1 != nil {
+		arg1Copy = make([]v7action.SecurityGroupDefinition, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.planSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.planSecurityGroupsReturnsOnCall[len(fake.planSecurityGroupsArgsForCall)]
+	fake.planSecurityGroupsArgsForCall = append(fake.planSecurityGroupsArgsForCall, struct {
+		arg1 []v7action.SecurityGroupDefinition
+		arg2 bool
+	}{arg1Copy, arg2})
+	fake.recordInvocation("PlanSecurityGroups", []interface{}{arg1Copy, arg2})
+	fake.planSecurityGroupsMutex.Unlock()
+	if fake.PlanSecurityGroupsStub != nil {
+		return fake.PlanSecurityGroupsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.planSecurityGroupsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) PlanSecurityGroupsCallCount() int {
+	fake.planSecurityGroupsMutex.RLock()
+	defer fake.planSecurityGroupsMutex.RUnlock()
+	return len(fake.planSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeActor) PlanSecurityGroupsCalls(stub func([]v7action.SecurityGroupDefinition, bool) ([]v7action.SecurityGroupChange, v7action.Warnings, error)) {
+	fake.planSecurityGroupsMutex.Lock()
+	defer fake.planSecurityGroupsMutex.Unlock()
+	fake.PlanSecurityGroupsStub = stub
+}
+
+func (fake *FakeActor) PlanSecurityGroupsArgsForCall(i int) ([]v7action.SecurityGroupDefinition, bool) {
+	fake.planSecurityGroupsMutex.RLock()
+	defer fake.planSecurityGroupsMutex.RUnlock()
+	argsForCall := fake.planSecurityGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) PlanSecurityGroupsReturns(result1 []v7action.SecurityGroupChange, result2 v7action.Warnings, result3 error) {
+	fake.planSecurityGroupsMutex.Lock()
+	defer fake.planSecurityGroupsMutex.Unlock()
+	fake.PlanSecurityGroupsStub = nil
+	fake.planSecurityGroupsReturns = struct {
+		result1 []v7action.SecurityGroupChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) PlanSecurityGroupsReturnsOnCall(i int, result1 []v7action.SecurityGroupChange, result2 v7action.Warnings, result3 error) {
+	fake.planSecurityGroupsMutex.Lock()
+	defer fake.planSecurityGroupsMutex.Unlock()
+	fake.PlanSecurityGroupsStub = nil
+	if fake.planSecurityGroupsReturnsOnCall == nil {
+		fake.planSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.SecurityGroupChange
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.planSecurityGroupsReturnsOnCall[i] = struct {
+		result1 []v7action.SecurityGroupChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) PollBuild(arg1 string, arg2 string) (resources.Droplet, v7action.Warnings, error) {
 	fake.pollBuildMutex.Lock()
 	ret, specificReturn := fake.pollBuildReturnsOnCall[len(fake.pollBuildArgsForCall)]
@@ -15261,6 +17740,78 @@ func (fake *FakeActor) RenameSpaceByNameAndOrganizationGUIDReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) ReplaceRouteDestinations(arg1 string, arg2 []resources.RouteDestination) ([]resources.RouteDestination, v7action.Warnings, error) {
+	var arg2Copy []resources.RouteDestination
+	if arg2 != nil {
+		arg2Copy = make([]resources.RouteDestination, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.replaceRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.replaceRouteDestinationsReturnsOnCall[len(fake.replaceRouteDestinationsArgsForCall)]
+	fake.replaceRouteDestinationsArgsForCall = append(fake.replaceRouteDestinationsArgsForCall, struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}{arg1, arg2Copy})
+	fake.recordInvocation("ReplaceRouteDestinations", []interface{}{arg1, arg2Copy})
+	fake.replaceRouteDestinationsMutex.Unlock()
+	if fake.ReplaceRouteDestinationsStub != nil {
+		return fake.ReplaceRouteDestinationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.replaceRouteDestinationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) ReplaceRouteDestinationsCallCount() int {
+	fake.replaceRouteDestinationsMutex.RLock()
+	defer fake.replaceRouteDestinationsMutex.RUnlock()
+	return len(fake.replaceRouteDestinationsArgsForCall)
+}
+
+func (fake *FakeActor) ReplaceRouteDestinationsCalls(stub func(string, []resources.RouteDestination) ([]resources.RouteDestination, v7action.Warnings, error)) {
+	fake.replaceRouteDestinationsMutex.Lock()
+	defer fake.replaceRouteDestinationsMutex.Unlock()
+	fake.ReplaceRouteDestinationsStub = stub
+}
+
+func (fake *FakeActor) ReplaceRouteDestinationsArgsForCall(i int) (string, []resources.RouteDestination) {
+	fake.replaceRouteDestinationsMutex.RLock()
+	defer fake.replaceRouteDestinationsMutex.RUnlock()
+	argsForCall := fake.replaceRouteDestinationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) ReplaceRouteDestinationsReturns(result1 []resources.RouteDestination, result2 v7action.Warnings, result3 error) {
+	fake.replaceRouteDestinationsMutex.Lock()
+	defer fake.replaceRouteDestinationsMutex.Unlock()
+	fake.ReplaceRouteDestinationsStub = nil
+	fake.replaceRouteDestinationsReturns = struct {
+		result1 []resources.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) ReplaceRouteDestinationsReturnsOnCall(i int, result1 []resources.RouteDestination, result2 v7action.Warnings, result3 error) {
+	fake.replaceRouteDestinationsMutex.Lock()
+	defer fake.replaceRouteDestinationsMutex.Unlock()
+	fake.ReplaceRouteDestinationsStub = nil
+	if fake.replaceRouteDestinationsReturnsOnCall == nil {
+		fake.replaceRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 []resources.RouteDestination
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.replaceRouteDestinationsReturnsOnCall[i] = struct {
+		result1 []resources.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) ResetOrganizationDefaultIsolationSegment(arg1 string) (v7action.Warnings, error) {
 	fake.resetOrganizationDefaultIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.resetOrganizationDefaultIsolationSegmentReturnsOnCall[len(fake.resetOrganizationDefaultIsolationSegmentArgsForCall)]
@@ -16236,6 +18787,78 @@ func (fake *FakeActor) SetOrganizationDefaultIsolationSegmentReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeActor) SetRouteWeights(arg1 resources.Route, arg2 []v7action.RouteWeight) ([]resources.RouteDestination, v7action.Warnings, error) {
+	var arg2Copy []v7action.RouteWeight
+	if arg2 != nil {
+		arg2Copy = make([]v7action.RouteWeight, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.setRouteWeightsMutex.Lock()
+	ret, specificReturn := fake.setRouteWeightsReturnsOnCall[len(fake.setRouteWeightsArgsForCall)]
+	fake.setRouteWeightsArgsForCall = append(fake.setRouteWeightsArgsForCall, struct {
+		arg1 resources.Route
+		arg2 []v7action.RouteWeight
+	}{arg1, arg2Copy})
+	fake.recordInvocation("SetRouteWeights", []interface{}{arg1, arg2Copy})
+	fake.setRouteWeightsMutex.Unlock()
+	if fake.SetRouteWeightsStub != nil {
+		return fake.SetRouteWeightsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.setRouteWeightsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) SetRouteWeightsCallCount() int {
+	fake.setRouteWeightsMutex.RLock()
+	defer fake.setRouteWeightsMutex.RUnlock()
+	return len(fake.setRouteWeightsArgsForCall)
+}
+
+func (fake *FakeActor) SetRouteWeightsCalls(stub func(resources.Route, []v7action.RouteWeight) ([]resources.RouteDestination, v7action.Warnings, error)) {
+	fake.setRouteWeightsMutex.Lock()
+	defer fake.setRouteWeightsMutex.Unlock()
+	fake.SetRouteWeightsStub = stub
+}
+
+func (fake *FakeActor) SetRouteWeightsArgsForCall(i int) (resources.Route, []v7action.RouteWeight) {
+	fake.setRouteWeightsMutex.RLock()
+	defer fake.setRouteWeightsMutex.RUnlock()
+	argsForCall := fake.setRouteWeightsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) SetRouteWeightsReturns(result1 []resources.RouteDestination, result2 v7action.Warnings, result3 error) {
+	fake.setRouteWeightsMutex.Lock()
+	defer fake.setRouteWeightsMutex.Unlock()
+	fake.SetRouteWeightsStub = nil
+	fake.setRouteWeightsReturns = struct {
+		result1 []resources.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) SetRouteWeightsReturnsOnCall(i int, result1 []resources.RouteDestination, result2 v7action.Warnings, result3 error) {
+	fake.setRouteWeightsMutex.Lock()
+	defer fake.setRouteWeightsMutex.Unlock()
+	fake.SetRouteWeightsStub = nil
+	if fake.setRouteWeightsReturnsOnCall == nil {
+		fake.setRouteWeightsReturnsOnCall = make(map[int]struct {
+			result1 []resources.RouteDestination
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.setRouteWeightsReturnsOnCall[i] = struct {
+		result1 []resources.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) SetSpaceManifest(arg1 string, arg2 []byte) (v7action.Warnings, error) {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -17342,6 +19965,75 @@ func (fake *FakeActor) UnshareServiceInstanceFromSpaceAndOrgReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UnshareServiceInstanceFromSpaces(arg1 string, arg2 []string) (v7action.Warnings, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.unshareServiceInstanceFromSpacesMutex.Lock()
+	ret, specificReturn := fake.unshareServiceInstanceFromSpacesReturnsOnCall[len(fake.unshareServiceInstanceFromSpacesArgsForCall)]
+	fake.unshareServiceInstanceFromSpacesArgsForCall = append(fake.unshareServiceInstanceFromSpacesArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("UnshareServiceInstanceFromSpaces", []interface{}{arg1, arg2Copy})
+	fake.unshareServiceInstanceFromSpacesMutex.Unlock()
+	if fake.UnshareServiceInstanceFromSpacesStub != nil {
+		return fake.UnshareServiceInstanceFromSpacesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unshareServiceInstanceFromSpacesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromSpacesCallCount() int {
+	fake.unshareServiceInstanceFromSpacesMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpacesMutex.RUnlock()
+	return len(fake.unshareServiceInstanceFromSpacesArgsForCall)
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromSpacesCalls(stub func(string, []string) (v7action.Warnings, error)) {
+	fake.unshareServiceInstanceFromSpacesMutex.Lock()
+	defer fake.unshareServiceInstanceFromSpacesMutex.Unlock()
+	fake.UnshareServiceInstanceFromSpacesStub = stub
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromSpacesArgsForCall(i int) (string, []string) {
+	fake.unshareServiceInstanceFromSpacesMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpacesMutex.RUnlock()
+	argsForCall := fake.unshareServiceInstanceFromSpacesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromSpacesReturns(result1 v7action.Warnings, result2 error) {
+	fake.unshareServiceInstanceFromSpacesMutex.Lock()
+	defer fake.unshareServiceInstanceFromSpacesMutex.Unlock()
+	fake.UnshareServiceInstanceFromSpacesStub = nil
+	fake.unshareServiceInstanceFromSpacesReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromSpacesReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.unshareServiceInstanceFromSpacesMutex.Lock()
+	defer fake.unshareServiceInstanceFromSpacesMutex.Unlock()
+	fake.UnshareServiceInstanceFromSpacesStub = nil
+	if fake.unshareServiceInstanceFromSpacesReturnsOnCall == nil {
+		fake.unshareServiceInstanceFromSpacesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.unshareServiceInstanceFromSpacesReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateAppFeature(arg1 resources.Application, arg2 bool, arg3 string) (v7action.Warnings, error) {
 	fake.updateAppFeatureMutex.Lock()
 	ret, specificReturn := fake.updateAppFeatureReturnsOnCall[len(fake.updateAppFeatureArgsForCall)]
@@ -17538,6 +20230,74 @@ func (fake *FakeActor) UpdateApplicationLabelsByApplicationNameReturnsOnCall(i i
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateApplicationSidecar(arg1 string, arg2 string, arg3 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.updateApplicationSidecarReturnsOnCall[len(fake.updateApplicationSidecarArgsForCall)]
+	fake.updateApplicationSidecarArgsForCall = append(fake.updateApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateApplicationSidecar", []interface{}{arg1, arg2, arg3})
+	fake.updateApplicationSidecarMutex.Unlock()
+	if fake.UpdateApplicationSidecarStub != nil {
+		return fake.UpdateApplicationSidecarStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateApplicationSidecarReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarCallCount() int {
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
+	return len(fake.updateApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarCalls(stub func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarArgsForCall(i int) (string, string, resources.Sidecar) {
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.updateApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = nil
+	fake.updateApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = nil
+	if fake.updateApplicationSidecarReturnsOnCall == nil {
+		fake.updateApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) UpdateBuildpackByNameAndStack(arg1 string, arg2 string, arg3 resources.Buildpack) (resources.Buildpack, v7action.Warnings, error) {
 	fake.updateBuildpackByNameAndStackMutex.Lock()
 	ret, specificReturn := fake.updateBuildpackByNameAndStackReturnsOnCall[len(fake.updateBuildpackByNameAndStackArgsForCall)]
@@ -18060,6 +20820,73 @@ func (fake *FakeActor) UpdateProcessByTypeAndApplicationReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateRoute(arg1 string, arg2 map[string]*string) (resources.Route, v7action.Warnings, error) {
+	fake.updateRouteMutex.Lock()
+	ret, specificReturn := fake.updateRouteReturnsOnCall[len(fake.updateRouteArgsForCall)]
+	fake.updateRouteArgsForCall = append(fake.updateRouteArgsForCall, struct {
+		arg1 string
+		arg2 map[string]*string
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateRoute", []interface{}{arg1, arg2})
+	fake.updateRouteMutex.Unlock()
+	if fake.UpdateRouteStub != nil {
+		return fake.UpdateRouteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UpdateRouteCallCount() int {
+	fake.updateRouteMutex.RLock()
+	defer fake.updateRouteMutex.RUnlock()
+	return len(fake.updateRouteArgsForCall)
+}
+
+func (fake *FakeActor) UpdateRouteCalls(stub func(string, map[string]*string) (resources.Route, v7action.Warnings, error)) {
+	fake.updateRouteMutex.Lock()
+	defer fake.updateRouteMutex.Unlock()
+	fake.UpdateRouteStub = stub
+}
+
+func (fake *FakeActor) UpdateRouteArgsForCall(i int) (string, map[string]*string) {
+	fake.updateRouteMutex.RLock()
+	defer fake.updateRouteMutex.RUnlock()
+	argsForCall := fake.updateRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateRouteReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.updateRouteMutex.Lock()
+	defer fake.updateRouteMutex.Unlock()
+	fake.UpdateRouteStub = nil
+	fake.updateRouteReturns = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateRouteReturnsOnCall(i int, result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.updateRouteMutex.Lock()
+	defer fake.updateRouteMutex.Unlock()
+	fake.UpdateRouteStub = nil
+	if fake.updateRouteReturnsOnCall == nil {
+		fake.updateRouteReturnsOnCall = make(map[int]struct {
+			result1 resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateRouteReturnsOnCall[i] = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) UpdateRouteLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateRouteLabelsMutex.Lock()
 	ret, specificReturn := fake.updateRouteLabelsReturnsOnCall[len(fake.updateRouteLabelsArgsForCall)]
@@ -19244,8 +22071,12 @@ func (fake *FakeActor) UploadDropletReturnsOnCall(i int, result1 v7action.Warnin
 func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addRouteDestinationsMutex.RLock()
+	defer fake.addRouteDestinationsMutex.RUnlock()
 	fake.applyOrganizationQuotaByNameMutex.RLock()
 	defer fake.applyOrganizationQuotaByNameMutex.RUnlock()
+	fake.applySecurityGroupChangesMutex.RLock()
+	defer fake.applySecurityGroupChangesMutex.RUnlock()
 	fake.applySpaceQuotaByNameMutex.RLock()
 	defer fake.applySpaceQuotaByNameMutex.RUnlock()
 	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
@@ -19268,6 +22099,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createApplicationDropletMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
 	fake.createBitsPackageByApplicationMutex.RLock()
 	defer fake.createBitsPackageByApplicationMutex.RUnlock()
 	fake.createBuildpackMutex.RLock()
@@ -19296,6 +22129,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createRouteMutex.RUnlock()
 	fake.createRouteBindingMutex.RLock()
 	defer fake.createRouteBindingMutex.RUnlock()
+	fake.createRouteInPortRangeMutex.RLock()
+	defer fake.createRouteInPortRangeMutex.RUnlock()
 	fake.createSecurityGroupMutex.RLock()
 	defer fake.createSecurityGroupMutex.RUnlock()
 	fake.createServiceAppBindingMutex.RLock()
@@ -19318,6 +22153,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
 	fake.deleteApplicationByNameAndSpaceMutex.RLock()
 	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
 	fake.deleteBuildpackByNameAndStackMutex.RLock()
 	defer fake.deleteBuildpackByNameAndStackMutex.RUnlock()
 	fake.deleteDomainMutex.RLock()
@@ -19344,6 +22181,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.deleteSecurityGroupMutex.RUnlock()
 	fake.deleteServiceAppBindingMutex.RLock()
 	defer fake.deleteServiceAppBindingMutex.RUnlock()
+	fake.deleteServiceAppBindingByGUIDMutex.RLock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.RUnlock()
 	fake.deleteServiceBrokerMutex.RLock()
 	defer fake.deleteServiceBrokerMutex.RUnlock()
 	fake.deleteServiceInstanceMutex.RLock()
@@ -19356,8 +22195,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.deleteSpaceQuotaByNameMutex.RUnlock()
 	fake.deleteSpaceRoleMutex.RLock()
 	defer fake.deleteSpaceRoleMutex.RUnlock()
+	fake.deleteStaleRouteMutex.RLock()
+	defer fake.deleteStaleRouteMutex.RUnlock()
 	fake.deleteUserMutex.RLock()
 	defer fake.deleteUserMutex.RUnlock()
+	fake.diffServiceBrokerCatalogMutex.RLock()
+	defer fake.diffServiceBrokerCatalogMutex.RUnlock()
 	fake.diffSpaceManifestMutex.RLock()
 	defer fake.diffSpaceManifestMutex.RUnlock()
 	fake.disableFeatureFlagMutex.RLock()
@@ -19380,6 +22223,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getAppSummariesForSpaceMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationCrashesByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationCrashesByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
 	defer fake.getApplicationDropletsMutex.RUnlock()
 	fake.getApplicationLabelsMutex.RLock()
@@ -19394,10 +22239,16 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	fake.getBindingsMutex.RLock()
+	defer fake.getBindingsMutex.RUnlock()
 	fake.getBuildpackLabelsMutex.RLock()
 	defer fake.getBuildpackLabelsMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
@@ -19416,6 +22267,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getDomainLabelsMutex.RUnlock()
 	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
 	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	fake.getEffectiveSecurityRulesMutex.RLock()
+	defer fake.getEffectiveSecurityRulesMutex.RUnlock()
 	fake.getEnvironmentVariableGroupMutex.RLock()
 	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
 	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
@@ -19428,6 +22281,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getGlobalRunningSecurityGroupsMutex.RUnlock()
 	fake.getGlobalStagingSecurityGroupsMutex.RLock()
 	defer fake.getGlobalStagingSecurityGroupsMutex.RUnlock()
+	fake.getInternalRouteMutex.RLock()
+	defer fake.getInternalRouteMutex.RUnlock()
+	fake.getInternalRoutesMutex.RLock()
+	defer fake.getInternalRoutesMutex.RUnlock()
 	fake.getIsolationSegmentByNameMutex.RLock()
 	defer fake.getIsolationSegmentByNameMutex.RUnlock()
 	fake.getIsolationSegmentSummariesMutex.RLock()
@@ -19474,6 +22331,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getRevisionsByApplicationNameAndSpaceMutex.RUnlock()
 	fake.getRootResponseMutex.RLock()
 	defer fake.getRootResponseMutex.RUnlock()
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
@@ -19482,12 +22341,16 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getRouteLabelsMutex.RUnlock()
 	fake.getRouteSummariesMutex.RLock()
 	defer fake.getRouteSummariesMutex.RUnlock()
+	fake.getRouterGroupDetailsMutex.RLock()
+	defer fake.getRouterGroupDetailsMutex.RUnlock()
 	fake.getRouterGroupsMutex.RLock()
 	defer fake.getRouterGroupsMutex.RUnlock()
 	fake.getRoutesByOrgMutex.RLock()
 	defer fake.getRoutesByOrgMutex.RUnlock()
 	fake.getRoutesBySpaceMutex.RLock()
 	defer fake.getRoutesBySpaceMutex.RUnlock()
+	fake.getRunningApplicationTasksMutex.RLock()
+	defer fake.getRunningApplicationTasksMutex.RUnlock()
 	fake.getSSHEnabledMutex.RLock()
 	defer fake.getSSHEnabledMutex.RUnlock()
 	fake.getSSHEnabledByAppNameMutex.RLock()
@@ -19504,8 +22367,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSecurityGroupsMutex.RUnlock()
 	fake.getServiceAccessMutex.RLock()
 	defer fake.getServiceAccessMutex.RUnlock()
+	fake.getServiceAppBindingMutex.RLock()
+	defer fake.getServiceAppBindingMutex.RUnlock()
 	fake.getServiceBrokerByNameMutex.RLock()
 	defer fake.getServiceBrokerByNameMutex.RUnlock()
+	fake.getServiceBrokerCatalogMutex.RLock()
+	defer fake.getServiceBrokerCatalogMutex.RUnlock()
 	fake.getServiceBrokerLabelsMutex.RLock()
 	defer fake.getServiceBrokerLabelsMutex.RUnlock()
 	fake.getServiceBrokersMutex.RLock()
@@ -19518,12 +22385,22 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getServiceInstanceLabelsMutex.RUnlock()
 	fake.getServiceInstanceParametersMutex.RLock()
 	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	fake.getServiceInstanceSharedSpacesUsageMutex.RLock()
+	defer fake.getServiceInstanceSharedSpacesUsageMutex.RUnlock()
+	fake.getServiceInstanceSharesForOrganizationMutex.RLock()
+	defer fake.getServiceInstanceSharesForOrganizationMutex.RUnlock()
 	fake.getServiceInstancesForSpaceMutex.RLock()
 	defer fake.getServiceInstancesForSpaceMutex.RUnlock()
+	fake.getServiceInstancesForUpgradeMutex.RLock()
+	defer fake.getServiceInstancesForUpgradeMutex.RUnlock()
+	fake.getServiceInstancesWithPendingUpgradesMutex.RLock()
+	defer fake.getServiceInstancesWithPendingUpgradesMutex.RUnlock()
 	fake.getServiceKeyByServiceInstanceAndNameMutex.RLock()
 	defer fake.getServiceKeyByServiceInstanceAndNameMutex.RUnlock()
 	fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RLock()
 	defer fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RUnlock()
+	fake.getServiceKeyRotationMutex.RLock()
+	defer fake.getServiceKeyRotationMutex.RUnlock()
 	fake.getServiceKeysByServiceInstanceMutex.RLock()
 	defer fake.getServiceKeysByServiceInstanceMutex.RUnlock()
 	fake.getServiceOfferingLabelsMutex.RLock()
@@ -19552,8 +22429,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getStackLabelsMutex.RUnlock()
 	fake.getStacksMutex.RLock()
 	defer fake.getStacksMutex.RUnlock()
+	fake.getStaleRoutesMutex.RLock()
+	defer fake.getStaleRoutesMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
 	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
 	defer fake.getTaskBySequenceIDAndApplicationMutex.RUnlock()
 	fake.getUAAAPIVersionMutex.RLock()
@@ -19562,6 +22443,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getUnstagedNewestPackageGUIDMutex.RUnlock()
 	fake.getUserMutex.RLock()
 	defer fake.getUserMutex.RUnlock()
+	fake.lintSecurityGroupRulesMutex.RLock()
+	defer fake.lintSecurityGroupRulesMutex.RUnlock()
 	fake.makeCurlRequestMutex.RLock()
 	defer fake.makeCurlRequestMutex.RUnlock()
 	fake.mapRouteMutex.RLock()
@@ -19572,6 +22455,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.moveRouteMutex.RUnlock()
 	fake.parseAccessTokenMutex.RLock()
 	defer fake.parseAccessTokenMutex.RUnlock()
+	fake.planSecurityGroupsMutex.RLock()
+	defer fake.planSecurityGroupsMutex.RUnlock()
 	fake.pollBuildMutex.RLock()
 	defer fake.pollBuildMutex.RUnlock()
 	fake.pollPackageMutex.RLock()
@@ -19600,6 +22485,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.renameServiceInstanceMutex.RUnlock()
 	fake.renameSpaceByNameAndOrganizationGUIDMutex.RLock()
 	defer fake.renameSpaceByNameAndOrganizationGUIDMutex.RUnlock()
+	fake.replaceRouteDestinationsMutex.RLock()
+	defer fake.replaceRouteDestinationsMutex.RUnlock()
 	fake.resetOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.resetOrganizationDefaultIsolationSegmentMutex.RUnlock()
 	fake.resetSpaceIsolationSegmentMutex.RLock()
@@ -19630,6 +22517,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.setEnvironmentVariableGroupMutex.RUnlock()
 	fake.setOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.setOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	fake.setRouteWeightsMutex.RLock()
+	defer fake.setRouteWeightsMutex.RUnlock()
 	fake.setSpaceManifestMutex.RLock()
 	defer fake.setSpaceManifestMutex.RUnlock()
 	fake.setTargetMutex.RLock()
@@ -19664,12 +22553,16 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.unshareRouteMutex.RUnlock()
 	fake.unshareServiceInstanceFromSpaceAndOrgMutex.RLock()
 	defer fake.unshareServiceInstanceFromSpaceAndOrgMutex.RUnlock()
+	fake.unshareServiceInstanceFromSpacesMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpacesMutex.RUnlock()
 	fake.updateAppFeatureMutex.RLock()
 	defer fake.updateAppFeatureMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.updateApplicationLabelsByApplicationNameMutex.RLock()
 	defer fake.updateApplicationLabelsByApplicationNameMutex.RUnlock()
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
 	fake.updateBuildpackByNameAndStackMutex.RLock()
 	defer fake.updateBuildpackByNameAndStackMutex.RUnlock()
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RLock()
@@ -19686,6 +22579,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.updateOrganizationQuotaMutex.RUnlock()
 	fake.updateProcessByTypeAndApplicationMutex.RLock()
 	defer fake.updateProcessByTypeAndApplicationMutex.RUnlock()
+	fake.updateRouteMutex.RLock()
+	defer fake.updateRouteMutex.RUnlock()
 	fake.updateRouteLabelsMutex.RLock()
 	defer fake.updateRouteLabelsMutex.RUnlock()
 	fake.updateSecurityGroupMutex.RLock()
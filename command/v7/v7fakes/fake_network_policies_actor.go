@@ -9,6 +9,21 @@ import (
 )
 
 type FakeNetworkPoliciesActor struct {
+	NetworkPoliciesByOrgStub        func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	networkPoliciesByOrgMutex       sync.RWMutex
+	networkPoliciesByOrgArgsForCall []struct {
+		arg1 string
+	}
+	networkPoliciesByOrgReturns struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	networkPoliciesByOrgReturnsOnCall map[int]struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
 	NetworkPoliciesBySpaceStub        func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
 	networkPoliciesBySpaceMutex       sync.RWMutex
 	networkPoliciesBySpaceArgsForCall []struct {
@@ -44,6 +59,72 @@ type FakeNetworkPoliciesActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrg(arg1 string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error) {
+	fake.networkPoliciesByOrgMutex.Lock()
+	ret, specificReturn := fake.networkPoliciesByOrgReturnsOnCall[len(fake.networkPoliciesByOrgArgsForCall)]
+	fake.networkPoliciesByOrgArgsForCall = append(fake.networkPoliciesByOrgArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("NetworkPoliciesByOrg", []interface{}{arg1})
+	fake.networkPoliciesByOrgMutex.Unlock()
+	if fake.NetworkPoliciesByOrgStub != nil {
+		return fake.NetworkPoliciesByOrgStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.networkPoliciesByOrgReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgCallCount() int {
+	fake.networkPoliciesByOrgMutex.RLock()
+	defer fake.networkPoliciesByOrgMutex.RUnlock()
+	return len(fake.networkPoliciesByOrgArgsForCall)
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgCalls(stub func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)) {
+	fake.networkPoliciesByOrgMutex.Lock()
+	defer fake.networkPoliciesByOrgMutex.Unlock()
+	fake.NetworkPoliciesByOrgStub = stub
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgArgsForCall(i int) string {
+	fake.networkPoliciesByOrgMutex.RLock()
+	defer fake.networkPoliciesByOrgMutex.RUnlock()
+	argsForCall := fake.networkPoliciesByOrgArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgReturns(result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesByOrgMutex.Lock()
+	defer fake.networkPoliciesByOrgMutex.Unlock()
+	fake.NetworkPoliciesByOrgStub = nil
+	fake.networkPoliciesByOrgReturns = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgReturnsOnCall(i int, result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesByOrgMutex.Lock()
+	defer fake.networkPoliciesByOrgMutex.Unlock()
+	fake.NetworkPoliciesByOrgStub = nil
+	if fake.networkPoliciesByOrgReturnsOnCall == nil {
+		fake.networkPoliciesByOrgReturnsOnCall = make(map[int]struct {
+			result1 []cfnetworkingaction.Policy
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.networkPoliciesByOrgReturnsOnCall[i] = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeNetworkPoliciesActor) NetworkPoliciesBySpace(arg1 string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error) {
 	fake.networkPoliciesBySpaceMutex.Lock()
 	ret, specificReturn := fake.networkPoliciesBySpaceReturnsOnCall[len(fake.networkPoliciesBySpaceArgsForCall)]
@@ -180,6 +261,8 @@ func (fake *FakeNetworkPoliciesActor) NetworkPoliciesBySpaceAndAppNameReturnsOnC
 func (fake *FakeNetworkPoliciesActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.networkPoliciesByOrgMutex.RLock()
+	defer fake.networkPoliciesByOrgMutex.RUnlock()
 	fake.networkPoliciesBySpaceMutex.RLock()
 	defer fake.networkPoliciesBySpaceMutex.RUnlock()
 	fake.networkPoliciesBySpaceAndAppNameMutex.RLock()
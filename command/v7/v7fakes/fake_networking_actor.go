@@ -6,9 +6,25 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type FakeNetworkingActor struct {
+	AddNetworkPoliciesStub        func([]resources.Application, []resources.Application, string, int, int) error
+	addNetworkPoliciesMutex       sync.RWMutex
+	addNetworkPoliciesArgsForCall []struct {
+		arg1 []resources.Application
+		arg2 []resources.Application
+		arg3 string
+		arg4 int
+		arg5 int
+	}
+	addNetworkPoliciesReturns struct {
+		result1 error
+	}
+	addNetworkPoliciesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	AddNetworkPolicyStub        func(string, string, string, string, string, int, int) (cfnetworkingaction.Warnings, error)
 	addNetworkPolicyMutex       sync.RWMutex
 	addNetworkPolicyArgsForCall []struct {
@@ -28,10 +44,99 @@ type FakeNetworkingActor struct {
 		result1 cfnetworkingaction.Warnings
 		result2 error
 	}
+	GetPolicyAppsStub        func(cfnetworkingaction.PolicyAppSelector) ([]resources.Application, cfnetworkingaction.Warnings, error)
+	getPolicyAppsMutex       sync.RWMutex
+	getPolicyAppsArgsForCall []struct {
+		arg1 cfnetworkingaction.PolicyAppSelector
+	}
+	getPolicyAppsReturns struct {
+		result1 []resources.Application
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	getPolicyAppsReturnsOnCall map[int]struct {
+		result1 []resources.Application
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeNetworkingActor) AddNetworkPolicies(arg1 []resources.Application, arg2 []resources.Application, arg3 string, arg4 int, arg5 int) error {
+	var arg1Copy []resources.Application
+	if arg1 != nil {
+		arg1Copy = make([]resources.Application, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	var arg2Copy []resources.Application
+	if arg2 != nil {
+		arg2Copy = make([]resources.Application, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addNetworkPoliciesMutex.Lock()
+	ret, specificReturn := fake.addNetworkPoliciesReturnsOnCall[len(fake.addNetworkPoliciesArgsForCall)]
+	fake.addNetworkPoliciesArgsForCall = append(fake.addNetworkPoliciesArgsForCall, struct {
+		arg1 []resources.Application
+		arg2 []resources.Application
+		arg3 string
+		arg4 int
+		arg5 int
+	}{arg1Copy, arg2Copy, arg3, arg4, arg5})
+	fake.recordInvocation("AddNetworkPolicies", []interface{}{arg1Copy, arg2Copy, arg3, arg4, arg5})
+	fake.addNetworkPoliciesMutex.Unlock()
+	if fake.AddNetworkPoliciesStub != nil {
+		return fake.AddNetworkPoliciesStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.addNetworkPoliciesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetworkingActor) AddNetworkPoliciesCallCount() int {
+	fake.addNetworkPoliciesMutex.RLock()
+	defer fake.addNetworkPoliciesMutex.RUnlock()
+	return len(fake.addNetworkPoliciesArgsForCall)
+}
+
+func (fake *FakeNetworkingActor) AddNetworkPoliciesCalls(stub func([]resources.Application, []resources.Application, string, int, int) error) {
+	fake.addNetworkPoliciesMutex.Lock()
+	defer fake.addNetworkPoliciesMutex.Unlock()
+	fake.AddNetworkPoliciesStub = stub
+}
+
+func (fake *FakeNetworkingActor) AddNetworkPoliciesArgsForCall(i int) ([]resources.Application, []resources.Application, string, int, int) {
+	fake.addNetworkPoliciesMutex.RLock()
+	defer fake.addNetworkPoliciesMutex.RUnlock()
+	argsForCall := fake.addNetworkPoliciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeNetworkingActor) AddNetworkPoliciesReturns(result1 error) {
+	fake.addNetworkPoliciesMutex.Lock()
+	defer fake.addNetworkPoliciesMutex.Unlock()
+	fake.AddNetworkPoliciesStub = nil
+	fake.addNetworkPoliciesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetworkingActor) AddNetworkPoliciesReturnsOnCall(i int, result1 error) {
+	fake.addNetworkPoliciesMutex.Lock()
+	defer fake.addNetworkPoliciesMutex.Unlock()
+	fake.AddNetworkPoliciesStub = nil
+	if fake.addNetworkPoliciesReturnsOnCall == nil {
+		fake.addNetworkPoliciesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addNetworkPoliciesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNetworkingActor) AddNetworkPolicy(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string, arg6 int, arg7 int) (cfnetworkingaction.Warnings, error) {
 	fake.addNetworkPolicyMutex.Lock()
 	ret, specificReturn := fake.addNetworkPolicyReturnsOnCall[len(fake.addNetworkPolicyArgsForCall)]
@@ -101,11 +206,81 @@ func (fake *FakeNetworkingActor) AddNetworkPolicyReturnsOnCall(i int, result1 cf
 	}{result1, result2}
 }
 
+func (fake *FakeNetworkingActor) GetPolicyApps(arg1 cfnetworkingaction.PolicyAppSelector) ([]resources.Application, cfnetworkingaction.Warnings, error) {
+	fake.getPolicyAppsMutex.Lock()
+	ret, specificReturn := fake.getPolicyAppsReturnsOnCall[len(fake.getPolicyAppsArgsForCall)]
+	fake.getPolicyAppsArgsForCall = append(fake.getPolicyAppsArgsForCall, struct {
+		arg1 cfnetworkingaction.PolicyAppSelector
+	}{arg1})
+	fake.recordInvocation("GetPolicyApps", []interface{}{arg1})
+	fake.getPolicyAppsMutex.Unlock()
+	if fake.GetPolicyAppsStub != nil {
+		return fake.GetPolicyAppsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getPolicyAppsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNetworkingActor) GetPolicyAppsCallCount() int {
+	fake.getPolicyAppsMutex.RLock()
+	defer fake.getPolicyAppsMutex.RUnlock()
+	return len(fake.getPolicyAppsArgsForCall)
+}
+
+func (fake *FakeNetworkingActor) GetPolicyAppsCalls(stub func(cfnetworkingaction.PolicyAppSelector) ([]resources.Application, cfnetworkingaction.Warnings, error)) {
+	fake.getPolicyAppsMutex.Lock()
+	defer fake.getPolicyAppsMutex.Unlock()
+	fake.GetPolicyAppsStub = stub
+}
+
+func (fake *FakeNetworkingActor) GetPolicyAppsArgsForCall(i int) cfnetworkingaction.PolicyAppSelector {
+	fake.getPolicyAppsMutex.RLock()
+	defer fake.getPolicyAppsMutex.RUnlock()
+	argsForCall := fake.getPolicyAppsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNetworkingActor) GetPolicyAppsReturns(result1 []resources.Application, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getPolicyAppsMutex.Lock()
+	defer fake.getPolicyAppsMutex.Unlock()
+	fake.GetPolicyAppsStub = nil
+	fake.getPolicyAppsReturns = struct {
+		result1 []resources.Application
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkingActor) GetPolicyAppsReturnsOnCall(i int, result1 []resources.Application, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getPolicyAppsMutex.Lock()
+	defer fake.getPolicyAppsMutex.Unlock()
+	fake.GetPolicyAppsStub = nil
+	if fake.getPolicyAppsReturnsOnCall == nil {
+		fake.getPolicyAppsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Application
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.getPolicyAppsReturnsOnCall[i] = struct {
+		result1 []resources.Application
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeNetworkingActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addNetworkPoliciesMutex.RLock()
+	defer fake.addNetworkPoliciesMutex.RUnlock()
 	fake.addNetworkPolicyMutex.RLock()
 	defer fake.addNetworkPolicyMutex.RUnlock()
+	fake.getPolicyAppsMutex.RLock()
+	defer fake.getPolicyAppsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -6,9 +6,40 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type FakeRemoveNetworkPolicyActor struct {
+	GetPolicyAppsStub        func(cfnetworkingaction.PolicyAppSelector) ([]resources.Application, cfnetworkingaction.Warnings, error)
+	getPolicyAppsMutex       sync.RWMutex
+	getPolicyAppsArgsForCall []struct {
+		arg1 cfnetworkingaction.PolicyAppSelector
+	}
+	getPolicyAppsReturns struct {
+		result1 []resources.Application
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	getPolicyAppsReturnsOnCall map[int]struct {
+		result1 []resources.Application
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	RemoveNetworkPoliciesStub        func([]resources.Application, []resources.Application, string, int, int) error
+	removeNetworkPoliciesMutex       sync.RWMutex
+	removeNetworkPoliciesArgsForCall []struct {
+		arg1 []resources.Application
+		arg2 []resources.Application
+		arg3 string
+		arg4 int
+		arg5 int
+	}
+	removeNetworkPoliciesReturns struct {
+		result1 error
+	}
+	removeNetworkPoliciesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveNetworkPolicyStub        func(string, string, string, string, string, int, int) (cfnetworkingaction.Warnings, error)
 	removeNetworkPolicyMutex       sync.RWMutex
 	removeNetworkPolicyArgsForCall []struct {
@@ -32,6 +63,146 @@ type FakeRemoveNetworkPolicyActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeRemoveNetworkPolicyActor) GetPolicyApps(arg1 cfnetworkingaction.PolicyAppSelector) ([]resources.Application, cfnetworkingaction.Warnings, error) {
+	fake.getPolicyAppsMutex.Lock()
+	ret, specificReturn := fake.getPolicyAppsReturnsOnCall[len(fake.getPolicyAppsArgsForCall)]
+	fake.getPolicyAppsArgsForCall = append(fake.getPolicyAppsArgsForCall, struct {
+		arg1 cfnetworkingaction.PolicyAppSelector
+	}{arg1})
+	fake.recordInvocation("GetPolicyApps", []interface{}{arg1})
+	fake.getPolicyAppsMutex.Unlock()
+	if fake.GetPolicyAppsStub != nil {
+		return fake.GetPolicyAppsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getPolicyAppsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) GetPolicyAppsCallCount() int {
+	fake.getPolicyAppsMutex.RLock()
+	defer fake.getPolicyAppsMutex.RUnlock()
+	return len(fake.getPolicyAppsArgsForCall)
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) GetPolicyAppsCalls(stub func(cfnetworkingaction.PolicyAppSelector) ([]resources.Application, cfnetworkingaction.Warnings, error)) {
+	fake.getPolicyAppsMutex.Lock()
+	defer fake.getPolicyAppsMutex.Unlock()
+	fake.GetPolicyAppsStub = stub
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) GetPolicyAppsArgsForCall(i int) cfnetworkingaction.PolicyAppSelector {
+	fake.getPolicyAppsMutex.RLock()
+	defer fake.getPolicyAppsMutex.RUnlock()
+	argsForCall := fake.getPolicyAppsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) GetPolicyAppsReturns(result1 []resources.Application, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getPolicyAppsMutex.Lock()
+	defer fake.getPolicyAppsMutex.Unlock()
+	fake.GetPolicyAppsStub = nil
+	fake.getPolicyAppsReturns = struct {
+		result1 []resources.Application
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) GetPolicyAppsReturnsOnCall(i int, result1 []resources.Application, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getPolicyAppsMutex.Lock()
+	defer fake.getPolicyAppsMutex.Unlock()
+	fake.GetPolicyAppsStub = nil
+	if fake.getPolicyAppsReturnsOnCall == nil {
+		fake.getPolicyAppsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Application
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.getPolicyAppsReturnsOnCall[i] = struct {
+		result1 []resources.Application
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicies(arg1 []resources.Application, arg2 []resources.Application, arg3 string, arg4 int, arg5 int) error {
+	var arg1Copy []resources.Application
+	if arg1 != nil {
+		arg1Copy = make([]resources.Application, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	var arg2Copy []resources.Application
+	if arg2 != nil {
+		arg2Copy = make([]resources.Application, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.removeNetworkPoliciesMutex.Lock()
+	ret, specificReturn := fake.removeNetworkPoliciesReturnsOnCall[len(fake.removeNetworkPoliciesArgsForCall)]
+	fake.removeNetworkPoliciesArgsForCall = append(fake.removeNetworkPoliciesArgsForCall, struct {
+		arg1 []resources.Application
+		arg2 []resources.Application
+		arg3 string
+		arg4 int
+		arg5 int
+	}{arg1Copy, arg2Copy, arg3, arg4, arg5})
+	fake.recordInvocation("RemoveNetworkPolicies", []interface{}{arg1Copy, arg2Copy, arg3, arg4, arg5})
+	fake.removeNetworkPoliciesMutex.Unlock()
+	if fake.RemoveNetworkPoliciesStub != nil {
+		return fake.RemoveNetworkPoliciesStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.removeNetworkPoliciesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPoliciesCallCount() int {
+	fake.removeNetworkPoliciesMutex.RLock()
+	defer fake.removeNetworkPoliciesMutex.RUnlock()
+	return len(fake.removeNetworkPoliciesArgsForCall)
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPoliciesCalls(stub func([]resources.Application, []resources.Application, string, int, int) error) {
+	fake.removeNetworkPoliciesMutex.Lock()
+	defer fake.removeNetworkPoliciesMutex.Unlock()
+	fake.RemoveNetworkPoliciesStub = stub
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPoliciesArgsForCall(i int) ([]resources.Application, []resources.Application, string, int, int) {
+	fake.removeNetworkPoliciesMutex.RLock()
+	defer fake.removeNetworkPoliciesMutex.RUnlock()
+	argsForCall := fake.removeNetworkPoliciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPoliciesReturns(result1 error) {
+	fake.removeNetworkPoliciesMutex.Lock()
+	defer fake.removeNetworkPoliciesMutex.Unlock()
+	fake.RemoveNetworkPoliciesStub = nil
+	fake.removeNetworkPoliciesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPoliciesReturnsOnCall(i int, result1 error) {
+	fake.removeNetworkPoliciesMutex.Lock()
+	defer fake.removeNetworkPoliciesMutex.Unlock()
+	fake.RemoveNetworkPoliciesStub = nil
+	if fake.removeNetworkPoliciesReturnsOnCall == nil {
+		fake.removeNetworkPoliciesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeNetworkPoliciesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicy(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string, arg6 int, arg7 int) (cfnetworkingaction.Warnings, error) {
 	fake.removeNetworkPolicyMutex.Lock()
 	ret, specificReturn := fake.removeNetworkPolicyReturnsOnCall[len(fake.removeNetworkPolicyArgsForCall)]
@@ -104,6 +275,10 @@ func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyReturnsOnCall(i int
 func (fake *FakeRemoveNetworkPolicyActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getPolicyAppsMutex.RLock()
+	defer fake.getPolicyAppsMutex.RUnlock()
+	fake.removeNetworkPoliciesMutex.RLock()
+	defer fake.removeNetworkPoliciesMutex.RUnlock()
 	fake.removeNetworkPolicyMutex.RLock()
 	defer fake.removeNetworkPolicyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -21,6 +21,20 @@ type FakeSharedSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RunSecureShellCommandStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions) ([]byte, error)
+	runSecureShellCommandMutex       sync.RWMutex
+	runSecureShellCommandArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+	}
+	runSecureShellCommandReturns struct {
+		result1 []byte
+		result2 error
+	}
+	runSecureShellCommandReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -86,11 +100,77 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeSharedSSHActor) RunSecureShellCommand(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions) ([]byte, error) {
+	fake.runSecureShellCommandMutex.Lock()
+	ret, specificReturn := fake.runSecureShellCommandReturnsOnCall[len(fake.runSecureShellCommandArgsForCall)]
+	fake.runSecureShellCommandArgsForCall = append(fake.runSecureShellCommandArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+	}{arg1, arg2})
+	fake.recordInvocation("RunSecureShellCommand", []interface{}{arg1, arg2})
+	fake.runSecureShellCommandMutex.Unlock()
+	if fake.RunSecureShellCommandStub != nil {
+		return fake.RunSecureShellCommandStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.runSecureShellCommandReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSharedSSHActor) RunSecureShellCommandCallCount() int {
+	fake.runSecureShellCommandMutex.RLock()
+	defer fake.runSecureShellCommandMutex.RUnlock()
+	return len(fake.runSecureShellCommandArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) RunSecureShellCommandCalls(stub func(sharedaction.SecureShellClient, sharedaction.SSHOptions) ([]byte, error)) {
+	fake.runSecureShellCommandMutex.Lock()
+	defer fake.runSecureShellCommandMutex.Unlock()
+	fake.RunSecureShellCommandStub = stub
+}
+
+func (fake *FakeSharedSSHActor) RunSecureShellCommandArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SSHOptions) {
+	fake.runSecureShellCommandMutex.RLock()
+	defer fake.runSecureShellCommandMutex.RUnlock()
+	argsForCall := fake.runSecureShellCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSharedSSHActor) RunSecureShellCommandReturns(result1 []byte, result2 error) {
+	fake.runSecureShellCommandMutex.Lock()
+	defer fake.runSecureShellCommandMutex.Unlock()
+	fake.RunSecureShellCommandStub = nil
+	fake.runSecureShellCommandReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSharedSSHActor) RunSecureShellCommandReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.runSecureShellCommandMutex.Lock()
+	defer fake.runSecureShellCommandMutex.Unlock()
+	fake.RunSecureShellCommandStub = nil
+	if fake.runSecureShellCommandReturnsOnCall == nil {
+		fake.runSecureShellCommandReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.runSecureShellCommandReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSharedSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.runSecureShellCommandMutex.RLock()
+	defer fake.runSecureShellCommandMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
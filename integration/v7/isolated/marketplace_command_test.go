@@ -19,11 +19,14 @@ var _ = Describe("marketplace command", func() {
 			Say(`marketplace - List available offerings in the marketplace`),
 			Say(`USAGE:`),
 			Say(`cf marketplace \[-e SERVICE_OFFERING\] \[-b SERVICE_BROKER\] \[--no-plans\]`),
+			Say(`cf marketplace -e SERVICE_OFFERING -p SERVICE_PLAN --show-schema \[-b SERVICE_BROKER\]`),
 			Say(`ALIAS:`),
 			Say(`m`),
 			Say(`OPTIONS:`),
 			Say(`-e\s+Show plan details for a particular service offering`),
 			Say(`--no-plans\s+Hide plan information for service offerings`),
+			Say(`-p\s+Plan whose parameter schemas are displayed \(requires -e and --show-schema\)`),
+			Say(`--show-schema\s+Show the JSON schemas that configuration parameters for the plan must satisfy`),
 			Say(`--show-unavailable\s+Show plans that are not available for use`),
 			Say(`create-service, services`),
 		)
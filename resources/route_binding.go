@@ -11,6 +11,7 @@ type RouteBinding struct {
 	ServiceInstanceGUID string               `jsonry:"relationships.service_instance.data.guid,omitempty"`
 	RouteGUID           string               `jsonry:"relationships.route.data.guid,omitempty"`
 	LastOperation       LastOperation        `jsonry:"last_operation"`
+	CreatedAt           string               `jsonry:"created_at,omitempty"`
 	Parameters          types.OptionalObject `jsonry:"parameters"`
 }
 
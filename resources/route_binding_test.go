@@ -26,6 +26,7 @@ var _ = Describe("RouteBinding", func() {
 		Entry("empty", RouteBinding{}, `{}`),
 		Entry("guid", RouteBinding{GUID: "fake-guid"}, `{"guid": "fake-guid"}`),
 		Entry("route service url", RouteBinding{RouteServiceURL: "fake-route-service-url"}, `{"route_service_url": "fake-route-service-url"}`),
+		Entry("created at", RouteBinding{CreatedAt: "2026-01-02T03:04:05Z"}, `{"created_at": "2026-01-02T03:04:05Z"}`),
 		Entry(
 			"route guid",
 			RouteBinding{RouteGUID: "fake-route-guid"},
@@ -18,6 +18,9 @@ type RouteDestination struct {
 	App      RouteDestinationApp
 	Port     int
 	Protocol string
+	// Weight is the share of the route's traffic sent to the destination,
+	// relative to the other destinations. Nil when the route is not weighted.
+	Weight *int
 }
 
 type Route struct {
@@ -31,6 +34,11 @@ type Route struct {
 	URL          string
 	Destinations []RouteDestination
 	Metadata     *Metadata
+	// Options are per-route settings, such as the load-balancing algorithm.
+	// A nil value removes the option when the route is updated.
+	Options map[string]*string
+	// CreatedAt is the time with zone when the route was created.
+	CreatedAt string
 }
 
 func (r Route) MarshalJSON() ([]byte, error) {
@@ -49,12 +57,13 @@ func (r Route) MarshalJSON() ([]byte, error) {
 
 	// Building up the request body in ccRoute
 	type ccRoute struct {
-		GUID          string         `json:"guid,omitempty"`
-		Host          string         `json:"host,omitempty"`
-		Path          string         `json:"path,omitempty"`
-		Protocol      string         `json:"protocol,omitempty"`
-		Port          int            `json:"port,omitempty"`
-		Relationships *Relationships `json:"relationships,omitempty"`
+		GUID          string             `json:"guid,omitempty"`
+		Host          string             `json:"host,omitempty"`
+		Path          string             `json:"path,omitempty"`
+		Protocol      string             `json:"protocol,omitempty"`
+		Port          int                `json:"port,omitempty"`
+		Options       map[string]*string `json:"options,omitempty"`
+		Relationships *Relationships     `json:"relationships,omitempty"`
 	}
 
 	ccR := ccRoute{
@@ -63,6 +72,7 @@ func (r Route) MarshalJSON() ([]byte, error) {
 		Path:     r.Path,
 		Protocol: r.Protocol,
 		Port:     r.Port,
+		Options:  r.Options,
 	}
 
 	if r.SpaceGUID != "" {
@@ -85,6 +95,8 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 		URL          string             `json:"url,omitempty"`
 		Destinations []RouteDestination `json:"destinations,omitempty"`
 		Metadata     *Metadata          `json:"metadata,omitempty"`
+		Options      map[string]*string `json:"options,omitempty"`
+		CreatedAt    string             `json:"created_at,omitempty"`
 
 		Relationships struct {
 			Space struct {
@@ -115,6 +127,8 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 	r.URL = alias.URL
 	r.Destinations = alias.Destinations
 	r.Metadata = alias.Metadata
+	r.Options = alias.Options
+	r.CreatedAt = alias.CreatedAt
 
 	return nil
 }
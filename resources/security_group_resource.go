@@ -16,8 +16,21 @@ type SecurityGroup struct {
 	RunningSpaceGUIDs      []string `jsonry:"relationships.running_spaces.data[].guid,omitempty"`
 }
 
+// MarshalJSON omits nil Rules, but sends an empty list of rules as such so
+// that an update can remove all the rules of a security group.
 func (sg SecurityGroup) MarshalJSON() ([]byte, error) {
-	return jsonry.Marshal(sg)
+	data, err := jsonry.Marshal(sg)
+	if err != nil || sg.Rules == nil || len(sg.Rules) > 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+	fields["rules"] = json.RawMessage("[]")
+	return json.Marshal(fields)
 }
 
 func (sg *SecurityGroup) UnmarshalJSON(data []byte) error {
@@ -139,6 +139,21 @@ var _ = Describe("Security group resource", func() {
 			},
 			`{"rules":[{"protocol":"udp","destination":"another-destination"}]}`,
 		),
+		Entry(
+			"no rules",
+			SecurityGroup{
+				GUID:  "security-group-guid",
+				Rules: []Rule{},
+			},
+			`{"guid":"security-group-guid","rules":[]}`,
+		),
+		Entry(
+			"nil rules",
+			SecurityGroup{
+				GUID: "security-group-guid",
+			},
+			`{"guid":"security-group-guid"}`,
+		),
 		Entry(
 			"name and rules",
 			SecurityGroup{
@@ -27,6 +27,10 @@ type ServiceCredentialBinding struct {
 	AppName string `jsonry:"-"`
 	// AppSpaceGUID is the space guid of the app. It is not part of the API response, and is here as pragmatic convenience.
 	AppSpaceGUID string `jsonry:"-"`
+	// ServiceInstanceName is the service instance name. It is not part of the API response, and is here as pragmatic convenience.
+	ServiceInstanceName string `jsonry:"-"`
+	// CreatedAt is the time when the binding was created
+	CreatedAt string `jsonry:"created_at,omitempty"`
 	// LastOperation is the last operation on the service credential binding
 	LastOperation LastOperation `jsonry:"last_operation"`
 	// Parameters can be specified when creating a binding
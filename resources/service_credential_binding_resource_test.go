@@ -28,6 +28,7 @@ var _ = Describe("service credential binding resource", func() {
 		Entry("type", ServiceCredentialBinding{Type: "fake-type"}, `{"type": "fake-type"}`),
 		Entry("name", ServiceCredentialBinding{Name: "fake-name"}, `{"name": "fake-name"}`),
 		Entry("guid", ServiceCredentialBinding{GUID: "fake-guid"}, `{"guid": "fake-guid"}`),
+		Entry("created at", ServiceCredentialBinding{CreatedAt: "2026-01-02T03:04:05Z"}, `{"created_at": "2026-01-02T03:04:05Z"}`),
 		Entry("service instance guid guid",
 			ServiceCredentialBinding{ServiceInstanceGUID: "fake-instance-guid"},
 			`{ "relationships": { "service_instance": { "data": { "guid": "fake-instance-guid" } } } }`,
@@ -22,6 +22,8 @@ type ServiceOffering struct {
 	ServiceBrokerName string `json:"-"`
 	// Shareable if the offering support service instance sharing
 	AllowsInstanceSharing bool `json:"shareable"`
+	// BrokerCatalogID is the ID of the offering in the catalog of the service broker
+	BrokerCatalogID string `jsonry:"broker_catalog.id"`
 
 	Metadata *Metadata `json:"metadata"`
 }
@@ -31,6 +31,14 @@ type ServicePlan struct {
 	MaintenanceInfoDescription string `jsonry:"maintenance_info.description"`
 	// MaintenanceInfoVersion is the version of the service plan
 	MaintenanceInfoVersion string `jsonry:"maintenance_info.version"`
+	// ServiceInstanceCreateSchema is the JSON schema for parameters when creating a service instance
+	ServiceInstanceCreateSchema map[string]interface{} `jsonry:"schemas.service_instance.create.parameters"`
+	// ServiceInstanceUpdateSchema is the JSON schema for parameters when updating a service instance
+	ServiceInstanceUpdateSchema map[string]interface{} `jsonry:"schemas.service_instance.update.parameters"`
+	// ServiceBindingCreateSchema is the JSON schema for parameters when creating a service binding
+	ServiceBindingCreateSchema map[string]interface{} `jsonry:"schemas.service_binding.create.parameters"`
+	// BrokerCatalogID is the ID of the plan in the catalog of the service broker
+	BrokerCatalogID string `jsonry:"broker_catalog.id"`
 
 	Metadata *Metadata `json:"metadata"`
 }
@@ -62,6 +62,7 @@ var _ = Describe("service plan resource", func() {
 				SpaceGUID:                  "fake-space-guid",
 				MaintenanceInfoDescription: "cool upgrade",
 				MaintenanceInfoVersion:     "1.2.3",
+				BrokerCatalogID:            "fake-catalog-id",
 				Metadata: &Metadata{
 					Labels: map[string]types.NullString{
 						"foo": types.NewNullString("bar"),
@@ -92,6 +93,9 @@ var _ = Describe("service plan resource", func() {
 					"description": "cool upgrade",
 					"version": "1.2.3"
 				},
+				"broker_catalog": {
+					"id": "fake-catalog-id"
+				},
 				"metadata": {
 					"labels": {
 						"foo": "bar",
@@ -1,9 +1,64 @@
 package resources
 
-import "code.cloudfoundry.org/cli/types"
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/types"
+)
 
 type Sidecar struct {
-	GUID    string               `json:"guid"`
-	Name    string               `json:"name"`
-	Command types.FilteredString `json:"command"`
+	GUID         string
+	Name         string
+	Command      types.FilteredString
+	ProcessTypes []string
+	MemoryInMB   types.NullUint64
+}
+
+// MarshalJSON converts a Sidecar into a Cloud Controller Sidecar. Only the
+// fields that are set are included, so the same body can be used to create
+// and to update a sidecar.
+func (s Sidecar) MarshalJSON() ([]byte, error) {
+	var ccSidecar struct {
+		Name         string      `json:"name,omitempty"`
+		Command      interface{} `json:"command,omitempty"`
+		ProcessTypes []string    `json:"process_types,omitempty"`
+		MemoryInMB   json.Number `json:"memory_in_mb,omitempty"`
+	}
+
+	ccSidecar.Name = s.Name
+	if s.Command.IsSet {
+		ccSidecar.Command = &s.Command
+	}
+	ccSidecar.ProcessTypes = s.ProcessTypes
+	if s.MemoryInMB.IsSet {
+		ccSidecar.MemoryInMB = json.Number(fmt.Sprint(s.MemoryInMB.Value))
+	}
+
+	return json.Marshal(ccSidecar)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Sidecar response.
+func (s *Sidecar) UnmarshalJSON(data []byte) error {
+	var ccSidecar struct {
+		GUID         string               `json:"guid"`
+		Name         string               `json:"name"`
+		Command      types.FilteredString `json:"command"`
+		ProcessTypes []string             `json:"process_types"`
+		MemoryInMB   types.NullUint64     `json:"memory_in_mb"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccSidecar)
+	if err != nil {
+		return err
+	}
+
+	s.GUID = ccSidecar.GUID
+	s.Name = ccSidecar.Name
+	s.Command = ccSidecar.Command
+	s.ProcessTypes = ccSidecar.ProcessTypes
+	s.MemoryInMB = ccSidecar.MemoryInMB
+
+	return nil
 }
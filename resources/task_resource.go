@@ -13,6 +13,8 @@ type Task struct {
 	CreatedAt string `json:"created_at,omitempty"`
 	// DiskInMB represents the disk in MB allocated for the task.
 	DiskInMB uint64 `json:"disk_in_mb,omitempty"`
+	// DropletGUID represents the droplet the task runs on.
+	DropletGUID string `json:"droplet_guid,omitempty"`
 	// GUID represents the unique task identifier.
 	GUID string `json:"guid,omitempty"`
 	// LogRateLimitInBPS represents the log rate limit in bytes allocated for the task.
@@ -24,6 +26,8 @@ type Task struct {
 	// SequenceID represents the user-facing id of the task. This number is
 	// unique for every task associated with a given app.
 	SequenceID int64 `json:"sequence_id,omitempty"`
+	// Result contains the failure reason of a failed task.
+	Result *TaskResult `json:"result,omitempty"`
 	// State represents the task state.
 	State constant.TaskState `json:"state,omitempty"`
 	// Tasks can use a process as a template to fill in
@@ -34,6 +38,10 @@ type Task struct {
 	Template *TaskTemplate `json:"template,omitempty"`
 }
 
+type TaskResult struct {
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
 type TaskTemplate struct {
 	Process TaskProcessTemplate `json:"process,omitempty"`
 }
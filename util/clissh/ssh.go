@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net"
 	"os"
 	"os/signal"
@@ -205,6 +206,34 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	return result
 }
 
+// Run runs the command in a new session without a terminal and returns what
+// it writes to standard output. A non-zero exit status is returned as an
+// *ssh.ExitError along with the output.
+func (c *SecureShell) Run(command string) ([]byte, error) {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = session.Start(command)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := ioutil.ReadAll(outPipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, session.Wait()
+}
+
 func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward) error {
 	for _, spec := range localPortForwardSpecs {
 		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
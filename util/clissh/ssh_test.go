@@ -13,6 +13,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -820,6 +821,65 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("Run", func() {
+		var (
+			output []byte
+			runErr error
+		)
+
+		BeforeEach(func() {
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("some-output"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+			output, runErr = secureShell.Run("some-command")
+		})
+
+		It("runs the command without a terminal and returns its output", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(output).To(Equal([]byte("some-output")))
+
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("some-command"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		When("the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit-error"))
+			})
+
+			It("returns the output and the error", func() {
+				Expect(runErr).To(MatchError("exit-error"))
+				Expect(output).To(Equal([]byte("some-output")))
+			})
+		})
+
+		When("the command cannot be started", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("start-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("start-error"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the session cannot be allocated", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("session-error"))
+			})
+
+			It("returns an error", func() {
+				Expect(runErr).To(MatchError("SSH session allocation failed: session-error"))
+			})
+		})
+	})
+
 	Describe("LocalPortForward", func() {
 		var (
 			forwardErr error
@@ -0,0 +1,375 @@
+// Package jsonschema validates JSON documents against the subset of JSON
+// Schema that service brokers commonly publish for plan parameters.
+// Keywords that are not understood are ignored, so an unsupported schema
+// never causes valid parameters to be rejected.
+package jsonschema
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ValidationError describes a single part of a document that does not
+// satisfy the schema. Path is a JSONPath-style location such as
+// "$.nodes[0].size".
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Validate checks document, which must be the result of decoding JSON into
+// an interface{}, against schema. It returns every violation found, ordered
+// by path.
+func Validate(schema map[string]interface{}, document interface{}) []ValidationError {
+	var errs []ValidationError
+	validate(schema, document, "$", &errs)
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Path < errs[j].Path })
+	return errs
+}
+
+func validate(schema map[string]interface{}, value interface{}, path string, errs *[]ValidationError) {
+	if schema == nil {
+		return
+	}
+
+	addError := func(format string, args ...interface{}) {
+		*errs = append(*errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if types, ok := schemaTypes(schema["type"]); ok && !matchesAnyType(value, types) {
+		addError("expected %s, got %s", strings.Join(types, " or "), typeName(value))
+		return
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		addError("must be one of %s", formatValues(enum))
+	}
+
+	if constant, ok := schema["const"]; ok && !reflect.DeepEqual(constant, value) {
+		addError("must be %s", formatValue(constant))
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		validateObject(schema, v, path, errs, addError)
+	case []interface{}:
+		validateArray(schema, v, path, errs, addError)
+	case string:
+		validateString(schema, v, addError)
+	case float64:
+		validateNumber(schema, v, addError)
+	}
+
+	validateCombinators(schema, value, path, errs, addError)
+}
+
+func validateObject(schema map[string]interface{}, object map[string]interface{}, path string, errs *[]ValidationError, addError func(string, ...interface{})) {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if key, ok := name.(string); ok {
+				if _, present := object[key]; !present {
+					addError("missing required property '%s'", key)
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	patternProperties := compilePatternProperties(schema["patternProperties"])
+	for _, key := range sortedKeys(object) {
+		childPath := path + "." + key
+		declared := false
+		if propertySchema, ok := properties[key].(map[string]interface{}); ok {
+			validate(propertySchema, object[key], childPath, errs)
+			declared = true
+		}
+		for _, candidate := range patternProperties {
+			if candidate.pattern.MatchString(key) {
+				validate(candidate.schema, object[key], childPath, errs)
+				declared = true
+			}
+		}
+		if declared {
+			continue
+		}
+
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				*errs = append(*errs, ValidationError{Path: childPath, Message: "additional property is not allowed"})
+			}
+		case map[string]interface{}:
+			validate(additional, object[key], childPath, errs)
+		}
+	}
+
+	if min, ok := number(schema["minProperties"]); ok && float64(len(object)) < min {
+		addError("must have at least %s properties", formatNumber(min))
+	}
+	if max, ok := number(schema["maxProperties"]); ok && float64(len(object)) > max {
+		addError("must have at most %s properties", formatNumber(max))
+	}
+}
+
+type patternProperty struct {
+	pattern *regexp.Regexp
+	schema  map[string]interface{}
+}
+
+// compilePatternProperties returns the patternProperties of a schema ordered
+// by pattern. Patterns that are not valid regular expressions are skipped.
+func compilePatternProperties(value interface{}) []patternProperty {
+	schemas, _ := value.(map[string]interface{})
+
+	var patternProperties []patternProperty
+	for _, pattern := range sortedKeys(schemas) {
+		schema, ok := schemas[pattern].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			continue
+		}
+		patternProperties = append(patternProperties, patternProperty{pattern: re, schema: schema})
+	}
+
+	return patternProperties
+}
+
+func validateArray(schema map[string]interface{}, array []interface{}, path string, errs *[]ValidationError, addError func(string, ...interface{})) {
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		for i, item := range array {
+			validate(items, item, fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	}
+
+	if min, ok := number(schema["minItems"]); ok && float64(len(array)) < min {
+		addError("must have at least %s items", formatNumber(min))
+	}
+	if max, ok := number(schema["maxItems"]); ok && float64(len(array)) > max {
+		addError("must have at most %s items", formatNumber(max))
+	}
+	if unique, ok := schema["uniqueItems"].(bool); ok && unique {
+		for i := range array {
+			for j := i + 1; j < len(array); j++ {
+				if reflect.DeepEqual(array[i], array[j]) {
+					addError("items must be unique")
+					return
+				}
+			}
+		}
+	}
+}
+
+func validateString(schema map[string]interface{}, str string, addError func(string, ...interface{})) {
+	length := float64(len([]rune(str)))
+	if min, ok := number(schema["minLength"]); ok && length < min {
+		addError("must be at least %s characters long", formatNumber(min))
+	}
+	if max, ok := number(schema["maxLength"]); ok && length > max {
+		addError("must be at most %s characters long", formatNumber(max))
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(str) {
+			addError("must match pattern '%s'", pattern)
+		}
+	}
+}
+
+// validateNumber checks the numeric keywords. exclusiveMinimum and
+// exclusiveMaximum are numbers from draft-06 on, and booleans that make
+// minimum and maximum exclusive in draft-04, which service brokers use.
+func validateNumber(schema map[string]interface{}, num float64, addError func(string, ...interface{})) {
+	exclusiveMin, _ := schema["exclusiveMinimum"].(bool)
+	exclusiveMax, _ := schema["exclusiveMaximum"].(bool)
+
+	if min, ok := number(schema["minimum"]); ok {
+		switch {
+		case exclusiveMin && num <= min:
+			addError("must be greater than %s", formatNumber(min))
+		case !exclusiveMin && num < min:
+			addError("must be greater than or equal to %s", formatNumber(min))
+		}
+	}
+	if max, ok := number(schema["maximum"]); ok {
+		switch {
+		case exclusiveMax && num >= max:
+			addError("must be less than %s", formatNumber(max))
+		case !exclusiveMax && num > max:
+			addError("must be less than or equal to %s", formatNumber(max))
+		}
+	}
+	if min, ok := number(schema["exclusiveMinimum"]); ok && num <= min {
+		addError("must be greater than %s", formatNumber(min))
+	}
+	if max, ok := number(schema["exclusiveMaximum"]); ok && num >= max {
+		addError("must be less than %s", formatNumber(max))
+	}
+	if multiple, ok := number(schema["multipleOf"]); ok && multiple > 0 {
+		if quotient := num / multiple; quotient != math.Trunc(quotient) {
+			addError("must be a multiple of %s", formatNumber(multiple))
+		}
+	}
+}
+
+func validateCombinators(schema map[string]interface{}, value interface{}, path string, errs *[]ValidationError, addError func(string, ...interface{})) {
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			if subSchema, ok := sub.(map[string]interface{}); ok {
+				validate(subSchema, value, path, errs)
+			}
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok && countMatching(anyOf, value) == 0 {
+		addError("must match at least one of the allowed schemas")
+	}
+
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		if matching := countMatching(oneOf, value); matching != 1 {
+			addError("must match exactly one of the allowed schemas, matched %d", matching)
+		}
+	}
+
+	if not, ok := schema["not"].(map[string]interface{}); ok && countMatching([]interface{}{not}, value) == 1 {
+		addError("must not match the disallowed schema")
+	}
+}
+
+func countMatching(schemas []interface{}, value interface{}) int {
+	matching := 0
+	for _, sub := range schemas {
+		if subSchema, ok := sub.(map[string]interface{}); ok && len(Validate(subSchema, value)) == 0 {
+			matching++
+		}
+	}
+	return matching
+}
+
+func schemaTypes(raw interface{}) ([]string, bool) {
+	switch t := raw.(type) {
+	case string:
+		return []string{t}, true
+	case []interface{}:
+		var types []string
+		for _, entry := range t {
+			if name, ok := entry.(string); ok {
+				types = append(types, name)
+			}
+		}
+		return types, len(types) > 0
+	}
+	return nil, false
+}
+
+func matchesAnyType(value interface{}, types []string) bool {
+	for _, t := range types {
+		if matchesType(value, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesType(value interface{}, t string) bool {
+	switch t {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		num, ok := value.(float64)
+		return ok && num == math.Trunc(num)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	}
+	return true
+}
+
+func typeName(value interface{}) string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, candidate := range values {
+		if reflect.DeepEqual(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func number(raw interface{}) (float64, bool) {
+	num, ok := raw.(float64)
+	return num, ok
+}
+
+func formatNumber(num float64) string {
+	return fmt.Sprintf("%g", num)
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return fmt.Sprintf("'%s'", v)
+	case float64:
+		return formatNumber(v)
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func formatValues(values []interface{}) string {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, formatValue(value))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,136 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/util/jsonschema"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	decode := func(raw string) interface{} {
+		var value interface{}
+		Expect(json.Unmarshal([]byte(raw), &value)).To(Succeed())
+		return value
+	}
+
+	validate := func(schema, document string) []ValidationError {
+		return Validate(decode(schema).(map[string]interface{}), decode(document))
+	}
+
+	It("accepts any document for an empty schema", func() {
+		Expect(validate(`{}`, `{"anything": [1, "two"]}`)).To(BeEmpty())
+	})
+
+	It("accepts a document that satisfies the schema", func() {
+		schema := `{
+			"type": "object",
+			"required": ["size"],
+			"properties": {
+				"size": {"type": "string", "enum": ["small", "large"]},
+				"replicas": {"type": "integer", "minimum": 1}
+			}
+		}`
+		Expect(validate(schema, `{"size": "small", "replicas": 3}`)).To(BeEmpty())
+	})
+
+	It("reports every violation with its path, ordered by path", func() {
+		schema := `{
+			"type": "object",
+			"required": ["size"],
+			"additionalProperties": false,
+			"properties": {
+				"replicas": {"type": "integer", "minimum": 1},
+				"nodes": {
+					"type": "array",
+					"items": {
+						"type": "object",
+						"properties": {"name": {"type": "string", "pattern": "^[a-z]+$"}}
+					}
+				}
+			}
+		}`
+		document := `{"replicas": 0, "nodes": [{"name": "ok"}, {"name": "NOT-OK"}], "colour": "blue"}`
+
+		Expect(validate(schema, document)).To(Equal([]ValidationError{
+			{Path: "$", Message: "missing required property 'size'"},
+			{Path: "$.colour", Message: "additional property is not allowed"},
+			{Path: "$.nodes[1].name", Message: "must match pattern '^[a-z]+$'"},
+			{Path: "$.replicas", Message: "must be greater than or equal to 1"},
+		}))
+	})
+
+	It("formats errors as path and message", func() {
+		err := ValidationError{Path: "$.size", Message: "expected string, got integer"}
+		Expect(err.Error()).To(Equal("$.size: expected string, got integer"))
+	})
+
+	DescribeTable("numbers on the boundary",
+		func(schema, document string) {
+			Expect(validate(schema, document)).To(BeEmpty())
+		},
+		Entry("draft-04 non-exclusive minimum", `{"minimum": 1, "exclusiveMinimum": false}`, `1`),
+		Entry("draft-04 exclusive minimum", `{"minimum": 1, "exclusiveMinimum": true}`, `1.5`),
+		Entry("draft-04 exclusive maximum", `{"maximum": 5, "exclusiveMaximum": true}`, `4`),
+		Entry("draft-06 exclusive minimum", `{"exclusiveMinimum": 1}`, `2`),
+	)
+
+	It("ignores keywords it does not understand", func() {
+		Expect(validate(`{"format": "uuid", "x-custom": true}`, `"not-a-uuid"`)).To(BeEmpty())
+	})
+
+	DescribeTable("single keyword violations",
+		func(schema, document, message string) {
+			Expect(validate(schema, document)).To(ConsistOf(ValidationError{Path: "$", Message: message}))
+		},
+		Entry("type", `{"type": "string"}`, `42`, "expected string, got integer"),
+		Entry("type list", `{"type": ["string", "null"]}`, `true`, "expected string or null, got boolean"),
+		Entry("integer", `{"type": "integer"}`, `1.5`, "expected integer, got number"),
+		Entry("enum", `{"enum": ["a", 1]}`, `"b"`, "must be one of 'a', 1"),
+		Entry("const", `{"const": "fixed"}`, `"other"`, "must be 'fixed'"),
+		Entry("maximum", `{"maximum": 10}`, `11`, "must be less than or equal to 10"),
+		Entry("exclusiveMinimum", `{"exclusiveMinimum": 0}`, `0`, "must be greater than 0"),
+		Entry("exclusiveMaximum", `{"exclusiveMaximum": 5}`, `5`, "must be less than 5"),
+		Entry("draft-04 exclusiveMinimum", `{"minimum": 1, "exclusiveMinimum": true}`, `1`, "must be greater than 1"),
+		Entry("draft-04 exclusiveMaximum", `{"maximum": 5, "exclusiveMaximum": true}`, `5`, "must be less than 5"),
+		Entry("draft-04 non-exclusive minimum", `{"minimum": 1, "exclusiveMinimum": false}`, `0`, "must be greater than or equal to 1"),
+		Entry("multipleOf", `{"multipleOf": 2}`, `3`, "must be a multiple of 2"),
+		Entry("minLength", `{"minLength": 3}`, `"ab"`, "must be at least 3 characters long"),
+		Entry("maxLength", `{"maxLength": 1}`, `"ab"`, "must be at most 1 characters long"),
+		Entry("minItems", `{"minItems": 1}`, `[]`, "must have at least 1 items"),
+		Entry("maxItems", `{"maxItems": 1}`, `[1, 2]`, "must have at most 1 items"),
+		Entry("uniqueItems", `{"uniqueItems": true}`, `[1, 1]`, "items must be unique"),
+		Entry("minProperties", `{"minProperties": 1}`, `{}`, "must have at least 1 properties"),
+		Entry("anyOf", `{"anyOf": [{"type": "string"}, {"type": "boolean"}]}`, `1`, "must match at least one of the allowed schemas"),
+		Entry("oneOf", `{"oneOf": [{"type": "number"}, {"type": "integer"}]}`, `1`, "must match exactly one of the allowed schemas, matched 2"),
+		Entry("not", `{"not": {"type": "string"}}`, `"s"`, "must not match the disallowed schema"),
+	)
+
+	It("validates additional properties against a schema", func() {
+		Expect(validate(`{"additionalProperties": {"type": "string"}}`, `{"a": "ok", "b": 2}`)).To(ConsistOf(
+			ValidationError{Path: "$.b", Message: "expected string, got integer"},
+		))
+	})
+
+	It("treats properties matching patternProperties as declared", func() {
+		schema := `{
+			"additionalProperties": false,
+			"properties": {"name": {"type": "string"}},
+			"patternProperties": {"^x-": {"type": "string"}, "^x-count": {"type": "integer"}}
+		}`
+
+		Expect(validate(schema, `{"name": "db", "x-owner": "team", "x-count": "many", "other": 1}`)).To(ConsistOf(
+			ValidationError{Path: "$.other", Message: "additional property is not allowed"},
+			ValidationError{Path: "$.x-count", Message: "expected integer, got string"},
+		))
+	})
+
+	It("applies every schema in allOf", func() {
+		Expect(validate(`{"allOf": [{"required": ["a"]}, {"required": ["b"]}]}`, `{}`)).To(ConsistOf(
+			ValidationError{Path: "$", Message: "missing required property 'a'"},
+			ValidationError{Path: "$", Message: "missing required property 'b'"},
+		))
+	})
+})
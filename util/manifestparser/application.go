@@ -29,8 +29,10 @@ type Application struct {
 	NoRoute                 bool                     `yaml:"no-route,omitempty"`
 	RandomRoute             bool                     `yaml:"random-route,omitempty"`
 	DefaultRoute            bool                     `yaml:"default-route,omitempty"`
+	Routes                  []Route                  `yaml:"routes,omitempty"`
 	Stack                   string                   `yaml:"stack,omitempty"`
 	LogRateLimit            string                   `yaml:"log-rate-limit-per-second,omitempty"`
+	Tasks                   []Task                   `yaml:"tasks,omitempty"`
 	RemainingManifestFields map[string]interface{}   `yaml:"-,inline"`
 }
 
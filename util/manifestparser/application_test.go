@@ -160,6 +160,64 @@ random-route: true
 			})
 		})
 
+		Context("when routes are provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+routes:
+- route: myapp.example.com
+  options:
+    loadbalancing: least-connection
+- route: tcp.example.com:1024
+  protocol: tcp
+`)
+			})
+
+			It("unmarshals the routes with their options", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.Routes).To(Equal([]Route{
+					{Route: "myapp.example.com", Options: map[string]string{"loadbalancing": "least-connection"}},
+					{Route: "tcp.example.com:1024", Protocol: "tcp"},
+				}))
+				Expect(application.RemainingManifestFields).NotTo(HaveKey("routes"))
+			})
+
+			It("marshals the routes with their options", func() {
+				raw, err := yaml.Marshal(application)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(ContainSubstring(`routes:
+- route: myapp.example.com
+  options:
+    loadbalancing: least-connection
+- route: tcp.example.com:1024
+  protocol: tcp
+`))
+			})
+
+			When("a route has keys the CLI does not know about", func() {
+				BeforeEach(func() {
+					rawYAML = []byte(`---
+routes:
+- route: myapp.example.com
+  some-future-key: some-value
+`)
+				})
+
+				It("keeps them on the route", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(application.Routes).To(Equal([]Route{
+						{Route: "myapp.example.com", RemainingManifestFields: map[string]interface{}{"some-future-key": "some-value"}},
+					}))
+
+					raw, err := yaml.Marshal(application)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(raw)).To(ContainSubstring(`routes:
+- route: myapp.example.com
+  some-future-key: some-value
+`))
+				})
+			})
+		})
+
 		Context("when buildpacks is provided", func() {
 			BeforeEach(func() {
 				rawYAML = []byte(`---
@@ -0,0 +1,12 @@
+package manifestparser
+
+import "fmt"
+
+type InvalidTaskEnvNameError struct {
+	TaskName string
+	EnvName  string
+}
+
+func (e InvalidTaskEnvNameError) Error() string {
+	return fmt.Sprintf("Invalid environment variable name '%s' in task '%s': names must match [A-Za-z_][A-Za-z0-9_]*", e.EnvName, e.TaskName)
+}
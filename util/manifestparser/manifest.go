@@ -41,6 +41,46 @@ func (m Manifest) GetFirstAppWebProcess() *Process {
 	return nil
 }
 
+// GetAppByName returns the application named appName.
+func (m Manifest) GetAppByName(appName string) (Application, error) {
+	for _, app := range m.Applications {
+		if app.Name == appName {
+			return app, nil
+		}
+	}
+
+	return Application{}, AppNotInManifestError{Name: appName}
+}
+
+// GetTaskTemplate returns the task named taskName from the `tasks` section
+// of the application named appName. The names of the task's environment
+// variables must be valid shell variable names.
+func (m Manifest) GetTaskTemplate(appName string, taskName string) (Task, error) {
+	for _, app := range m.Applications {
+		if app.Name != appName {
+			continue
+		}
+
+		for _, task := range app.Tasks {
+			if task.Name != taskName {
+				continue
+			}
+
+			for envName := range task.Env {
+				if !taskEnvNameRegexp.MatchString(envName) {
+					return Task{}, InvalidTaskEnvNameError{TaskName: taskName, EnvName: envName}
+				}
+			}
+
+			return task, nil
+		}
+
+		return Task{}, TaskNotInManifestError{AppName: appName, TaskName: taskName}
+	}
+
+	return Task{}, AppNotInManifestError{Name: appName}
+}
+
 func (m Manifest) HasAppWithNoName() bool {
 	for _, app := range m.Applications {
 		if app.Name == "" {
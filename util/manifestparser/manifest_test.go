@@ -170,6 +170,59 @@ var _ = Describe("Manifest", func() {
 		})
 	})
 
+	Describe("GetAppByName", func() {
+		BeforeEach(func() {
+			manifest.Applications = []Application{{Name: "app1"}, {Name: "app2", Path: "some-path"}}
+		})
+
+		It("returns the app with the given name", func() {
+			app, err := manifest.GetAppByName("app2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(app).To(Equal(Application{Name: "app2", Path: "some-path"}))
+		})
+
+		It("returns an error when the app is not in the manifest", func() {
+			_, err := manifest.GetAppByName("app3")
+			Expect(err).To(MatchError(AppNotInManifestError{Name: "app3"}))
+		})
+	})
+
+	Describe("GetTaskTemplate", func() {
+		BeforeEach(func() {
+			manifest.Applications = []Application{
+				{
+					Name: "app1",
+					Tasks: []Task{
+						{Name: "migrate", Command: "rake db:migrate", Memory: "256M"},
+					},
+				},
+			}
+		})
+
+		It("returns the task from the app's tasks section", func() {
+			task, err := manifest.GetTaskTemplate("app1", "migrate")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(task).To(Equal(Task{Name: "migrate", Command: "rake db:migrate", Memory: "256M"}))
+		})
+
+		It("returns an error when the task is not defined", func() {
+			_, err := manifest.GetTaskTemplate("app1", "seed")
+			Expect(err).To(MatchError(TaskNotInManifestError{AppName: "app1", TaskName: "seed"}))
+		})
+
+		It("returns an error when an environment variable name is not a valid shell name", func() {
+			manifest.Applications[0].Tasks[0].Env = map[string]string{"RAILS_ENV": "prod", "X;rm -rf /": "1"}
+
+			_, err := manifest.GetTaskTemplate("app1", "migrate")
+			Expect(err).To(MatchError(InvalidTaskEnvNameError{TaskName: "migrate", EnvName: "X;rm -rf /"}))
+		})
+
+		It("returns an error when the app is not in the manifest", func() {
+			_, err := manifest.GetTaskTemplate("app2", "migrate")
+			Expect(err).To(MatchError(AppNotInManifestError{Name: "app2"}))
+		})
+	})
+
 	Describe("has the correct attributes", func() {
 		It("it unmarshals with the version", func() {
 			manifestBytes := []byte(`---
@@ -73,6 +73,16 @@ func (m ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte)
 	return parsedManifest, nil
 }
 
+// MarshalManifest marshals the manifest so that it can be sent to the Cloud
+// Controller. Task templates are omitted because the Cloud Controller does
+// not know about them.
 func (m ManifestParser) MarshalManifest(manifest Manifest) ([]byte, error) {
+	applications := make([]Application, len(manifest.Applications))
+	for i, app := range manifest.Applications {
+		app.Tasks = nil
+		applications[i] = app
+	}
+	manifest.Applications = applications
+
 	return yaml.Marshal(manifest)
 }
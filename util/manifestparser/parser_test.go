@@ -325,5 +325,49 @@ applications:
     unknown-process-key: 2
 `))
 		})
+
+		It("round-trips sidecars", func() {
+			var manifest Manifest
+			err := yaml.Unmarshal([]byte(`applications:
+- name: app-1
+  sidecars:
+  - name: config-server
+    command: ./config-server
+    process_types: [web, worker]
+    memory: 128M
+`), &manifest)
+			Expect(err).NotTo(HaveOccurred())
+
+			rawManifest, err := parser.MarshalManifest(manifest)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rawManifest).To(MatchYAML(`applications:
+- name: app-1
+  sidecars:
+  - name: config-server
+    command: ./config-server
+    process_types: [web, worker]
+    memory: 128M
+`))
+		})
+
+		It("omits task templates", func() {
+			manifest := Manifest{
+				Applications: []Application{
+					{
+						Name:  "app-1",
+						Tasks: []Task{{Name: "migrate", Command: "rake db:migrate"}},
+					},
+				},
+			}
+
+			yaml, err := parser.MarshalManifest(manifest)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(yaml).To(MatchYAML(`applications:
+- name: app-1
+`))
+			Expect(manifest.Applications[0].Tasks).To(HaveLen(1))
+		})
 	})
 })
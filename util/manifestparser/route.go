@@ -0,0 +1,14 @@
+package manifestparser
+
+// Route is an entry of the `routes` section of an application in the
+// manifest.
+type Route struct {
+	Route    string `yaml:"route"`
+	Protocol string `yaml:"protocol,omitempty"`
+	// Options are per-route settings, such as
+	// `loadbalancing: least-connection`.
+	Options map[string]string `yaml:"options,omitempty"`
+	// RemainingManifestFields keeps the route keys the CLI does not know
+	// about, so that they are passed on to the server as written.
+	RemainingManifestFields map[string]interface{} `yaml:"-,inline"`
+}
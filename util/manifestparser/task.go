@@ -0,0 +1,17 @@
+package manifestparser
+
+import "regexp"
+
+var taskEnvNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Task is a named task definition from the `tasks` section of an
+// application in the manifest. It is only used by the CLI and is never sent
+// to the Cloud Controller.
+type Task struct {
+	Name         string            `yaml:"name"`
+	Command      string            `yaml:"command,omitempty"`
+	DiskQuota    string            `yaml:"disk_quota,omitempty"`
+	Memory       string            `yaml:"memory,omitempty"`
+	LogRateLimit string            `yaml:"log-rate-limit-per-second,omitempty"`
+	Env          map[string]string `yaml:"env,omitempty"`
+}
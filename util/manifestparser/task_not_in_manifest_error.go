@@ -0,0 +1,12 @@
+package manifestparser
+
+import "fmt"
+
+type TaskNotInManifestError struct {
+	AppName  string
+	TaskName string
+}
+
+func (e TaskNotInManifestError) Error() string {
+	return fmt.Sprintf("Could not find task named '%s' for app '%s' in manifest", e.TaskName, e.AppName)
+}
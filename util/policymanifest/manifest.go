@@ -0,0 +1,115 @@
+// Package policymanifest reads and writes files that declare the network
+// policies between apps.
+package policymanifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+type App struct {
+	Name string `yaml:"app"`
+	// Space is the space of the app. When empty, the targeted space is used.
+	Space string `yaml:"space,omitempty"`
+	// Org is the org of the space. When empty, the targeted org is used.
+	Org string `yaml:"org,omitempty"`
+}
+
+type Policy struct {
+	Source      App    `yaml:"source"`
+	Destination App    `yaml:"destination"`
+	Protocol    string `yaml:"protocol"`
+	// Ports is a single port, such as 8080, or a range, such as 8080-8090.
+	Ports string `yaml:"ports"`
+}
+
+type Manifest struct {
+	Policies []Policy `yaml:"network_policies"`
+}
+
+type InvalidManifestError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidManifestError) Error() string {
+	return fmt.Sprintf("Invalid network policies file '%s': %s", e.Path, e.Reason)
+}
+
+// Read parses and validates the network policies file at path.
+func Read(path string) (Manifest, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var manifest Manifest
+	if err := yaml.Unmarshal(raw, &manifest); err != nil {
+		return Manifest{}, InvalidManifestError{Path: path, Reason: err.Error()}
+	}
+
+	for i, policy := range manifest.Policies {
+		switch {
+		case policy.Source.Name == "":
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("policy %d has no source app", i+1)}
+		case policy.Destination.Name == "":
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("policy %d has no destination app", i+1)}
+		case policy.Protocol != "tcp" && policy.Protocol != "udp":
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("policy %d must have protocol tcp or udp", i+1)}
+		}
+
+		if _, _, err := ParsePorts(policy.Ports); err != nil {
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("policy %d has invalid ports: %s", i+1, err)}
+		}
+	}
+
+	return manifest, nil
+}
+
+// Marshal returns the YAML form of the manifest.
+func Marshal(manifest Manifest) ([]byte, error) {
+	return yaml.Marshal(manifest)
+}
+
+// ParsePorts returns the first and last port of a single port or a port
+// range.
+func ParsePorts(ports string) (int, int, error) {
+	startText, endText := ports, ports
+	if i := strings.Index(ports, "-"); i >= 0 {
+		startText, endText = ports[:i], ports[i+1:]
+	}
+
+	start, err := parsePort(startText)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parsePort(endText)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if end < start {
+		return 0, 0, fmt.Errorf("range '%s' ends before it starts", ports)
+	}
+	return start, end, nil
+}
+
+// FormatPorts is the inverse of ParsePorts.
+func FormatPorts(start int, end int) string {
+	if start == end {
+		return strconv.Itoa(start)
+	}
+	return fmt.Sprintf("%d-%d", start, end)
+}
+
+func parsePort(text string) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(text))
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("'%s' is not a port between 1 and 65535", text)
+	}
+	return port, nil
+}
@@ -0,0 +1,146 @@
+package policymanifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/policymanifest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Read", func() {
+	var (
+		dir      string
+		path     string
+		contents string
+		manifest Manifest
+		err      error
+	)
+
+	BeforeEach(func() {
+		dir, err = ioutil.TempDir("", "policymanifest-test")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "policies.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		manifest, err = Read(path)
+	})
+
+	When("the file is valid", func() {
+		BeforeEach(func() {
+			contents = `---
+network_policies:
+- source:
+    app: frontend
+  destination:
+    app: backend
+  protocol: tcp
+  ports: 8080
+- source:
+    org: org-a
+    space: space-a
+    app: backend
+  destination:
+    org: org-b
+    space: space-b
+    app: db
+  protocol: udp
+  ports: 5000-5010
+`
+		})
+
+		It("returns the declared policies", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifest).To(Equal(Manifest{
+				Policies: []Policy{
+					{
+						Source:      App{Name: "frontend"},
+						Destination: App{Name: "backend"},
+						Protocol:    "tcp",
+						Ports:       "8080",
+					},
+					{
+						Source:      App{Org: "org-a", Space: "space-a", Name: "backend"},
+						Destination: App{Org: "org-b", Space: "space-b", Name: "db"},
+						Protocol:    "udp",
+						Ports:       "5000-5010",
+					},
+				},
+			}))
+		})
+	})
+
+	DescribeTable("invalid files",
+		func(policy string, reason string) {
+			Expect(ioutil.WriteFile(path, []byte("network_policies:\n"+policy), 0600)).To(Succeed())
+			_, err := Read(path)
+			Expect(err).To(MatchError(InvalidManifestError{Path: path, Reason: reason}))
+		},
+		Entry("missing source", "- destination: {app: b}\n  protocol: tcp\n  ports: 8080\n", "policy 1 has no source app"),
+		Entry("missing destination", "- source: {app: a}\n  protocol: tcp\n  ports: 8080\n", "policy 1 has no destination app"),
+		Entry("bad protocol", "- source: {app: a}\n  destination: {app: b}\n  protocol: icmp\n  ports: 8080\n", "policy 1 must have protocol tcp or udp"),
+		Entry("bad ports", "- source: {app: a}\n  destination: {app: b}\n  protocol: tcp\n  ports: http\n", "policy 1 has invalid ports: 'http' is not a port between 1 and 65535"),
+	)
+
+	When("the file is not YAML", func() {
+		BeforeEach(func() {
+			contents = "network_policies: ["
+		})
+
+		It("returns an InvalidManifestError", func() {
+			Expect(err).To(BeAssignableToTypeOf(InvalidManifestError{}))
+		})
+	})
+})
+
+var _ = Describe("Marshal", func() {
+	It("writes a file that Read accepts", func() {
+		manifest := Manifest{
+			Policies: []Policy{{
+				Source:      App{Org: "org", Space: "space", Name: "a"},
+				Destination: App{Org: "org", Space: "space", Name: "b"},
+				Protocol:    "tcp",
+				Ports:       "8080-8081",
+			}},
+		}
+
+		raw, err := Marshal(manifest)
+		Expect(err).NotTo(HaveOccurred())
+
+		dir, err := ioutil.TempDir("", "policymanifest-test")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "policies.yml")
+		Expect(ioutil.WriteFile(path, raw, 0600)).To(Succeed())
+
+		Expect(Read(path)).To(Equal(manifest))
+	})
+})
+
+var _ = DescribeTable("ParsePorts",
+	func(ports string, start int, end int, errMessage string) {
+		actualStart, actualEnd, err := ParsePorts(ports)
+		if errMessage != "" {
+			Expect(err).To(MatchError(errMessage))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actualStart).To(Equal(start))
+		Expect(actualEnd).To(Equal(end))
+		Expect(FormatPorts(start, end)).To(Equal(ports))
+	},
+	Entry("single port", "8080", 8080, 8080, ""),
+	Entry("range", "8080-8090", 8080, 8090, ""),
+	Entry("reversed range", "8090-8080", 0, 0, "range '8090-8080' ends before it starts"),
+	Entry("out of range", "70000", 0, 0, "'70000' is not a port between 1 and 65535"),
+	Entry("empty", "", 0, 0, "'' is not a port between 1 and 65535"),
+)
@@ -0,0 +1,13 @@
+package policymanifest_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPolicymanifest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Policy Manifest Suite")
+}
@@ -0,0 +1,88 @@
+// Package securitygroupmanifest reads files that declare security groups,
+// their rules and where they are bound.
+package securitygroupmanifest
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+type Rule struct {
+	Protocol    string `yaml:"protocol"`
+	Destination string `yaml:"destination"`
+	Ports       string `yaml:"ports,omitempty"`
+	Type        *int   `yaml:"type,omitempty"`
+	Code        *int   `yaml:"code,omitempty"`
+	Description string `yaml:"description,omitempty"`
+	Log         *bool  `yaml:"log,omitempty"`
+}
+
+// Space is a space a security group is bound to.
+type Space struct {
+	Org   string `yaml:"org"`
+	Space string `yaml:"space"`
+}
+
+// GloballyEnabled declares whether a security group applies to the apps of
+// every space.
+type GloballyEnabled struct {
+	Running bool `yaml:"running,omitempty"`
+	Staging bool `yaml:"staging,omitempty"`
+}
+
+type SecurityGroup struct {
+	Name            string          `yaml:"name"`
+	Rules           []Rule          `yaml:"rules"`
+	GloballyEnabled GloballyEnabled `yaml:"globally_enabled,omitempty"`
+	RunningSpaces   []Space         `yaml:"running_spaces,omitempty"`
+	StagingSpaces   []Space         `yaml:"staging_spaces,omitempty"`
+}
+
+type Manifest struct {
+	SecurityGroups []SecurityGroup `yaml:"security_groups"`
+}
+
+type InvalidManifestError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidManifestError) Error() string {
+	return fmt.Sprintf("Invalid security groups file '%s': %s", e.Path, e.Reason)
+}
+
+// Read parses the security groups file at path and checks that every group
+// has a unique name and that every bound space names its org. The rules
+// themselves are not validated.
+func Read(path string) (Manifest, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var manifest Manifest
+	if err := yaml.UnmarshalStrict(raw, &manifest); err != nil {
+		return Manifest{}, InvalidManifestError{Path: path, Reason: err.Error()}
+	}
+
+	names := map[string]bool{}
+	for i, group := range manifest.SecurityGroups {
+		if group.Name == "" {
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("security group %d has no name", i+1)}
+		}
+		if names[group.Name] {
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("security group '%s' is declared more than once", group.Name)}
+		}
+		names[group.Name] = true
+
+		for _, space := range append(group.RunningSpaces, group.StagingSpaces...) {
+			if space.Org == "" || space.Space == "" {
+				return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("security group '%s' has a space binding without an org and a space", group.Name)}
+			}
+		}
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,114 @@
+package securitygroupmanifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/securitygroupmanifest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Read", func() {
+	var (
+		dir      string
+		path     string
+		contents string
+		manifest Manifest
+		err      error
+	)
+
+	BeforeEach(func() {
+		dir, err = ioutil.TempDir("", "securitygroupmanifest-test")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "groups.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		manifest, err = Read(path)
+	})
+
+	When("the file is valid", func() {
+		BeforeEach(func() {
+			contents = `---
+security_groups:
+- name: public
+  rules:
+  - protocol: tcp
+    destination: 0.0.0.0/0
+    ports: 443
+    description: https
+  globally_enabled:
+    running: true
+- name: backend
+  rules:
+  - protocol: icmp
+    destination: 10.0.0.0/8
+    type: 0
+    code: -1
+    log: true
+  running_spaces:
+  - {org: some-org, space: some-space}
+  staging_spaces:
+  - {org: some-org, space: other-space}
+`
+		})
+
+		It("returns the security groups", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			icmpType, icmpCode, log := 0, -1, true
+			Expect(manifest.SecurityGroups).To(Equal([]SecurityGroup{
+				{
+					Name:            "public",
+					Rules:           []Rule{{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: "443", Description: "https"}},
+					GloballyEnabled: GloballyEnabled{Running: true},
+				},
+				{
+					Name:          "backend",
+					Rules:         []Rule{{Protocol: "icmp", Destination: "10.0.0.0/8", Type: &icmpType, Code: &icmpCode, Log: &log}},
+					RunningSpaces: []Space{{Org: "some-org", Space: "some-space"}},
+					StagingSpaces: []Space{{Org: "some-org", Space: "other-space"}},
+				},
+			}))
+		})
+	})
+
+	DescribeTable("invalid files",
+		func(fileContents string, reason string) {
+			Expect(ioutil.WriteFile(path, []byte(fileContents), 0600)).To(Succeed())
+			_, err := Read(path)
+			Expect(err).To(MatchError(InvalidManifestError{Path: path, Reason: reason}))
+		},
+		Entry("no name", "security_groups:\n- rules: []\n",
+			"security group 1 has no name"),
+		Entry("duplicate name", "security_groups:\n- name: a\n- name: a\n",
+			"security group 'a' is declared more than once"),
+		Entry("space without org", "security_groups:\n- name: a\n  staging_spaces:\n  - {space: s}\n",
+			"security group 'a' has a space binding without an org and a space"),
+	)
+
+	When("the file has an unknown key", func() {
+		BeforeEach(func() {
+			contents = "security_groups:\n- name: a\n  running_space: []\n"
+		})
+
+		It("returns an InvalidManifestError", func() {
+			Expect(err).To(BeAssignableToTypeOf(InvalidManifestError{}))
+		})
+	})
+
+	When("the file does not exist", func() {
+		It("returns the error", func() {
+			_, err := Read(filepath.Join(dir, "missing.yml"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
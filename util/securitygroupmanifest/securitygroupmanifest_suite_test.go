@@ -0,0 +1,13 @@
+package securitygroupmanifest_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecuritygroupmanifest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Security Group Manifest Suite")
+}
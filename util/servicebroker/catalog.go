@@ -0,0 +1,71 @@
+// Package servicebroker fetches the catalog of a service broker directly from
+// the broker, using the Open Service Broker API.
+package servicebroker
+
+// Catalog is the response to GET /v2/catalog.
+type Catalog struct {
+	Services []Service `json:"services"`
+}
+
+type Service struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Tags        []string               `json:"tags"`
+	Metadata    map[string]interface{} `json:"metadata"`
+	Plans       []Plan                 `json:"plans"`
+}
+
+// Shareable is true when the broker allows instances of the service to be
+// shared between spaces.
+func (s Service) Shareable() bool {
+	shareable, _ := s.Metadata["shareable"].(bool)
+	return shareable
+}
+
+type Plan struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Free defaults to true when the broker does not specify it.
+	Free            *bool            `json:"free"`
+	MaintenanceInfo *MaintenanceInfo `json:"maintenance_info"`
+	Schemas         Schemas          `json:"schemas"`
+}
+
+// IsFree applies the default of the Open Service Broker API to Free.
+func (p Plan) IsFree() bool {
+	return p.Free == nil || *p.Free
+}
+
+// MaintenanceInfoVersion returns the version of the maintenance info of the
+// plan, or an empty string when the plan has none.
+func (p Plan) MaintenanceInfoVersion() string {
+	if p.MaintenanceInfo == nil {
+		return ""
+	}
+	return p.MaintenanceInfo.Version
+}
+
+type MaintenanceInfo struct {
+	Version     string `json:"version"`
+	Description string `json:"description"`
+}
+
+type Schemas struct {
+	ServiceInstance ServiceInstanceSchemas `json:"service_instance"`
+	ServiceBinding  ServiceBindingSchemas  `json:"service_binding"`
+}
+
+type ServiceInstanceSchemas struct {
+	Create SchemaParameters `json:"create"`
+	Update SchemaParameters `json:"update"`
+}
+
+type ServiceBindingSchemas struct {
+	Create SchemaParameters `json:"create"`
+}
+
+type SchemaParameters struct {
+	Parameters map[string]interface{} `json:"parameters"`
+}
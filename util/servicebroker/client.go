@@ -0,0 +1,74 @@
+package servicebroker
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/util"
+)
+
+// APIVersion is the version of the Open Service Broker API sent to brokers.
+const APIVersion = "2.16"
+
+type Client struct {
+	HTTPClient HTTPClient
+}
+
+func NewClient(dialTimeout time.Duration, skipSSLValidation bool) *Client {
+	tr := &http.Transport{
+		TLSClientConfig: util.NewTLSConfig(nil, skipSSLValidation),
+		Proxy:           http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: 30 * time.Second,
+			Timeout:   dialTimeout,
+		}).DialContext,
+	}
+
+	return &Client{
+		HTTPClient: &http.Client{
+			Transport: tr,
+		},
+	}
+}
+
+// GetCatalog fetches the catalog of the broker at brokerURL, authenticating
+// with the given basic auth credentials.
+func (client Client) GetCatalog(brokerURL, username, password string) (Catalog, error) {
+	request, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(brokerURL, "/")+"/v2/catalog", nil)
+	if err != nil {
+		return Catalog{}, err
+	}
+	request.SetBasicAuth(username, password)
+	request.Header.Set("X-Broker-API-Version", APIVersion)
+	request.Header.Set("Accept", "application/json")
+
+	response, err := client.HTTPClient.Do(request)
+	if err != nil {
+		return Catalog{}, err
+	}
+	defer response.Body.Close()
+
+	rawBytes, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return Catalog{}, err
+	}
+
+	if response.StatusCode >= 400 {
+		return Catalog{}, RawHTTPStatusError{
+			Status:      response.Status,
+			RawResponse: rawBytes,
+		}
+	}
+
+	var catalog Catalog
+	if err := json.Unmarshal(rawBytes, &catalog); err != nil {
+		return Catalog{}, fmt.Errorf("Invalid catalog from service broker: %s", err)
+	}
+
+	return catalog, nil
+}
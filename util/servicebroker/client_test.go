@@ -0,0 +1,137 @@
+package servicebroker_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/servicebroker"
+	"code.cloudfoundry.org/cli/util/servicebroker/servicebrokerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		fakeHTTPClient *servicebrokerfakes.FakeHTTPClient
+		client         *Client
+	)
+
+	BeforeEach(func() {
+		fakeHTTPClient = new(servicebrokerfakes.FakeHTTPClient)
+		client = &Client{
+			HTTPClient: fakeHTTPClient,
+		}
+	})
+
+	Describe("GetCatalog", func() {
+		var (
+			catalog    Catalog
+			executeErr error
+		)
+
+		respondWith := func(statusCode int, status, body string) {
+			fakeHTTPClient.DoReturns(&http.Response{
+				StatusCode: statusCode,
+				Status:     status,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil)
+		}
+
+		JustBeforeEach(func() {
+			catalog, executeErr = client.GetCatalog("https://broker.example.com/", "user", "pass")
+		})
+
+		When("the broker returns its catalog", func() {
+			BeforeEach(func() {
+				respondWith(http.StatusOK, "200 OK", `{
+					"services": [{
+						"id": "offering-id",
+						"name": "mysql",
+						"description": "MySQL databases",
+						"tags": ["sql"],
+						"metadata": {"shareable": true},
+						"plans": [
+							{
+								"id": "small-id",
+								"name": "small",
+								"description": "A small database",
+								"free": false,
+								"maintenance_info": {"version": "1.2.0"},
+								"schemas": {"service_instance": {"create": {"parameters": {"type": "object"}}}}
+							},
+							{"id": "large-id", "name": "large"}
+						]
+					}]
+				}`)
+			})
+
+			It("sends an authenticated catalog request", func() {
+				Expect(fakeHTTPClient.DoCallCount()).To(Equal(1))
+				request := fakeHTTPClient.DoArgsForCall(0)
+				Expect(request.Method).To(Equal(http.MethodGet))
+				Expect(request.URL.String()).To(Equal("https://broker.example.com/v2/catalog"))
+				Expect(request.Header.Get("X-Broker-API-Version")).To(Equal(APIVersion))
+
+				username, password, ok := request.BasicAuth()
+				Expect(ok).To(BeTrue())
+				Expect(username).To(Equal("user"))
+				Expect(password).To(Equal("pass"))
+			})
+
+			It("returns the catalog", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(catalog.Services).To(HaveLen(1))
+
+				service := catalog.Services[0]
+				Expect(service.Name).To(Equal("mysql"))
+				Expect(service.Tags).To(Equal([]string{"sql"}))
+				Expect(service.Shareable()).To(BeTrue())
+				Expect(service.Plans).To(HaveLen(2))
+
+				small := service.Plans[0]
+				Expect(small.IsFree()).To(BeFalse())
+				Expect(small.MaintenanceInfoVersion()).To(Equal("1.2.0"))
+				Expect(small.Schemas.ServiceInstance.Create.Parameters).To(Equal(map[string]interface{}{"type": "object"}))
+
+				large := service.Plans[1]
+				Expect(large.IsFree()).To(BeTrue())
+				Expect(large.MaintenanceInfoVersion()).To(BeEmpty())
+			})
+		})
+
+		When("the broker returns an error status", func() {
+			BeforeEach(func() {
+				respondWith(http.StatusUnauthorized, "401 Unauthorized", "go away")
+			})
+
+			It("returns a raw HTTP status error", func() {
+				Expect(executeErr).To(MatchError(RawHTTPStatusError{
+					Status:      "401 Unauthorized",
+					RawResponse: []byte("go away"),
+				}))
+			})
+		})
+
+		When("the catalog is not valid JSON", func() {
+			BeforeEach(func() {
+				respondWith(http.StatusOK, "200 OK", "{")
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(ContainSubstring("Invalid catalog from service broker")))
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				fakeHTTPClient.DoReturns(nil, errors.New("connection refused"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("connection refused"))
+			})
+		})
+	})
+})
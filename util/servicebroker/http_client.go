@@ -0,0 +1,9 @@
+package servicebroker
+
+import "net/http"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . HTTPClient
+
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
@@ -0,0 +1,14 @@
+package servicebroker
+
+import "fmt"
+
+// RawHTTPStatusError represents any response from the broker with a 4xx or
+// 5xx status code.
+type RawHTTPStatusError struct {
+	Status      string
+	RawResponse []byte
+}
+
+func (r RawHTTPStatusError) Error() string {
+	return fmt.Sprintf("Service broker responded with %s\nResponse Body: %s", r.Status, r.RawResponse)
+}
@@ -0,0 +1,130 @@
+// Package servicemanifest reads files that declare the service instances a
+// space should contain.
+package servicemanifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+type SharedSpace struct {
+	Space string `yaml:"space"`
+	// Org is the org of the space. When empty, the targeted org is used.
+	Org string `yaml:"org,omitempty"`
+}
+
+type ServiceInstance struct {
+	Name     string `yaml:"name"`
+	Offering string `yaml:"offering"`
+	Broker   string `yaml:"broker,omitempty"`
+	Plan     string `yaml:"plan"`
+	// Parameters are only compared when they are declared. They are
+	// normalised to JSON types, so numbers are float64.
+	Parameters map[string]interface{} `yaml:"parameters,omitempty"`
+	// Tags are only compared when they are declared; an empty list means
+	// the instance should have no tags.
+	Tags     []string      `yaml:"tags,omitempty"`
+	SharedTo []SharedSpace `yaml:"shared_to,omitempty"`
+}
+
+type Manifest struct {
+	ServiceInstances []ServiceInstance `yaml:"services"`
+}
+
+type InvalidManifestError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidManifestError) Error() string {
+	return fmt.Sprintf("Invalid services file '%s': %s", e.Path, e.Reason)
+}
+
+// Read parses and validates the services file at path.
+func Read(path string) (Manifest, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var manifest Manifest
+	if err := yaml.Unmarshal(raw, &manifest); err != nil {
+		return Manifest{}, InvalidManifestError{Path: path, Reason: err.Error()}
+	}
+
+	if len(manifest.ServiceInstances) == 0 {
+		return Manifest{}, InvalidManifestError{Path: path, Reason: "no services are declared"}
+	}
+
+	seen := make(map[string]bool)
+	for i, instance := range manifest.ServiceInstances {
+		switch {
+		case instance.Name == "":
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("service %d has no name", i+1)}
+		case seen[instance.Name]:
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("service '%s' is declared more than once", instance.Name)}
+		case instance.Offering == "":
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("service '%s' has no offering", instance.Name)}
+		case instance.Plan == "":
+			return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("service '%s' has no plan", instance.Name)}
+		}
+		seen[instance.Name] = true
+
+		for _, shared := range instance.SharedTo {
+			if shared.Space == "" {
+				return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("service '%s' is shared to a space with no name", instance.Name)}
+			}
+		}
+
+		if instance.Parameters != nil {
+			parameters, err := normalise(instance.Parameters)
+			if err != nil {
+				return Manifest{}, InvalidManifestError{Path: path, Reason: fmt.Sprintf("service '%s' has invalid parameters: %s", instance.Name, err)}
+			}
+			manifest.ServiceInstances[i].Parameters = parameters
+		}
+	}
+
+	return manifest, nil
+}
+
+// normalise converts YAML maps into JSON objects, so that parameters can be
+// compared with the ones returned by the Cloud Controller.
+func normalise(parameters map[string]interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(stringKeys(parameters))
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	err = json.Unmarshal(raw, &result)
+	return result, err
+}
+
+func stringKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			result[fmt.Sprint(key)] = stringKeys(child)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			result[key] = stringKeys(child)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, child := range v {
+			result[i] = stringKeys(child)
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -0,0 +1,120 @@
+package servicemanifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/servicemanifest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Read", func() {
+	var (
+		dir      string
+		path     string
+		contents string
+		manifest Manifest
+		err      error
+	)
+
+	BeforeEach(func() {
+		dir, err = ioutil.TempDir("", "servicemanifest-test")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "services.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		manifest, err = Read(path)
+	})
+
+	When("the file is valid", func() {
+		BeforeEach(func() {
+			contents = `---
+services:
+- name: mydb
+  offering: p-mysql
+  broker: mysql-broker
+  plan: small
+  parameters:
+    nodes: 3
+    backup:
+      enabled: true
+  tags: [sql, primary]
+  shared_to:
+  - space: other-space
+  - space: prod
+    org: other-org
+- name: cache
+  offering: redis
+  plan: dedicated
+  tags: []
+`
+		})
+
+		It("returns the declared service instances", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifest.ServiceInstances).To(Equal([]ServiceInstance{
+				{
+					Name:     "mydb",
+					Offering: "p-mysql",
+					Broker:   "mysql-broker",
+					Plan:     "small",
+					Parameters: map[string]interface{}{
+						"nodes":  float64(3),
+						"backup": map[string]interface{}{"enabled": true},
+					},
+					Tags: []string{"sql", "primary"},
+					SharedTo: []SharedSpace{
+						{Space: "other-space"},
+						{Space: "prod", Org: "other-org"},
+					},
+				},
+				{
+					Name:     "cache",
+					Offering: "redis",
+					Plan:     "dedicated",
+					Tags:     []string{},
+				},
+			}))
+		})
+	})
+
+	When("the file is not valid YAML", func() {
+		BeforeEach(func() {
+			contents = "services: [\n"
+		})
+
+		It("returns an invalid manifest error", func() {
+			Expect(err).To(BeAssignableToTypeOf(InvalidManifestError{}))
+		})
+	})
+
+	DescribeTable("invalid declarations",
+		func(yaml, reason string) {
+			Expect(ioutil.WriteFile(path, []byte(yaml), 0600)).To(Succeed())
+			_, err := Read(path)
+			Expect(err).To(MatchError(InvalidManifestError{Path: path, Reason: reason}))
+		},
+		Entry("no services", "services: []\n", "no services are declared"),
+		Entry("no name", "services:\n- offering: o\n  plan: p\n", "service 1 has no name"),
+		Entry("duplicate name", "services:\n- {name: a, offering: o, plan: p}\n- {name: a, offering: o, plan: p}\n", "service 'a' is declared more than once"),
+		Entry("no offering", "services:\n- {name: a, plan: p}\n", "service 'a' has no offering"),
+		Entry("no plan", "services:\n- {name: a, offering: o}\n", "service 'a' has no plan"),
+		Entry("shared to no space", "services:\n- {name: a, offering: o, plan: p, shared_to: [{org: x}]}\n", "service 'a' is shared to a space with no name"),
+	)
+
+	When("the file does not exist", func() {
+		It("returns the error", func() {
+			_, err := Read(filepath.Join(dir, "missing.yml"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package servicemanifest_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestServicemanifest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Service Manifest Suite")
+}
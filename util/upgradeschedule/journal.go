@@ -0,0 +1,76 @@
+package upgradeschedule
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+type JournalStatus string
+
+const (
+	// JournalUpgraded means the instance was upgraded.
+	JournalUpgraded JournalStatus = "upgraded"
+	// JournalRetrying means an upgrade failed and will be retried.
+	JournalRetrying JournalStatus = "retrying"
+	// JournalFailed means every attempt to upgrade the instance failed.
+	JournalFailed JournalStatus = "failed"
+)
+
+type JournalEntry struct {
+	Status    JournalStatus `json:"status"`
+	Attempts  int           `json:"attempts"`
+	LastError string        `json:"last_error,omitempty"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// Journal records the progress of scheduled upgrades, keyed by instance. It
+// is saved after every change, so it survives an interrupted run.
+type Journal struct {
+	path      string
+	Instances map[string]JournalEntry `json:"instances"`
+}
+
+// OpenJournal loads the journal at path. A journal that does not exist yet is
+// empty.
+func OpenJournal(path string) (*Journal, error) {
+	journal := &Journal{path: path, Instances: map[string]JournalEntry{}}
+
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return journal, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, journal); err != nil {
+		return nil, err
+	}
+	if journal.Instances == nil {
+		journal.Instances = map[string]JournalEntry{}
+	}
+
+	return journal, nil
+}
+
+func (journal *Journal) Entry(key string) JournalEntry {
+	return journal.Instances[key]
+}
+
+// Record stores the entry for key and saves the journal.
+func (journal *Journal) Record(key string, entry JournalEntry) error {
+	journal.Instances[key] = entry
+
+	raw, err := json.MarshalIndent(journal, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpPath := journal.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, raw, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, journal.path)
+}
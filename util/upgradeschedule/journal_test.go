@@ -0,0 +1,59 @@
+package upgradeschedule_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/upgradeschedule"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Journal", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "upgrade-journal")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "journal.json")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("is empty when the file does not exist", func() {
+		journal, err := OpenJournal(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(journal.Entry("org/space/db")).To(Equal(JournalEntry{}))
+	})
+
+	It("saves every recorded entry", func() {
+		journal, err := OpenJournal(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		entry := JournalEntry{
+			Status:    JournalRetrying,
+			Attempts:  1,
+			LastError: "boom",
+			UpdatedAt: time.Date(2026, time.October, 17, 22, 0, 0, 0, time.UTC),
+		}
+		Expect(journal.Record("org/space/db", entry)).To(Succeed())
+
+		reopened, err := OpenJournal(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reopened.Entry("org/space/db")).To(Equal(entry))
+	})
+
+	It("returns an error when the file is not valid JSON", func() {
+		Expect(ioutil.WriteFile(path, []byte("{"), 0600)).To(Succeed())
+		_, err := OpenJournal(path)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,260 @@
+// Package upgradeschedule reads the maintenance windows in which service
+// instance upgrades may run, and keeps a journal of upgrade progress so that
+// an interrupted run can resume.
+package upgradeschedule
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	DefaultRetryInterval = 5 * time.Minute
+	DefaultUpgradeTime   = 15 * time.Minute
+	journalSuffix        = ".journal.json"
+)
+
+type Schedule struct {
+	Location      *time.Location
+	Windows       []Window
+	Retries       int
+	RetryInterval time.Duration
+	// UpgradeTime is how much of a window must remain for an upgrade or a
+	// retry to be started in it.
+	UpgradeTime time.Duration
+	JournalPath string
+}
+
+// Window is a daily time range. An End before Start means that the window
+// ends on the next day.
+type Window struct {
+	// Days on which the window starts. When empty, the window starts on
+	// every day.
+	Days  []time.Weekday
+	Start time.Duration
+	End   time.Duration
+}
+
+type rawSchedule struct {
+	Timezone      string      `yaml:"timezone"`
+	Windows       []rawWindow `yaml:"windows"`
+	Retries       int         `yaml:"retries"`
+	RetryInterval string      `yaml:"retry_interval"`
+	UpgradeTime   string      `yaml:"upgrade_time"`
+	Journal       string      `yaml:"journal"`
+}
+
+type rawWindow struct {
+	Days  []string `yaml:"days"`
+	Start string   `yaml:"start"`
+	End   string   `yaml:"end"`
+}
+
+type InvalidScheduleError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidScheduleError) Error() string {
+	return fmt.Sprintf("Invalid upgrade schedule '%s': %s", e.Path, e.Reason)
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Read parses and validates the schedule file at path. The journal path is
+// relative to the directory of the schedule file, and defaults to the path of
+// the schedule file with a .journal.json suffix.
+func Read(path string) (Schedule, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	var parsed rawSchedule
+	if err := yaml.Unmarshal(raw, &parsed); err != nil {
+		return Schedule{}, InvalidScheduleError{Path: path, Reason: err.Error()}
+	}
+
+	invalid := func(format string, args ...interface{}) (Schedule, error) {
+		return Schedule{}, InvalidScheduleError{Path: path, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	schedule := Schedule{
+		Location:      time.Local,
+		Retries:       parsed.Retries,
+		RetryInterval: DefaultRetryInterval,
+		UpgradeTime:   DefaultUpgradeTime,
+		JournalPath:   path + journalSuffix,
+	}
+
+	if parsed.Timezone != "" {
+		schedule.Location, err = time.LoadLocation(parsed.Timezone)
+		if err != nil {
+			return invalid("unknown timezone '%s'", parsed.Timezone)
+		}
+	}
+
+	if parsed.Retries < 0 {
+		return invalid("retries must not be negative")
+	}
+
+	if parsed.RetryInterval != "" {
+		schedule.RetryInterval, err = time.ParseDuration(parsed.RetryInterval)
+		if err != nil || schedule.RetryInterval <= 0 {
+			return invalid("retry_interval '%s' is not a positive duration", parsed.RetryInterval)
+		}
+	}
+
+	if parsed.UpgradeTime != "" {
+		schedule.UpgradeTime, err = time.ParseDuration(parsed.UpgradeTime)
+		if err != nil || schedule.UpgradeTime <= 0 {
+			return invalid("upgrade_time '%s' is not a positive duration", parsed.UpgradeTime)
+		}
+	}
+
+	if parsed.Journal != "" {
+		schedule.JournalPath = parsed.Journal
+		if !filepath.IsAbs(parsed.Journal) {
+			schedule.JournalPath = filepath.Join(filepath.Dir(path), parsed.Journal)
+		}
+	}
+
+	if len(parsed.Windows) == 0 {
+		return invalid("no windows are declared")
+	}
+
+	for i, w := range parsed.Windows {
+		var window Window
+		for _, day := range w.Days {
+			weekday, ok := weekdays[strings.ToLower(day)]
+			if !ok {
+				return invalid("window %d has an unknown day '%s'", i+1, day)
+			}
+			window.Days = append(window.Days, weekday)
+		}
+
+		if window.Start, err = parseTimeOfDay(w.Start); err != nil {
+			return invalid("window %d has an invalid start '%s'", i+1, w.Start)
+		}
+		if window.End, err = parseTimeOfDay(w.End); err != nil {
+			return invalid("window %d has an invalid end '%s'", i+1, w.End)
+		}
+		if window.Start == window.End {
+			return invalid("window %d is empty", i+1)
+		}
+
+		schedule.Windows = append(schedule.Windows, window)
+	}
+
+	longEnough := false
+	for _, window := range schedule.Windows {
+		if window.length() >= schedule.UpgradeTime {
+			longEnough = true
+		}
+	}
+	if !longEnough {
+		return invalid("no window is at least upgrade_time %s long", schedule.UpgradeTime)
+	}
+
+	return schedule, nil
+}
+
+// NextWindow returns the start and end of the window that contains t or, when
+// t is outside every window, of the next window to start. The start is before
+// t when t is inside a window.
+func (schedule Schedule) NextWindow(t time.Time) (time.Time, time.Time) {
+	local := t.In(schedule.Location)
+
+	var start, end time.Time
+	// A window that started yesterday may still be open.
+	for offset := -1; offset <= 7; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, schedule.Location)
+
+		for _, window := range schedule.Windows {
+			if !window.startsOn(day.Weekday()) {
+				continue
+			}
+
+			windowStart := atTimeOfDay(day, window.Start)
+			windowEnd := atTimeOfDay(day, window.End)
+			if window.End < window.Start {
+				windowEnd = atTimeOfDay(day.AddDate(0, 0, 1), window.End)
+			}
+
+			if !windowEnd.After(t) {
+				continue
+			}
+			if start.IsZero() || windowStart.Before(start) {
+				start, end = windowStart, windowEnd
+			}
+		}
+	}
+
+	return start, end
+}
+
+// NextUpgradeStart returns the earliest time from t on at which a window is
+// open with at least UpgradeTime of it remaining.
+func (schedule Schedule) NextUpgradeStart(t time.Time) time.Time {
+	// Each window starts at most once a day, so every window of the coming
+	// week is looked at before giving up.
+	for i := 0; i <= 8*len(schedule.Windows); i++ {
+		start, end := schedule.NextWindow(t)
+		if start.IsZero() {
+			break
+		}
+		if start.Before(t) {
+			start = t
+		}
+		if end.Sub(start) >= schedule.UpgradeTime {
+			return start
+		}
+		t = end
+	}
+
+	return time.Time{}
+}
+
+func (window Window) length() time.Duration {
+	if window.End < window.Start {
+		return 24*time.Hour - window.Start + window.End
+	}
+	return window.End - window.Start
+}
+
+func (window Window) startsOn(day time.Weekday) bool {
+	if len(window.Days) == 0 {
+		return true
+	}
+	for _, d := range window.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+func atTimeOfDay(day time.Time, offset time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, day.Location())
+}
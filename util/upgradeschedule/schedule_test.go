@@ -0,0 +1,163 @@
+package upgradeschedule_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/upgradeschedule"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "upgrade-schedule")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "schedule.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	writeSchedule := func(content string) {
+		Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+	}
+
+	Describe("Read", func() {
+		It("parses the schedule", func() {
+			writeSchedule(`
+timezone: UTC
+windows:
+- days: [sat, Sun]
+  start: "22:00"
+  end: "02:30"
+retries: 2
+retry_interval: 10m
+upgrade_time: 30m
+journal: progress.json
+`)
+			schedule, err := Read(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedule).To(Equal(Schedule{
+				Location: time.UTC,
+				Windows: []Window{{
+					Days:  []time.Weekday{time.Saturday, time.Sunday},
+					Start: 22 * time.Hour,
+					End:   2*time.Hour + 30*time.Minute,
+				}},
+				Retries:       2,
+				RetryInterval: 10 * time.Minute,
+				UpgradeTime:   30 * time.Minute,
+				JournalPath:   filepath.Join(dir, "progress.json"),
+			}))
+		})
+
+		It("applies defaults", func() {
+			writeSchedule("windows: [{start: \"01:00\", end: \"02:00\"}]")
+			schedule, err := Read(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedule.Location).To(Equal(time.Local))
+			Expect(schedule.Retries).To(BeZero())
+			Expect(schedule.RetryInterval).To(Equal(DefaultRetryInterval))
+			Expect(schedule.UpgradeTime).To(Equal(DefaultUpgradeTime))
+			Expect(schedule.JournalPath).To(Equal(path + ".journal.json"))
+		})
+
+		DescribeTable("rejects invalid schedules",
+			func(content, reason string) {
+				writeSchedule(content)
+				_, err := Read(path)
+				Expect(err).To(MatchError(InvalidScheduleError{Path: path, Reason: reason}))
+			},
+			Entry("no windows", "retries: 1", "no windows are declared"),
+			Entry("unknown day", `windows: [{days: [someday], start: "01:00", end: "02:00"}]`, "window 1 has an unknown day 'someday'"),
+			Entry("invalid start", `windows: [{start: "25:00", end: "02:00"}]`, "window 1 has an invalid start '25:00'"),
+			Entry("invalid end", `windows: [{start: "01:00"}]`, "window 1 has an invalid end ''"),
+			Entry("empty window", `windows: [{start: "01:00", end: "01:00"}]`, "window 1 is empty"),
+			Entry("unknown timezone", `{timezone: Nowhere/Nothing, windows: [{start: "01:00", end: "02:00"}]}`, "unknown timezone 'Nowhere/Nothing'"),
+			Entry("negative retries", `{retries: -1, windows: [{start: "01:00", end: "02:00"}]}`, "retries must not be negative"),
+			Entry("invalid retry interval", `{retry_interval: soon, windows: [{start: "01:00", end: "02:00"}]}`, "retry_interval 'soon' is not a positive duration"),
+			Entry("invalid upgrade time", `{upgrade_time: -1m, windows: [{start: "01:00", end: "02:00"}]}`, "upgrade_time '-1m' is not a positive duration"),
+			Entry("windows shorter than the upgrade time", `{upgrade_time: 2h, windows: [{start: "23:00", end: "00:30"}]}`, "no window is at least upgrade_time 2h0m0s long"),
+		)
+	})
+
+	Describe("NextWindow", func() {
+		var schedule Schedule
+
+		BeforeEach(func() {
+			schedule = Schedule{
+				Location: time.UTC,
+				Windows: []Window{
+					{Days: []time.Weekday{time.Saturday}, Start: 22 * time.Hour, End: 2 * time.Hour},
+					{Days: []time.Weekday{time.Wednesday}, Start: 12 * time.Hour, End: 13 * time.Hour},
+				},
+			}
+		})
+
+		// 2026-10-17 is a Saturday.
+		at := func(day, hour, minute int) time.Time {
+			return time.Date(2026, time.October, day, hour, minute, 0, 0, time.UTC)
+		}
+
+		It("returns the next window when outside every window", func() {
+			start, end := schedule.NextWindow(at(17, 9, 0))
+			Expect(start).To(Equal(at(17, 22, 0)))
+			Expect(end).To(Equal(at(18, 2, 0)))
+		})
+
+		It("returns the current window when inside one that crosses midnight", func() {
+			start, end := schedule.NextWindow(at(18, 1, 0))
+			Expect(start).To(Equal(at(17, 22, 0)))
+			Expect(end).To(Equal(at(18, 2, 0)))
+		})
+
+		It("returns a window later in the week once the current one has ended", func() {
+			start, end := schedule.NextWindow(at(18, 2, 0))
+			Expect(start).To(Equal(at(21, 12, 0)))
+			Expect(end).To(Equal(at(21, 13, 0)))
+		})
+	})
+
+	Describe("NextUpgradeStart", func() {
+		var schedule Schedule
+
+		BeforeEach(func() {
+			schedule = Schedule{
+				Location: time.UTC,
+				Windows: []Window{
+					{Days: []time.Weekday{time.Saturday}, Start: 22 * time.Hour, End: 2 * time.Hour},
+					{Days: []time.Weekday{time.Wednesday}, Start: 12 * time.Hour, End: 13 * time.Hour},
+				},
+				UpgradeTime: 30 * time.Minute,
+			}
+		})
+
+		// 2026-10-17 is a Saturday.
+		at := func(day, hour, minute int) time.Time {
+			return time.Date(2026, time.October, day, hour, minute, 0, 0, time.UTC)
+		}
+
+		It("returns the start of the next window when outside every window", func() {
+			Expect(schedule.NextUpgradeStart(at(17, 9, 0))).To(Equal(at(17, 22, 0)))
+		})
+
+		It("returns t when enough of the current window remains", func() {
+			Expect(schedule.NextUpgradeStart(at(18, 1, 30))).To(Equal(at(18, 1, 30)))
+		})
+
+		It("defers to the next window when too little of the current one remains", func() {
+			Expect(schedule.NextUpgradeStart(at(18, 1, 31))).To(Equal(at(21, 12, 0)))
+		})
+	})
+})